@@ -17,7 +17,8 @@ var (
 	passportSvc passport.PassportSeviceClient
 
 	authWhitelistPrefixes []string = []string{
-		`^\/v1\/auth\/(register|social|login)$`,
+		`^\/v1\/auth\/(register|social|login|fido|refresh|validate_account)$`,
+		`^\/v1\/(forgotpassword|resetpassword)$`,
 	}
 )
 
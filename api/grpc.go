@@ -5,6 +5,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"pm.tcfw.com.au/source/ataas/api/pb/audit"
 	"pm.tcfw.com.au/source/ataas/api/pb/blocks"
 	"pm.tcfw.com.au/source/ataas/api/pb/excreds"
 	"pm.tcfw.com.au/source/ataas/api/pb/notify"
@@ -13,6 +14,7 @@ import (
 	"pm.tcfw.com.au/source/ataas/api/pb/strategy"
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
 	"pm.tcfw.com.au/source/ataas/api/pb/users"
+	auditImpl "pm.tcfw.com.au/source/ataas/internal/audit"
 	blocksImpl "pm.tcfw.com.au/source/ataas/internal/blocks"
 	excredsImpl "pm.tcfw.com.au/source/ataas/internal/excreds"
 	notifyImpl "pm.tcfw.com.au/source/ataas/internal/notify"
@@ -66,6 +68,12 @@ func newGRPCServer(ctx context.Context, opts ...grpc.ServerOption) (func(), func
 		panic(err)
 	}
 
+	auditServer, err := auditImpl.NewServer(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	audit.RegisterAuditServiceServer(grpcServer, auditServer)
 	blocks.RegisterBlocksServiceServer(grpcServer, blockServer)
 	excreds.RegisterExCredsServiceServer(grpcServer, excredsServer)
 	notify.RegisterNotifyServiceServer(grpcServer, notifyServer)
@@ -79,6 +87,7 @@ func newGRPCServer(ctx context.Context, opts ...grpc.ServerOption) (func(), func
 	startServices := func() {
 		blockServer.Listen()
 		go stratServer.Start(ctx)
+		go ordersServer.Watch(ctx)
 
 		logrus.New().Infoln("Started services")
 	}
@@ -15,6 +15,16 @@ func httpHeaderMatch(h string) (string, bool) {
 	return h, isHttpHeader(h)
 }
 
+//httpIncomingHeaderMatch forwards the account selection header along with the
+//headers forwarded by default
+func httpIncomingHeaderMatch(h string) (string, bool) {
+	if http.CanonicalHeaderKey(h) == "X-Account" {
+		return "x-account", true
+	}
+
+	return runtime.DefaultHeaderMatcher(h)
+}
+
 func isHttpHeader(h string) bool {
 	_, ok := commonHeader[h]
 	return ok
@@ -58,6 +58,40 @@ func (BlockState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_99dbce71772e5cd4, []int{0}
 }
 
+type ManualActionStatus int32
+
+const (
+	ManualActionStatus_PENDING   ManualActionStatus = 0
+	ManualActionStatus_REJECTED  ManualActionStatus = 1
+	ManualActionStatus_EXECUTED  ManualActionStatus = 2
+	ManualActionStatus_FAILED    ManualActionStatus = 3
+	ManualActionStatus_EXECUTING ManualActionStatus = 4
+)
+
+var ManualActionStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "REJECTED",
+	2: "EXECUTED",
+	3: "FAILED",
+	4: "EXECUTING",
+}
+
+var ManualActionStatus_value = map[string]int32{
+	"PENDING":   0,
+	"REJECTED":  1,
+	"EXECUTED":  2,
+	"FAILED":    3,
+	"EXECUTING": 4,
+}
+
+func (x ManualActionStatus) String() string {
+	return proto.EnumName(ManualActionStatus_name, int32(x))
+}
+
+func (ManualActionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{1}
+}
+
 type Block struct {
 	Id                string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	StrategyId        string     `protobuf:"bytes,2,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
@@ -71,6 +105,8 @@ type Block struct {
 	Market            string     `protobuf:"bytes,12,opt,name=market,proto3" json:"market,omitempty"`
 	Instrument        string     `protobuf:"bytes,13,opt,name=instrument,proto3" json:"instrument,omitempty"`
 	Account           string     `protobuf:"bytes,14,opt,name=account,proto3" json:"account,omitempty"`
+	CreatedAt         string     `protobuf:"bytes,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	PendingOrder      string     `protobuf:"bytes,16,opt,name=pendingOrder,proto3" json:"pendingOrder,omitempty"`
 }
 
 func (m *Block) Reset()         { *m = Block{} }
@@ -190,6 +226,20 @@ func (m *Block) GetAccount() string {
 	return ""
 }
 
+func (m *Block) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Block) GetPendingOrder() string {
+	if m != nil {
+		return m.PendingOrder
+	}
+	return ""
+}
+
 type GetRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -367,7 +417,8 @@ func (m *ManualRequest) GetAction() orders.Action {
 }
 
 type ManualResponse struct {
-	Order *orders.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order   *orders.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Request *ManualAction `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
 func (m *ManualResponse) Reset()         { *m = ManualResponse{} }
@@ -410,6 +461,279 @@ func (m *ManualResponse) GetOrder() *orders.Order {
 	return nil
 }
 
+func (m *ManualResponse) GetRequest() *ManualAction {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// ManualAction a manual buy or sell of a block. Actions requested by viewers
+// must be approved by a trader before they are executed
+type ManualAction struct {
+	Id          string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlockID     string             `protobuf:"bytes,2,opt,name=blockID,proto3" json:"blockID,omitempty"`
+	Action      orders.Action      `protobuf:"varint,3,opt,name=action,proto3,enum=ataas.orders.Action" json:"action,omitempty"`
+	Status      ManualActionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ataas.blocks.ManualActionStatus" json:"status,omitempty"`
+	RequestedBy string             `protobuf:"bytes,5,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+	DecidedBy   string             `protobuf:"bytes,6,opt,name=decidedBy,proto3" json:"decidedBy,omitempty"`
+	OrderID     string             `protobuf:"bytes,7,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Error       string             `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   string             `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	DecidedAt   string             `protobuf:"bytes,10,opt,name=decidedAt,proto3" json:"decidedAt,omitempty"`
+}
+
+func (m *ManualAction) Reset()         { *m = ManualAction{} }
+func (m *ManualAction) String() string { return proto.CompactTextString(m) }
+func (*ManualAction) ProtoMessage()    {}
+func (*ManualAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{6}
+}
+func (m *ManualAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManualAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManualAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManualAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualAction.Merge(m, src)
+}
+func (m *ManualAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManualAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualAction proto.InternalMessageInfo
+
+func (m *ManualAction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ManualAction) GetBlockID() string {
+	if m != nil {
+		return m.BlockID
+	}
+	return ""
+}
+
+func (m *ManualAction) GetAction() orders.Action {
+	if m != nil {
+		return m.Action
+	}
+	return orders.Action_BUY
+}
+
+func (m *ManualAction) GetStatus() ManualActionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ManualActionStatus_PENDING
+}
+
+func (m *ManualAction) GetRequestedBy() string {
+	if m != nil {
+		return m.RequestedBy
+	}
+	return ""
+}
+
+func (m *ManualAction) GetDecidedBy() string {
+	if m != nil {
+		return m.DecidedBy
+	}
+	return ""
+}
+
+func (m *ManualAction) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ManualAction) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ManualAction) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *ManualAction) GetDecidedAt() string {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return ""
+}
+
+type ListManualActionsRequest struct {
+	BlockID     string `protobuf:"bytes,1,opt,name=blockID,proto3" json:"blockID,omitempty"`
+	PendingOnly bool   `protobuf:"varint,2,opt,name=pendingOnly,proto3" json:"pendingOnly,omitempty"`
+}
+
+func (m *ListManualActionsRequest) Reset()         { *m = ListManualActionsRequest{} }
+func (m *ListManualActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListManualActionsRequest) ProtoMessage()    {}
+func (*ListManualActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{7}
+}
+func (m *ListManualActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListManualActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListManualActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListManualActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListManualActionsRequest.Merge(m, src)
+}
+func (m *ListManualActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListManualActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListManualActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListManualActionsRequest proto.InternalMessageInfo
+
+func (m *ListManualActionsRequest) GetBlockID() string {
+	if m != nil {
+		return m.BlockID
+	}
+	return ""
+}
+
+func (m *ListManualActionsRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+type ManualActionList struct {
+	Actions []*ManualAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (m *ManualActionList) Reset()         { *m = ManualActionList{} }
+func (m *ManualActionList) String() string { return proto.CompactTextString(m) }
+func (*ManualActionList) ProtoMessage()    {}
+func (*ManualActionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{8}
+}
+func (m *ManualActionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManualActionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManualActionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManualActionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualActionList.Merge(m, src)
+}
+func (m *ManualActionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManualActionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualActionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualActionList proto.InternalMessageInfo
+
+func (m *ManualActionList) GetActions() []*ManualAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type DecideManualActionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (m *DecideManualActionRequest) Reset()         { *m = DecideManualActionRequest{} }
+func (m *DecideManualActionRequest) String() string { return proto.CompactTextString(m) }
+func (*DecideManualActionRequest) ProtoMessage()    {}
+func (*DecideManualActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{9}
+}
+func (m *DecideManualActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecideManualActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecideManualActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecideManualActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecideManualActionRequest.Merge(m, src)
+}
+func (m *DecideManualActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecideManualActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecideManualActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecideManualActionRequest proto.InternalMessageInfo
+
+func (m *DecideManualActionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DecideManualActionRequest) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
 type DeleteRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -418,7 +742,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99dbce71772e5cd4, []int{6}
+	return fileDescriptor_99dbce71772e5cd4, []int{10}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +785,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99dbce71772e5cd4, []int{7}
+	return fileDescriptor_99dbce71772e5cd4, []int{11}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +823,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99dbce71772e5cd4, []int{8}
+	return fileDescriptor_99dbce71772e5cd4, []int{12}
 }
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +875,7 @@ func (m *CalcRequest) Reset()         { *m = CalcRequest{} }
 func (m *CalcRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcRequest) ProtoMessage()    {}
 func (*CalcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99dbce71772e5cd4, []int{9}
+	return fileDescriptor_99dbce71772e5cd4, []int{13}
 }
 func (m *CalcRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +927,7 @@ func (m *CalcResponse) Reset()         { *m = CalcResponse{} }
 func (m *CalcResponse) String() string { return proto.CompactTextString(m) }
 func (*CalcResponse) ProtoMessage()    {}
 func (*CalcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99dbce71772e5cd4, []int{10}
+	return fileDescriptor_99dbce71772e5cd4, []int{14}
 }
 func (m *CalcResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,101 +970,538 @@ func (m *CalcResponse) GetN() int32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ataas.blocks.BlockState", BlockState_name, BlockState_value)
-	proto.RegisterType((*Block)(nil), "ataas.blocks.Block")
-	proto.RegisterType((*GetRequest)(nil), "ataas.blocks.GetRequest")
-	proto.RegisterType((*ListRequest)(nil), "ataas.blocks.ListRequest")
-	proto.RegisterType((*ListResponse)(nil), "ataas.blocks.ListResponse")
-	proto.RegisterType((*ManualRequest)(nil), "ataas.blocks.ManualRequest")
-	proto.RegisterType((*ManualResponse)(nil), "ataas.blocks.ManualResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "ataas.blocks.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "ataas.blocks.DeleteResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "ataas.blocks.UpdateRequest")
-	proto.RegisterType((*CalcRequest)(nil), "ataas.blocks.CalcRequest")
-	proto.RegisterType((*CalcResponse)(nil), "ataas.blocks.CalcResponse")
+type PnL struct {
+	BlockID          string  `protobuf:"bytes,1,opt,name=blockID,proto3" json:"blockID,omitempty"`
+	Realised         float64 `protobuf:"fixed64,2,opt,name=realised,proto3" json:"realised,omitempty"`
+	Unrealised       float64 `protobuf:"fixed64,3,opt,name=unrealised,proto3" json:"unrealised,omitempty"`
+	Fees             float64 `protobuf:"fixed64,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	Invested         float64 `protobuf:"fixed64,5,opt,name=invested,proto3" json:"invested,omitempty"`
+	Roi              float64 `protobuf:"fixed64,6,opt,name=roi,proto3" json:"roi,omitempty"`
+	Position         float64 `protobuf:"fixed64,7,opt,name=position,proto3" json:"position,omitempty"`
+	CurrentPrice     float32 `protobuf:"fixed32,8,opt,name=currentPrice,proto3" json:"currentPrice,omitempty"`
+	Since            string  `protobuf:"bytes,9,opt,name=since,proto3" json:"since,omitempty"`
+	PriceUnavailable bool    `protobuf:"varint,10,opt,name=priceUnavailable,proto3" json:"priceUnavailable,omitempty"`
 }
 
-func init() { proto.RegisterFile("blocks.proto", fileDescriptor_99dbce71772e5cd4) }
+func (m *PnL) Reset()         { *m = PnL{} }
+func (m *PnL) String() string { return proto.CompactTextString(m) }
+func (*PnL) ProtoMessage()    {}
+func (*PnL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{15}
+}
+func (m *PnL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PnL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PnL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PnL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PnL.Merge(m, src)
+}
+func (m *PnL) XXX_Size() int {
+	return m.Size()
+}
+func (m *PnL) XXX_DiscardUnknown() {
+	xxx_messageInfo_PnL.DiscardUnknown(m)
+}
 
-var fileDescriptor_99dbce71772e5cd4 = []byte{
-	// 835 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x54,
-	0x10, 0xcf, 0xcb, 0xbf, 0x4d, 0x26, 0x76, 0x36, 0xfb, 0x58, 0x16, 0x63, 0xa2, 0x34, 0xb2, 0xf6,
-	0x90, 0x0d, 0x2b, 0x5b, 0x14, 0x71, 0x61, 0xc5, 0xa1, 0x6d, 0xba, 0xdd, 0x45, 0x6d, 0x5a, 0x1c,
-	0xca, 0x01, 0x21, 0xa1, 0x17, 0xfb, 0x91, 0x58, 0x75, 0xfc, 0x82, 0xfd, 0xdc, 0x08, 0x55, 0x5c,
-	0xf8, 0x04, 0x48, 0x5c, 0xb8, 0xf2, 0x6d, 0x38, 0x56, 0xe2, 0xc2, 0x11, 0xb5, 0x7c, 0x10, 0x94,
-	0xf7, 0xec, 0x24, 0x76, 0x13, 0x01, 0xa7, 0xe4, 0xcd, 0xfc, 0xe6, 0x37, 0x33, 0xbf, 0x99, 0x91,
-	0x41, 0x19, 0xfb, 0xcc, 0xb9, 0x8a, 0xcc, 0x79, 0xc8, 0x38, 0xc3, 0x0a, 0xe1, 0x84, 0x44, 0xa6,
-	0xb4, 0xe9, 0xed, 0x09, 0x63, 0x13, 0x9f, 0x5a, 0x64, 0xee, 0x59, 0x24, 0x08, 0x18, 0x27, 0xdc,
-	0x63, 0x41, 0x82, 0xd5, 0x15, 0x16, 0xba, 0x34, 0x4c, 0x5f, 0xcd, 0x88, 0x87, 0x84, 0xd3, 0xc9,
-	0x0f, 0xc9, 0x1b, 0x26, 0x6c, 0xc2, 0xe4, 0x7f, 0xe3, 0xd7, 0x12, 0x54, 0x0e, 0x97, 0x94, 0xb8,
-	0x09, 0x45, 0xcf, 0xd5, 0x50, 0x17, 0xf5, 0xea, 0x76, 0xd1, 0x73, 0xf1, 0x1e, 0x34, 0xd2, 0xb8,
-	0x6f, 0x3d, 0x57, 0x2b, 0x0a, 0x07, 0xa4, 0xa6, 0xb7, 0x2e, 0x6e, 0x43, 0x7d, 0x4c, 0x22, 0x7a,
-	0x19, 0x78, 0x3c, 0xd2, 0x4a, 0x5d, 0xd4, 0x43, 0xf6, 0xda, 0x80, 0x0d, 0x50, 0x9c, 0x38, 0x0c,
-	0x69, 0xc0, 0x25, 0xa0, 0x2c, 0x00, 0x19, 0x1b, 0xd6, 0xa1, 0x36, 0x8f, 0x43, 0x67, 0x4a, 0x22,
-	0xaa, 0x55, 0xba, 0xa8, 0x57, 0xb4, 0x57, 0x6f, 0x6c, 0x42, 0x25, 0xe2, 0x84, 0x53, 0xad, 0xd6,
-	0x45, 0xbd, 0xe6, 0xbe, 0x66, 0x6e, 0xb6, 0x6f, 0x8a, 0x92, 0x47, 0x4b, 0xbf, 0x2d, 0x61, 0xf8,
-	0x39, 0xa8, 0x0b, 0xc2, 0x9d, 0xe9, 0x20, 0x0e, 0x85, 0x14, 0x5a, 0xbd, 0x8b, 0x7a, 0x25, 0x3b,
-	0x6b, 0xc4, 0x7d, 0x68, 0x45, 0x53, 0x16, 0xf2, 0x11, 0xf5, 0xfd, 0x03, 0xdf, 0x67, 0x0b, 0xea,
-	0x6a, 0xd0, 0x45, 0xbd, 0x9a, 0xfd, 0xc0, 0x8e, 0x5f, 0xc2, 0x93, 0x31, 0x71, 0xae, 0x58, 0xcc,
-	0x2f, 0x68, 0xe8, 0xd0, 0x80, 0x93, 0x09, 0xd5, 0x1a, 0xa2, 0xcc, 0x87, 0x0e, 0xfc, 0x0c, 0xaa,
-	0x33, 0x12, 0x5e, 0x51, 0xae, 0x29, 0x42, 0xa9, 0xe4, 0x85, 0x3b, 0x00, 0x5e, 0x10, 0xf1, 0x30,
-	0x9e, 0xd1, 0x80, 0x6b, 0xaa, 0x54, 0x71, 0x6d, 0xc1, 0x1a, 0x3c, 0x22, 0x8e, 0xc3, 0xe2, 0x80,
-	0x6b, 0x4d, 0xe1, 0x4c, 0x9f, 0x46, 0x1b, 0xe0, 0x84, 0x72, 0x9b, 0x7e, 0x1f, 0xd3, 0x88, 0xe7,
-	0xc7, 0x63, 0xa8, 0xd0, 0x38, 0xf5, 0xa2, 0xd4, 0x6d, 0xbc, 0x02, 0x45, 0x3e, 0xa3, 0x39, 0x0b,
-	0x22, 0x8a, 0x3f, 0x84, 0xaa, 0x94, 0x4a, 0x43, 0xdd, 0x52, 0xaf, 0xb1, 0xff, 0xce, 0x16, 0xfd,
-	0xec, 0x04, 0x62, 0x9c, 0x81, 0x7a, 0x46, 0x82, 0x98, 0xf8, 0x3b, 0x92, 0xe1, 0x97, 0x50, 0x25,
-	0x8e, 0x50, 0xb5, 0x28, 0xa6, 0xf1, 0x34, 0x61, 0x4b, 0xd6, 0xec, 0x40, 0xf8, 0xec, 0x04, 0x63,
-	0xbc, 0x82, 0x66, 0x4a, 0x97, 0x54, 0xf3, 0x02, 0x2a, 0x02, 0x2a, 0x28, 0xd7, 0xc5, 0x24, 0xe1,
-	0xe7, 0xcb, 0x1f, 0x5b, 0x22, 0x8c, 0x3d, 0x50, 0x07, 0xd4, 0xa7, 0x9c, 0xee, 0x6a, 0xbc, 0x05,
-	0xcd, 0x14, 0x20, 0xd9, 0x8d, 0xcf, 0x41, 0xbd, 0x9c, 0xbb, 0x64, 0x67, 0xc8, 0x32, 0xbd, 0xe8,
-	0x54, 0x54, 0xbf, 0x43, 0x0b, 0x89, 0x30, 0xa6, 0xd0, 0x38, 0x22, 0xbe, 0x93, 0x32, 0xad, 0x22,
-	0xd1, 0xbf, 0x45, 0x62, 0x33, 0xa7, 0xd1, 0xb3, 0x04, 0xbb, 0x3a, 0xbe, 0x9c, 0x4a, 0xa7, 0xa0,
-	0xc8, 0x4c, 0x89, 0x46, 0xab, 0x85, 0x47, 0xff, 0x6d, 0xe1, 0x15, 0x40, 0x32, 0x55, 0xc5, 0x46,
-	0x41, 0xff, 0x33, 0x80, 0x35, 0x04, 0x37, 0xe0, 0xd1, 0xf0, 0xfc, 0xcb, 0x37, 0x6f, 0x87, 0x27,
-	0xad, 0x02, 0x56, 0xa1, 0x7e, 0x71, 0x69, 0x1f, 0xbd, 0x39, 0x18, 0x1d, 0x0f, 0x5a, 0x08, 0xd7,
-	0xa0, 0x3c, 0x3a, 0x3f, 0x1d, 0xb4, 0x8a, 0xb8, 0x0e, 0x95, 0xe3, 0xe1, 0xe0, 0x78, 0xd0, 0x2a,
-	0xed, 0xff, 0x56, 0x01, 0x55, 0xc4, 0x47, 0x23, 0x1a, 0x5e, 0x7b, 0x0e, 0xc5, 0xaf, 0xa1, 0x34,
-	0xa4, 0x0b, 0xbc, 0xad, 0x63, 0x7d, 0x9b, 0xd1, 0x78, 0xf7, 0xa7, 0x3f, 0xfe, 0xfe, 0xa5, 0xf8,
-	0xd8, 0x00, 0xeb, 0xfa, 0x23, 0x4b, 0x7a, 0x3e, 0x45, 0x7d, 0xfc, 0x05, 0x94, 0x97, 0x8b, 0x89,
-	0xdf, 0xcf, 0xc6, 0x6c, 0xec, 0xae, 0xae, 0x6f, 0x73, 0x25, 0xb3, 0xc5, 0x82, 0x55, 0xc1, 0x1b,
-	0xac, 0xf8, 0x0c, 0x4a, 0x27, 0x94, 0xe3, 0x9c, 0x42, 0xeb, 0x5b, 0xd9, 0x5e, 0xdf, 0x7b, 0x82,
-	0xe9, 0x09, 0x7e, 0xbc, 0x66, 0xb2, 0x6e, 0x3c, 0xf7, 0x47, 0xfc, 0x15, 0x54, 0xe5, 0xfa, 0xe0,
-	0x0f, 0xb2, 0x71, 0x99, 0xa5, 0xda, 0x4e, 0xaa, 0x0b, 0xd2, 0xa7, 0x46, 0x9e, 0x74, 0xd9, 0x79,
-	0x0c, 0x8a, 0x3c, 0x03, 0x39, 0xf8, 0x3c, 0x7b, 0xe6, 0xe2, 0xf4, 0xf6, 0x76, 0x67, 0xa2, 0x42,
-	0x5f, 0xa4, 0x79, 0x6e, 0xec, 0xe5, 0xd2, 0x58, 0x72, 0x97, 0xac, 0x1b, 0xf9, 0x2b, 0xd2, 0x7e,
-	0x03, 0x55, 0x79, 0x1f, 0xf9, 0x84, 0x99, 0xb3, 0xca, 0x27, 0xcc, 0x9d, 0x54, 0x22, 0x56, 0xff,
-	0x81, 0x58, 0x87, 0x50, 0x5f, 0x6e, 0xad, 0x5c, 0xb3, 0xdc, 0x4c, 0x37, 0x0e, 0x27, 0x3f, 0xd3,
-	0xcc, 0xa6, 0x7f, 0x02, 0xe5, 0xd7, 0x5e, 0xe0, 0xfe, 0xcf, 0x01, 0x1e, 0x1e, 0xfd, 0x7e, 0xd7,
-	0x41, 0xb7, 0x77, 0x1d, 0xf4, 0xd7, 0x5d, 0x07, 0xfd, 0x7c, 0xdf, 0x29, 0xdc, 0xde, 0x77, 0x0a,
-	0x7f, 0xde, 0x77, 0x0a, 0x5f, 0xbf, 0x98, 0xcf, 0x4c, 0xee, 0x7c, 0xb7, 0x30, 0x1d, 0x36, 0x33,
-	0x49, 0x6c, 0x45, 0x2c, 0x0e, 0x1d, 0x6a, 0x09, 0x0e, 0xf1, 0x85, 0x9c, 0x8f, 0x93, 0x26, 0xc6,
-	0x55, 0xf1, 0xd9, 0xfb, 0xf8, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x7c, 0x32, 0x1f, 0x5c,
-	0x07, 0x00, 0x00,
+var xxx_messageInfo_PnL proto.InternalMessageInfo
+
+func (m *PnL) GetBlockID() string {
+	if m != nil {
+		return m.BlockID
+	}
+	return ""
 }
 
-func (m *Block) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PnL) GetRealised() float64 {
+	if m != nil {
+		return m.Realised
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Block) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PnL) GetUnrealised() float64 {
+	if m != nil {
+		return m.Unrealised
+	}
+	return 0
 }
 
-func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
+func (m *PnL) GetFees() float64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *PnL) GetInvested() float64 {
+	if m != nil {
+		return m.Invested
+	}
+	return 0
+}
+
+func (m *PnL) GetRoi() float64 {
+	if m != nil {
+		return m.Roi
+	}
+	return 0
+}
+
+func (m *PnL) GetPosition() float64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *PnL) GetCurrentPrice() float32 {
+	if m != nil {
+		return m.CurrentPrice
+	}
+	return 0
+}
+
+func (m *PnL) GetSince() string {
+	if m != nil {
+		return m.Since
+	}
+	return ""
+}
+
+func (m *PnL) GetPriceUnavailable() bool {
+	if m != nil {
+		return m.PriceUnavailable
+	}
+	return false
+}
+
+type AccountPnLRequest struct {
+}
+
+func (m *AccountPnLRequest) Reset()         { *m = AccountPnLRequest{} }
+func (m *AccountPnLRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountPnLRequest) ProtoMessage()    {}
+func (*AccountPnLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{16}
+}
+func (m *AccountPnLRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPnLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPnLRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPnLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPnLRequest.Merge(m, src)
+}
+func (m *AccountPnLRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPnLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPnLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPnLRequest proto.InternalMessageInfo
+
+type AccountPnLResponse struct {
+	Total  *PnL   `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Blocks []*PnL `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *AccountPnLResponse) Reset()         { *m = AccountPnLResponse{} }
+func (m *AccountPnLResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountPnLResponse) ProtoMessage()    {}
+func (*AccountPnLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{17}
+}
+func (m *AccountPnLResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPnLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPnLResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPnLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPnLResponse.Merge(m, src)
+}
+func (m *AccountPnLResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPnLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPnLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPnLResponse proto.InternalMessageInfo
+
+func (m *AccountPnLResponse) GetTotal() *PnL {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *AccountPnLResponse) GetBlocks() []*PnL {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type PnLSeriesRequest struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Depth    int32  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *PnLSeriesRequest) Reset()         { *m = PnLSeriesRequest{} }
+func (m *PnLSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*PnLSeriesRequest) ProtoMessage()    {}
+func (*PnLSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{18}
+}
+func (m *PnLSeriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PnLSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PnLSeriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PnLSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PnLSeriesRequest.Merge(m, src)
+}
+func (m *PnLSeriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PnLSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PnLSeriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PnLSeriesRequest proto.InternalMessageInfo
+
+func (m *PnLSeriesRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PnLSeriesRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *PnLSeriesRequest) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type PnLPoint struct {
+	Timestamp  int64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Realised   float64 `protobuf:"fixed64,2,opt,name=realised,proto3" json:"realised,omitempty"`
+	Unrealised float64 `protobuf:"fixed64,3,opt,name=unrealised,proto3" json:"unrealised,omitempty"`
+}
+
+func (m *PnLPoint) Reset()         { *m = PnLPoint{} }
+func (m *PnLPoint) String() string { return proto.CompactTextString(m) }
+func (*PnLPoint) ProtoMessage()    {}
+func (*PnLPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{19}
+}
+func (m *PnLPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PnLPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PnLPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PnLPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PnLPoint.Merge(m, src)
+}
+func (m *PnLPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PnLPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PnLPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PnLPoint proto.InternalMessageInfo
+
+func (m *PnLPoint) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PnLPoint) GetRealised() float64 {
+	if m != nil {
+		return m.Realised
+	}
+	return 0
+}
+
+func (m *PnLPoint) GetUnrealised() float64 {
+	if m != nil {
+		return m.Unrealised
+	}
+	return 0
+}
+
+type PnLSeriesResponse struct {
+	Data []*PnLPoint `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PnLSeriesResponse) Reset()         { *m = PnLSeriesResponse{} }
+func (m *PnLSeriesResponse) String() string { return proto.CompactTextString(m) }
+func (*PnLSeriesResponse) ProtoMessage()    {}
+func (*PnLSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99dbce71772e5cd4, []int{20}
+}
+func (m *PnLSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PnLSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PnLSeriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PnLSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PnLSeriesResponse.Merge(m, src)
+}
+func (m *PnLSeriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PnLSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PnLSeriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PnLSeriesResponse proto.InternalMessageInfo
+
+func (m *PnLSeriesResponse) GetData() []*PnLPoint {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ataas.blocks.BlockState", BlockState_name, BlockState_value)
+	proto.RegisterEnum("ataas.blocks.ManualActionStatus", ManualActionStatus_name, ManualActionStatus_value)
+	proto.RegisterType((*Block)(nil), "ataas.blocks.Block")
+	proto.RegisterType((*GetRequest)(nil), "ataas.blocks.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "ataas.blocks.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "ataas.blocks.ListResponse")
+	proto.RegisterType((*ManualRequest)(nil), "ataas.blocks.ManualRequest")
+	proto.RegisterType((*ManualResponse)(nil), "ataas.blocks.ManualResponse")
+	proto.RegisterType((*ManualAction)(nil), "ataas.blocks.ManualAction")
+	proto.RegisterType((*ListManualActionsRequest)(nil), "ataas.blocks.ListManualActionsRequest")
+	proto.RegisterType((*ManualActionList)(nil), "ataas.blocks.ManualActionList")
+	proto.RegisterType((*DecideManualActionRequest)(nil), "ataas.blocks.DecideManualActionRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "ataas.blocks.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "ataas.blocks.DeleteResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "ataas.blocks.UpdateRequest")
+	proto.RegisterType((*CalcRequest)(nil), "ataas.blocks.CalcRequest")
+	proto.RegisterType((*CalcResponse)(nil), "ataas.blocks.CalcResponse")
+	proto.RegisterType((*PnL)(nil), "ataas.blocks.PnL")
+	proto.RegisterType((*AccountPnLRequest)(nil), "ataas.blocks.AccountPnLRequest")
+	proto.RegisterType((*AccountPnLResponse)(nil), "ataas.blocks.AccountPnLResponse")
+	proto.RegisterType((*PnLSeriesRequest)(nil), "ataas.blocks.PnLSeriesRequest")
+	proto.RegisterType((*PnLPoint)(nil), "ataas.blocks.PnLPoint")
+	proto.RegisterType((*PnLSeriesResponse)(nil), "ataas.blocks.PnLSeriesResponse")
+}
+
+func init() { proto.RegisterFile("blocks.proto", fileDescriptor_99dbce71772e5cd4) }
+
+var fileDescriptor_99dbce71772e5cd4 = []byte{
+	// 1520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0x25, 0x4b, 0x96, 0x8e, 0x1e, 0x96, 0x27, 0x2f, 0x86, 0x31, 0x64, 0x81, 0x37, 0xf7,
+	0xc6, 0xd1, 0x0d, 0x24, 0x5c, 0xdf, 0xb4, 0x28, 0x1a, 0x14, 0x85, 0x6c, 0x29, 0x89, 0x03, 0xc5,
+	0x56, 0xa9, 0x38, 0x08, 0x82, 0x02, 0xe9, 0x98, 0x9c, 0xc8, 0x44, 0x28, 0x92, 0x21, 0x47, 0x36,
+	0x8c, 0x20, 0x9b, 0xfc, 0x82, 0x02, 0xdd, 0xb7, 0xbf, 0xa1, 0xff, 0xa2, 0x8b, 0x2e, 0x02, 0x74,
+	0x53, 0x74, 0x55, 0x24, 0xfd, 0x21, 0xc5, 0x3c, 0x28, 0x91, 0x12, 0x95, 0xb4, 0xe8, 0x4a, 0x3a,
+	0x8f, 0xf9, 0xce, 0x99, 0xf3, 0x1c, 0x42, 0xf9, 0xd8, 0xf1, 0xcc, 0x17, 0x61, 0xcb, 0x0f, 0x3c,
+	0xea, 0xa1, 0x32, 0xa6, 0x18, 0x87, 0x2d, 0xc1, 0xd3, 0x36, 0x47, 0x9e, 0x37, 0x72, 0x48, 0x1b,
+	0xfb, 0x76, 0x1b, 0xbb, 0xae, 0x47, 0x31, 0xb5, 0x3d, 0x57, 0xea, 0x6a, 0x65, 0x2f, 0xb0, 0x48,
+	0x10, 0x51, 0xd5, 0x90, 0x06, 0x98, 0x92, 0xd1, 0xb9, 0xa4, 0x61, 0xe4, 0x8d, 0x3c, 0xf1, 0x5f,
+	0xff, 0x2d, 0x0b, 0xb9, 0x5d, 0x06, 0x89, 0xaa, 0x90, 0xb1, 0x2d, 0x55, 0x69, 0x28, 0xdb, 0x45,
+	0x23, 0x63, 0x5b, 0x68, 0x0b, 0x4a, 0xd1, 0xb9, 0x67, 0xb6, 0xa5, 0x66, 0xb8, 0x00, 0x22, 0xd6,
+	0xbe, 0x85, 0x36, 0xa1, 0x78, 0x8c, 0x43, 0x72, 0xe4, 0xda, 0x34, 0x54, 0xb3, 0x0d, 0x65, 0x5b,
+	0x31, 0x66, 0x0c, 0xa4, 0x43, 0xd9, 0x9c, 0x04, 0x01, 0x71, 0xa9, 0x50, 0x58, 0xe5, 0x0a, 0x09,
+	0x1e, 0xd2, 0xa0, 0xe0, 0x4f, 0x02, 0xf3, 0x04, 0x87, 0x44, 0xcd, 0x35, 0x94, 0xed, 0x8c, 0x31,
+	0xa5, 0x51, 0x0b, 0x72, 0x21, 0xc5, 0x94, 0xa8, 0x85, 0x86, 0xb2, 0x5d, 0xdd, 0x51, 0x5b, 0xf1,
+	0xeb, 0xb7, 0xb8, 0xcb, 0x43, 0x26, 0x37, 0x84, 0x1a, 0xba, 0x0e, 0x95, 0x33, 0x4c, 0xcd, 0x93,
+	0xee, 0x24, 0xe0, 0xa1, 0x50, 0x8b, 0x0d, 0x65, 0x3b, 0x6b, 0x24, 0x99, 0xa8, 0x09, 0xb5, 0xf0,
+	0xc4, 0x0b, 0xe8, 0x90, 0x38, 0x4e, 0xc7, 0x71, 0xbc, 0x33, 0x62, 0xa9, 0xd0, 0x50, 0xb6, 0x0b,
+	0xc6, 0x02, 0x1f, 0xdd, 0x82, 0x8d, 0x63, 0x6c, 0xbe, 0xf0, 0x26, 0x74, 0x40, 0x02, 0x93, 0xb8,
+	0x14, 0x8f, 0x88, 0x5a, 0xe2, 0x6e, 0x2e, 0x0a, 0xd0, 0x65, 0xc8, 0x8f, 0x71, 0xf0, 0x82, 0x50,
+	0xb5, 0xcc, 0x23, 0x25, 0x29, 0x54, 0x07, 0xb0, 0xdd, 0x90, 0x06, 0x93, 0x31, 0x71, 0xa9, 0x5a,
+	0x11, 0x51, 0x9c, 0x71, 0x90, 0x0a, 0x6b, 0xd8, 0x34, 0xbd, 0x89, 0x4b, 0xd5, 0x2a, 0x17, 0x46,
+	0x24, 0x8b, 0xaf, 0x19, 0x10, 0x4c, 0x89, 0xd5, 0xa1, 0xea, 0x3a, 0x97, 0xcd, 0x18, 0x2c, 0xbe,
+	0x3e, 0x71, 0x2d, 0xdb, 0x1d, 0x1d, 0xb2, 0x5c, 0xab, 0x35, 0xae, 0x90, 0xe0, 0xe9, 0x9b, 0x00,
+	0xf7, 0x08, 0x35, 0xc8, 0xcb, 0x09, 0x09, 0xe9, 0x7c, 0x82, 0xf5, 0x0a, 0x94, 0xfa, 0x76, 0x18,
+	0x89, 0xf5, 0x3b, 0x50, 0x16, 0x64, 0xe8, 0x7b, 0x6e, 0x48, 0xd0, 0x7f, 0x21, 0x2f, 0x82, 0xad,
+	0x2a, 0x8d, 0xec, 0x76, 0x69, 0xe7, 0x42, 0x4a, 0x06, 0x0c, 0xa9, 0xa2, 0x3f, 0x84, 0xca, 0x43,
+	0xec, 0x4e, 0xb0, 0xb3, 0xc4, 0x18, 0xba, 0x05, 0x79, 0x6c, 0xf2, 0xbc, 0x64, 0x78, 0x3e, 0x2f,
+	0x4a, 0x34, 0x59, 0xa8, 0x1d, 0x2e, 0x33, 0xa4, 0x8e, 0xfe, 0x12, 0xaa, 0x11, 0x9c, 0xf4, 0xe6,
+	0x26, 0xe4, 0xb8, 0x2a, 0x87, 0x9c, 0x39, 0x23, 0x8f, 0xf3, 0xeb, 0x1a, 0x42, 0x03, 0xdd, 0x86,
+	0xb5, 0x40, 0x78, 0xc1, 0x6d, 0x95, 0x76, 0xb4, 0xa4, 0xe7, 0x02, 0x59, 0x5a, 0x8c, 0x54, 0xf5,
+	0x9f, 0x33, 0x50, 0x8e, 0x4b, 0x16, 0x6e, 0xa0, 0xc2, 0x1a, 0x07, 0xd8, 0xef, 0xca, 0x5e, 0x88,
+	0xc8, 0xd8, 0xdd, 0xb2, 0x1f, 0xbf, 0x1b, 0xfa, 0x0c, 0xf2, 0xac, 0x62, 0x27, 0xa2, 0x25, 0xaa,
+	0x3b, 0x8d, 0xe5, 0xde, 0x0d, 0xb9, 0x9e, 0x21, 0xf5, 0x51, 0x03, 0x4a, 0xd2, 0x5b, 0x62, 0xed,
+	0x9e, 0xf3, 0x8e, 0x29, 0x1a, 0x71, 0x16, 0x2b, 0x19, 0x8b, 0x98, 0xb6, 0xc5, 0xe5, 0x79, 0x51,
+	0x32, 0x53, 0x06, 0xbb, 0x01, 0x77, 0x69, 0xbf, 0xab, 0xae, 0x89, 0x1b, 0x48, 0x12, 0x5d, 0x84,
+	0x1c, 0x09, 0x02, 0x2f, 0xe0, 0xcd, 0x56, 0x34, 0x04, 0x91, 0x2c, 0xc0, 0xe2, 0x7c, 0x01, 0xce,
+	0x6c, 0x75, 0xa8, 0x0a, 0x09, 0x5b, 0x1d, 0xaa, 0x3f, 0x06, 0x95, 0x55, 0x53, 0xfc, 0x36, 0x61,
+	0x54, 0x1b, 0xb1, 0x48, 0x2a, 0xc9, 0x48, 0x36, 0xa0, 0x14, 0x15, 0xb0, 0xeb, 0x9c, 0xf3, 0x38,
+	0x17, 0x8c, 0x38, 0x4b, 0xbf, 0x0f, 0xb5, 0x38, 0x26, 0xb3, 0xc1, 0x12, 0x2e, 0x62, 0x1b, 0x95,
+	0xea, 0x07, 0x13, 0x2e, 0x55, 0xf5, 0x1e, 0x5c, 0xed, 0x72, 0x77, 0x13, 0xe2, 0x25, 0xe5, 0xcb,
+	0xba, 0xd4, 0xf7, 0x03, 0xef, 0x94, 0x48, 0xa7, 0x22, 0x52, 0xdf, 0x82, 0x4a, 0x97, 0x38, 0x84,
+	0x92, 0x65, 0x6d, 0x56, 0x83, 0x6a, 0xa4, 0x20, 0x6a, 0x59, 0x7f, 0x00, 0x95, 0x23, 0xdf, 0xc2,
+	0x4b, 0x8f, 0xb0, 0x62, 0xe7, 0xae, 0xab, 0x99, 0x44, 0xb1, 0x27, 0x3a, 0x4f, 0x68, 0xe8, 0x27,
+	0x50, 0xda, 0xc3, 0x8e, 0x19, 0x21, 0x4d, 0x4f, 0x2a, 0x1f, 0x3b, 0x89, 0x5a, 0x73, 0x1d, 0x79,
+	0x59, 0xea, 0x4e, 0x97, 0xc5, 0x5c, 0x4f, 0xf6, 0xa1, 0x2c, 0x2c, 0xc9, 0x8e, 0x9c, 0x0e, 0x68,
+	0xe5, 0xaf, 0x0d, 0xe8, 0x32, 0x28, 0xc2, 0x54, 0xce, 0x50, 0x5c, 0xfd, 0x87, 0x0c, 0x64, 0x07,
+	0x6e, 0xff, 0x03, 0xb5, 0xa0, 0x41, 0x21, 0x20, 0xd8, 0xb1, 0x43, 0x22, 0x96, 0x8f, 0x62, 0x4c,
+	0x69, 0x36, 0x54, 0x27, 0xee, 0x54, 0x2a, 0x76, 0x4f, 0x8c, 0x83, 0x10, 0xac, 0x3e, 0x27, 0x24,
+	0x5a, 0x3a, 0xfc, 0x3f, 0xc3, 0xb3, 0xdd, 0x53, 0xde, 0x29, 0xbc, 0x75, 0x14, 0x63, 0x4a, 0xa3,
+	0x1a, 0x64, 0x03, 0xcf, 0xe6, 0x1d, 0xa3, 0x18, 0xec, 0x2f, 0x5f, 0x4d, 0x5e, 0x68, 0xf3, 0xf8,
+	0xac, 0x09, 0xed, 0x88, 0x8e, 0xad, 0xb6, 0x41, 0x60, 0x9b, 0x62, 0x43, 0x65, 0x8c, 0x04, 0x8f,
+	0x75, 0x54, 0x68, 0xbb, 0x26, 0x91, 0x7d, 0x23, 0x08, 0xb6, 0x7e, 0x7c, 0x26, 0x3e, 0x72, 0xf1,
+	0x29, 0xb6, 0x1d, 0x7c, 0xec, 0x90, 0x68, 0xfd, 0xcc, 0xf3, 0xf5, 0x0b, 0xb0, 0xd1, 0x11, 0x9b,
+	0x60, 0xe0, 0xf6, 0xa3, 0x21, 0x7d, 0x02, 0x28, 0xce, 0x94, 0xa9, 0xb8, 0x01, 0x39, 0xea, 0x51,
+	0xec, 0xc8, 0xac, 0x6f, 0x24, 0x53, 0xc1, 0x34, 0x85, 0x1c, 0xdd, 0x9c, 0xce, 0xf4, 0x4c, 0x23,
+	0x9b, 0xae, 0x19, 0x4d, 0xf4, 0x47, 0x50, 0x1b, 0xb8, 0xfd, 0x21, 0x09, 0x6c, 0x12, 0x2e, 0xab,
+	0x53, 0x1e, 0x52, 0x4a, 0x82, 0x53, 0xec, 0xc8, 0x99, 0x38, 0xa5, 0x59, 0x00, 0x2c, 0xe2, 0xd3,
+	0x13, 0x9e, 0x9d, 0x9c, 0x21, 0x08, 0xdd, 0x82, 0xc2, 0xc0, 0xed, 0x0f, 0x3c, 0x5b, 0xec, 0x37,
+	0x6a, 0x8f, 0x49, 0x48, 0xf1, 0xd8, 0xe7, 0xa0, 0x59, 0x63, 0xc6, 0xf8, 0x27, 0xe9, 0xd7, 0xbf,
+	0x84, 0x8d, 0x98, 0xef, 0x32, 0x48, 0x4d, 0x58, 0xb5, 0x30, 0xc5, 0x72, 0x44, 0x5c, 0x5e, 0xb8,
+	0x39, 0x77, 0xca, 0xe0, 0x3a, 0xcd, 0x2f, 0x00, 0x66, 0x05, 0x8c, 0x4a, 0xb0, 0x76, 0x70, 0xf8,
+	0xe8, 0xfe, 0xfe, 0xc1, 0xbd, 0xda, 0x0a, 0xaa, 0x40, 0x71, 0x70, 0x64, 0xec, 0xdd, 0xef, 0x0c,
+	0x7b, 0xdd, 0x9a, 0x82, 0x0a, 0xb0, 0x3a, 0x3c, 0xec, 0x77, 0x6b, 0x19, 0x54, 0x84, 0x5c, 0xef,
+	0xa0, 0xdb, 0xeb, 0xd6, 0xb2, 0xcd, 0x27, 0x80, 0x16, 0xc7, 0x38, 0x83, 0x19, 0xf4, 0x0e, 0xba,
+	0x02, 0xa6, 0x0c, 0x05, 0xa3, 0xf7, 0xa0, 0xb7, 0xf7, 0x88, 0xa3, 0x94, 0xa1, 0xd0, 0x7b, 0xd2,
+	0xdb, 0x3b, 0x62, 0x54, 0x06, 0x01, 0xe4, 0xef, 0x76, 0xf6, 0xfb, 0x0c, 0x8a, 0x99, 0x13, 0x12,
+	0x76, 0x6c, 0x75, 0xe7, 0x47, 0x80, 0x0a, 0xf7, 0x2c, 0x1c, 0x92, 0xe0, 0x94, 0x15, 0xda, 0x5d,
+	0xc8, 0x1e, 0x90, 0x33, 0x94, 0xd6, 0xe9, 0x5a, 0x1a, 0x53, 0xbf, 0xf4, 0xe6, 0x97, 0x3f, 0xbe,
+	0xcb, 0xac, 0xeb, 0xd0, 0x3e, 0xfd, 0x5f, 0x5b, 0x48, 0x3e, 0x57, 0x9a, 0xe8, 0x2b, 0x58, 0xe5,
+	0xc3, 0xf4, 0x6a, 0xf2, 0x4c, 0xec, 0x85, 0xa0, 0x69, 0x69, 0x22, 0x39, 0xd3, 0x10, 0x47, 0x2d,
+	0xa3, 0x18, 0x2a, 0x7a, 0x08, 0xd9, 0x7b, 0x84, 0xa2, 0xb9, 0xc9, 0x30, 0x7b, 0x91, 0xa4, 0xfb,
+	0x77, 0x85, 0x23, 0x6d, 0xa0, 0xf5, 0x19, 0x52, 0xfb, 0x95, 0x6d, 0xbd, 0x46, 0x8f, 0x21, 0x2f,
+	0xc6, 0x26, 0xba, 0x96, 0x3c, 0x97, 0x18, 0xa6, 0xe9, 0xa0, 0x1a, 0x07, 0xbd, 0xa8, 0xcf, 0x83,
+	0xb2, 0x9b, 0x4f, 0xe6, 0x16, 0xff, 0xb5, 0xb4, 0xed, 0x11, 0xa1, 0x6f, 0xa6, 0x0b, 0x65, 0x14,
+	0x9a, 0xdc, 0xcc, 0x75, 0x7d, 0x6b, 0xce, 0x4c, 0x5b, 0xcc, 0xd0, 0xf6, 0x2b, 0xf1, 0xcb, 0xcd,
+	0x7e, 0xaf, 0xc0, 0xc6, 0xc2, 0x8a, 0x44, 0xff, 0x59, 0x8c, 0x71, 0xda, 0x0e, 0xd5, 0xea, 0xcb,
+	0x57, 0x1c, 0x3b, 0xa3, 0x77, 0xb8, 0x27, 0x77, 0x10, 0x62, 0x9e, 0x8c, 0xb9, 0xf4, 0x99, 0x30,
+	0x1e, 0x3e, 0xfd, 0x37, 0xfa, 0x57, 0xdc, 0x3f, 0x39, 0x70, 0x5f, 0xcf, 0xa9, 0xa1, 0xd7, 0x80,
+	0x16, 0x17, 0x24, 0xba, 0x91, 0x34, 0xbc, 0x74, 0x85, 0x6a, 0x1f, 0x58, 0xc2, 0xba, 0xce, 0xbd,
+	0xdb, 0xd4, 0xaf, 0x2c, 0x7a, 0x37, 0x4d, 0xcb, 0xd7, 0x90, 0x17, 0x7b, 0x73, 0x3e, 0x21, 0x89,
+	0x75, 0xab, 0x6d, 0xa6, 0x0b, 0x65, 0x42, 0x64, 0x31, 0x35, 0x17, 0x8a, 0xe9, 0x50, 0xae, 0x9f,
+	0xa5, 0xb5, 0xb9, 0x38, 0x1a, 0xf5, 0x6b, 0x1c, 0xec, 0x12, 0xba, 0x30, 0x9f, 0x5d, 0xdf, 0x75,
+	0xd0, 0x37, 0x00, 0xb3, 0xc9, 0x8c, 0xb6, 0x92, 0xa7, 0x17, 0x06, 0xb9, 0xd6, 0x58, 0xae, 0x20,
+	0x5d, 0x5f, 0xe7, 0xd6, 0x8a, 0x68, 0x8d, 0x59, 0x63, 0x16, 0xde, 0x28, 0x50, 0x9c, 0x8e, 0x35,
+	0x54, 0x5f, 0xf0, 0x2f, 0x31, 0xab, 0xb5, 0xad, 0xa5, 0x72, 0x89, 0x7f, 0x9b, 0xe3, 0xb7, 0x50,
+	0x55, 0xe2, 0xb7, 0x43, 0x2e, 0x7f, 0xba, 0x89, 0xb4, 0x94, 0xfb, 0x49, 0x29, 0xda, 0x85, 0x22,
+	0x7b, 0x05, 0x88, 0xc1, 0x38, 0x37, 0x2b, 0x62, 0x0f, 0x11, 0x4d, 0x4b, 0x13, 0xc9, 0x49, 0xfc,
+	0x09, 0xac, 0xde, 0xb5, 0x5d, 0xeb, 0x6f, 0x0e, 0x06, 0xf4, 0x29, 0x40, 0xc7, 0xf7, 0x9d, 0x73,
+	0xfe, 0xd8, 0x47, 0x69, 0x5f, 0x00, 0xa9, 0xe7, 0x76, 0xf7, 0x7e, 0x7a, 0x57, 0x57, 0xde, 0xbe,
+	0xab, 0x2b, 0xbf, 0xbf, 0xab, 0x2b, 0xdf, 0xbe, 0xaf, 0xaf, 0xbc, 0x7d, 0x5f, 0x5f, 0xf9, 0xf5,
+	0x7d, 0x7d, 0xe5, 0xe9, 0x4d, 0x7f, 0xdc, 0xa2, 0xe6, 0xf3, 0xb3, 0x96, 0xe9, 0x8d, 0x5b, 0x78,
+	0xd2, 0x0e, 0xbd, 0x49, 0x60, 0x92, 0x36, 0xc7, 0xe0, 0x5f, 0xd6, 0xfe, 0xb1, 0x8c, 0xc3, 0x71,
+	0x9e, 0x7f, 0x2e, 0xff, 0xff, 0xcf, 0x01, 0x00, 0x5c, 0xfe, 0x74, 0xaf, 0x94, 0x0f, 0x00, 0x00,
+}
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOrder) > 0 {
+		i -= len(m.PendingOrder)
+		copy(dAtA[i:], m.PendingOrder)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.PendingOrder)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
 		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Account)))
@@ -967,6 +1728,18 @@ func (m *ManualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlocks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Order != nil {
 		{
 			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
@@ -982,7 +1755,7 @@ func (m *ManualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+func (m *ManualAction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -992,28 +1765,234 @@ func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManualAction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ManualAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Id)))
+	if len(m.DecidedAt) > 0 {
+		i -= len(m.DecidedAt)
+		copy(dAtA[i:], m.DecidedAt)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.DecidedAt)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OrderID) > 0 {
+		i -= len(m.OrderID)
+		copy(dAtA[i:], m.OrderID)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.OrderID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DecidedBy) > 0 {
+		i -= len(m.DecidedBy)
+		copy(dAtA[i:], m.DecidedBy)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.DecidedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintBlocks(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Action != 0 {
+		i = encodeVarintBlocks(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockID) > 0 {
+		i -= len(m.BlockID)
+		copy(dAtA[i:], m.BlockID)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.BlockID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListManualActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListManualActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListManualActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingOnly {
+		i--
+		if m.PendingOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockID) > 0 {
+		i -= len(m.BlockID)
+		copy(dAtA[i:], m.BlockID)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.BlockID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManualActionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManualActionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManualActionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlocks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecideManualActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecideManualActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecideManualActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -1150,105 +2129,298 @@ func (m *CalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBlocks(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBlocks(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PnL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Block) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PnL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PnL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
-	}
-	l = len(m.StrategyId)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
-	}
-	if m.BaseUnits != 0 {
-		n += 9
+	if m.PriceUnavailable {
+		i--
+		if m.PriceUnavailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.CurrentUnits != 0 {
-		n += 9
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Since)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Purchase != 0 {
-		n += 5
+	if m.CurrentPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.CurrentPrice))))
+		i--
+		dAtA[i] = 0x45
 	}
-	if m.State != 0 {
-		n += 1 + sovBlocks(uint64(m.State))
+	if m.Position != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Position))))
+		i--
+		dAtA[i] = 0x39
 	}
-	if m.WatchDuration != 0 {
-		n += 1 + sovBlocks(uint64(m.WatchDuration))
+	if m.Roi != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Roi))))
+		i--
+		dAtA[i] = 0x31
 	}
-	if m.ShortSellAllowed {
-		n += 2
+	if m.Invested != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Invested))))
+		i--
+		dAtA[i] = 0x29
 	}
-	if m.BackoutPercentage != 0 {
-		n += 5
+	if m.Fees != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fees))))
+		i--
+		dAtA[i] = 0x21
 	}
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
+	if m.Unrealised != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Unrealised))))
+		i--
+		dAtA[i] = 0x19
 	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
+	if m.Realised != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Realised))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
+	if len(m.BlockID) > 0 {
+		i -= len(m.BlockID)
+		copy(dAtA[i:], m.BlockID)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.BlockID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountPnLRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AccountPnLRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPnLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPnLResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountPnLResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPnLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlocks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Total != nil {
+		{
+			size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlocks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PnLSeriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PnLSeriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PnLSeriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Depth != 0 {
+		i = encodeVarintBlocks(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PnLPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PnLPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PnLPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovBlocks(uint64(l))
+	if m.Unrealised != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Unrealised))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Realised != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Realised))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintBlocks(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PnLSeriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PnLSeriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PnLSeriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlocks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ManualRequest) Size() (n int) {
+func encodeVarintBlocks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlocks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Block) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1258,48 +2430,92 @@ func (m *ManualRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlocks(uint64(l))
 	}
-	if m.Action != 0 {
-		n += 1 + sovBlocks(uint64(m.Action))
+	l = len(m.StrategyId)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.BaseUnits != 0 {
+		n += 9
+	}
+	if m.CurrentUnits != 0 {
+		n += 9
+	}
+	if m.Purchase != 0 {
+		n += 5
+	}
+	if m.State != 0 {
+		n += 1 + sovBlocks(uint64(m.State))
+	}
+	if m.WatchDuration != 0 {
+		n += 1 + sovBlocks(uint64(m.WatchDuration))
+	}
+	if m.ShortSellAllowed {
+		n += 2
+	}
+	if m.BackoutPercentage != 0 {
+		n += 5
+	}
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.PendingOrder)
+	if l > 0 {
+		n += 2 + l + sovBlocks(uint64(l))
 	}
 	return n
 }
 
-func (m *ManualResponse) Size() (n int) {
+func (m *GetRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
+	l = len(m.Id)
+	if l > 0 {
 		n += 1 + l + sovBlocks(uint64(l))
 	}
 	return n
 }
 
-func (m *DeleteRequest) Size() (n int) {
+func (m *ListRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBlocks(uint64(l))
-	}
 	return n
 }
 
-func (m *DeleteResponse) Size() (n int) {
+func (m *ListResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBlocks(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *UpdateRequest) Size() (n int) {
+func (m *ManualRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1309,51 +2525,1694 @@ func (m *UpdateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlocks(uint64(l))
 	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovBlocks(uint64(l))
+	if m.Action != 0 {
+		n += 1 + sovBlocks(uint64(m.Action))
 	}
 	return n
 }
 
-func (m *CalcRequest) Size() (n int) {
+func (m *ManualResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
+	if m.Order != nil {
+		l = m.Order.Size()
 		n += 1 + l + sovBlocks(uint64(l))
 	}
-	if m.Action != 0 {
-		n += 1 + sovBlocks(uint64(m.Action))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovBlocks(uint64(l))
 	}
 	return n
 }
 
-func (m *CalcResponse) Size() (n int) {
+func (m *ManualAction) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovBlocks(uint64(m.State))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
 	}
-	if m.N != 0 {
-		n += 1 + sovBlocks(uint64(m.N))
+	l = len(m.BlockID)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovBlocks(uint64(m.Action))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBlocks(uint64(m.Status))
+	}
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.DecidedBy)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.OrderID)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.DecidedAt)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	return n
+}
+
+func (m *ListManualActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockID)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.PendingOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *ManualActionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovBlocks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecideManualActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.Approve {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	return n
+}
+
+func (m *CalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovBlocks(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *CalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovBlocks(uint64(m.State))
+	}
+	if m.N != 0 {
+		n += 1 + sovBlocks(uint64(m.N))
+	}
+	return n
+}
+
+func (m *PnL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockID)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.Realised != 0 {
+		n += 9
+	}
+	if m.Unrealised != 0 {
+		n += 9
+	}
+	if m.Fees != 0 {
+		n += 9
+	}
+	if m.Invested != 0 {
+		n += 9
+	}
+	if m.Roi != 0 {
+		n += 9
+	}
+	if m.Position != 0 {
+		n += 9
+	}
+	if m.CurrentPrice != 0 {
+		n += 5
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.PriceUnavailable {
+		n += 2
+	}
+	return n
+}
+
+func (m *AccountPnLRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AccountPnLResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBlocks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PnLSeriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovBlocks(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *PnLPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovBlocks(uint64(m.Timestamp))
+	}
+	if m.Realised != 0 {
+		n += 9
+	}
+	if m.Unrealised != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *PnLSeriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovBlocks(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlocks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlocks(x uint64) (n int) {
+	return sovBlocks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseUnits", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BaseUnits = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentUnits", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CurrentUnits = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Purchase = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= BlockState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchDuration", wireType)
+			}
+			m.WatchDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortSellAllowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShortSellAllowed = bool(v != 0)
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoutPercentage", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.BackoutPercentage = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOrder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= orders.Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &orders.Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ManualAction{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManualAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManualAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManualAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= orders.Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ManualActionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecidedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecidedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListManualActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListManualActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListManualActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManualActionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManualActionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManualActionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &ManualAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovBlocks(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBlocks(x uint64) (n int) {
-	return sovBlocks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *DecideManualActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,10 +4235,10 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecideManualActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecideManualActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1415,10 +4274,10 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategyId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -1428,62 +4287,70 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBlocks
+			m.Approve = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StrategyId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseUnits", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.BaseUnits = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentUnits", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CurrentUnits = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Purchase = float32(math.Float32frombits(v))
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -1493,96 +4360,133 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= BlockState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchDuration", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocks
 			}
-			m.WatchDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBlocks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WatchDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortSellAllowed", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBlocks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ShortSellAllowed = bool(v != 0)
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackoutPercentage", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.BackoutPercentage = float32(math.Float32frombits(v))
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBlocks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Market = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1610,13 +4514,13 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instrument = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -1626,23 +4530,27 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlocks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1668,7 +4576,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *CalcRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1691,17 +4599,17 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalcRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -1711,24 +4619,47 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlocks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= strategy.Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -1753,7 +4684,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
+func (m *CalcResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1776,12 +4707,50 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalcResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= BlockState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			m.N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.N |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -1806,7 +4775,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResponse) Unmarshal(dAtA []byte) error {
+func (m *PnL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1829,17 +4798,17 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PnL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PnL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -1849,82 +4818,104 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlocks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &Block{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBlocks(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realised", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBlocks
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBlocks
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Realised = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrealised", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManualRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBlocks
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Unrealised = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Fees = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invested", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManualRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Invested = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roi", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Roi = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Position = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.CurrentPrice = float32(math.Float32frombits(v))
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1952,13 +4943,13 @@ func (m *ManualRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Since = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUnavailable", wireType)
 			}
-			m.Action = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -1968,11 +4959,12 @@ func (m *ManualRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= orders.Action(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.PriceUnavailable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -1997,7 +4989,7 @@ func (m *ManualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManualResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountPnLRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,48 +5012,12 @@ func (m *ManualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountPnLRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountPnLRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBlocks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBlocks
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBlocks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Order == nil {
-				m.Order = &orders.Order{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -2086,7 +5042,7 @@ func (m *ManualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountPnLResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2109,17 +5065,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountPnLResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountPnLResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -2129,77 +5085,62 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlocks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBlocks(dAtA[iNdEx:])
-			if err != nil {
+			if m.Total == nil {
+				m.Total = &PnL{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBlocks
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBlocks
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBlocks
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Blocks = append(m.Blocks, &PnL{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -2224,7 +5165,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *PnLSeriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2247,10 +5188,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PnLSeriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PnLSeriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2287,9 +5228,9 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -2299,28 +5240,43 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBlocks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlocks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -2345,7 +5301,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CalcRequest) Unmarshal(dAtA []byte) error {
+func (m *PnLPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2368,17 +5324,17 @@ func (m *CalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PnLPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PnLPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -2388,47 +5344,33 @@ func (m *CalcRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBlocks
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBlocks
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realised", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Realised = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrealised", wireType)
 			}
-			m.Action = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBlocks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Action |= strategy.Action(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Unrealised = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
@@ -2453,7 +5395,7 @@ func (m *CalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CalcResponse) Unmarshal(dAtA []byte) error {
+func (m *PnLSeriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2476,17 +5418,17 @@ func (m *CalcResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PnLSeriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PnLSeriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlocks
@@ -2496,30 +5438,26 @@ func (m *CalcResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= BlockState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
 			}
-			m.N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBlocks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.N |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &PnLPoint{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlocks(dAtA[iNdEx:])
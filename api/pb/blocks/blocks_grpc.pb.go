@@ -7,6 +7,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	orders "pm.tcfw.com.au/source/ataas/api/pb/orders"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -23,9 +24,15 @@ type BlocksServiceClient interface {
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Block, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Block, error)
 	ManualAction(ctx context.Context, in *ManualRequest, opts ...grpc.CallOption) (*ManualResponse, error)
+	ListManualActions(ctx context.Context, in *ListManualActionsRequest, opts ...grpc.CallOption) (*ManualActionList, error)
+	DecideManualAction(ctx context.Context, in *DecideManualActionRequest, opts ...grpc.CallOption) (*ManualAction, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	PnL(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PnL, error)
+	AccountPnL(ctx context.Context, in *AccountPnLRequest, opts ...grpc.CallOption) (*AccountPnLResponse, error)
+	PnLSeries(ctx context.Context, in *PnLSeriesRequest, opts ...grpc.CallOption) (*PnLSeriesResponse, error)
 	CalcState(ctx context.Context, in *CalcRequest, opts ...grpc.CallOption) (*CalcResponse, error)
 	Find(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Block, error)
+	ApplyOrder(ctx context.Context, in *orders.Order, opts ...grpc.CallOption) (*Block, error)
 }
 
 type blocksServiceClient struct {
@@ -81,6 +88,24 @@ func (c *blocksServiceClient) ManualAction(ctx context.Context, in *ManualReques
 	return out, nil
 }
 
+func (c *blocksServiceClient) ListManualActions(ctx context.Context, in *ListManualActionsRequest, opts ...grpc.CallOption) (*ManualActionList, error) {
+	out := new(ManualActionList)
+	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/ListManualActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blocksServiceClient) DecideManualAction(ctx context.Context, in *DecideManualActionRequest, opts ...grpc.CallOption) (*ManualAction, error) {
+	out := new(ManualAction)
+	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/DecideManualAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blocksServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
 	out := new(DeleteResponse)
 	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/Delete", in, out, opts...)
@@ -90,6 +115,33 @@ func (c *blocksServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *blocksServiceClient) PnL(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PnL, error) {
+	out := new(PnL)
+	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/PnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blocksServiceClient) AccountPnL(ctx context.Context, in *AccountPnLRequest, opts ...grpc.CallOption) (*AccountPnLResponse, error) {
+	out := new(AccountPnLResponse)
+	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/AccountPnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blocksServiceClient) PnLSeries(ctx context.Context, in *PnLSeriesRequest, opts ...grpc.CallOption) (*PnLSeriesResponse, error) {
+	out := new(PnLSeriesResponse)
+	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/PnLSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blocksServiceClient) CalcState(ctx context.Context, in *CalcRequest, opts ...grpc.CallOption) (*CalcResponse, error) {
 	out := new(CalcResponse)
 	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/CalcState", in, out, opts...)
@@ -108,6 +160,15 @@ func (c *blocksServiceClient) Find(ctx context.Context, in *GetRequest, opts ...
 	return out, nil
 }
 
+func (c *blocksServiceClient) ApplyOrder(ctx context.Context, in *orders.Order, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/ataas.blocks.BlocksService/ApplyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlocksServiceServer is the server API for BlocksService service.
 // All implementations must embed UnimplementedBlocksServiceServer
 // for forward compatibility
@@ -117,9 +178,15 @@ type BlocksServiceServer interface {
 	Get(context.Context, *GetRequest) (*Block, error)
 	Update(context.Context, *UpdateRequest) (*Block, error)
 	ManualAction(context.Context, *ManualRequest) (*ManualResponse, error)
+	ListManualActions(context.Context, *ListManualActionsRequest) (*ManualActionList, error)
+	DecideManualAction(context.Context, *DecideManualActionRequest) (*ManualAction, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	PnL(context.Context, *GetRequest) (*PnL, error)
+	AccountPnL(context.Context, *AccountPnLRequest) (*AccountPnLResponse, error)
+	PnLSeries(context.Context, *PnLSeriesRequest) (*PnLSeriesResponse, error)
 	CalcState(context.Context, *CalcRequest) (*CalcResponse, error)
 	Find(context.Context, *GetRequest) (*Block, error)
+	ApplyOrder(context.Context, *orders.Order) (*Block, error)
 	mustEmbedUnimplementedBlocksServiceServer()
 }
 
@@ -142,15 +209,33 @@ func (UnimplementedBlocksServiceServer) Update(context.Context, *UpdateRequest)
 func (UnimplementedBlocksServiceServer) ManualAction(context.Context, *ManualRequest) (*ManualResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManualAction not implemented")
 }
+func (UnimplementedBlocksServiceServer) ListManualActions(context.Context, *ListManualActionsRequest) (*ManualActionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManualActions not implemented")
+}
+func (UnimplementedBlocksServiceServer) DecideManualAction(context.Context, *DecideManualActionRequest) (*ManualAction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideManualAction not implemented")
+}
 func (UnimplementedBlocksServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedBlocksServiceServer) PnL(context.Context, *GetRequest) (*PnL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PnL not implemented")
+}
+func (UnimplementedBlocksServiceServer) AccountPnL(context.Context, *AccountPnLRequest) (*AccountPnLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPnL not implemented")
+}
+func (UnimplementedBlocksServiceServer) PnLSeries(context.Context, *PnLSeriesRequest) (*PnLSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PnLSeries not implemented")
+}
 func (UnimplementedBlocksServiceServer) CalcState(context.Context, *CalcRequest) (*CalcResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcState not implemented")
 }
 func (UnimplementedBlocksServiceServer) Find(context.Context, *GetRequest) (*Block, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
 }
+func (UnimplementedBlocksServiceServer) ApplyOrder(context.Context, *orders.Order) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyOrder not implemented")
+}
 func (UnimplementedBlocksServiceServer) mustEmbedUnimplementedBlocksServiceServer() {}
 
 // UnsafeBlocksServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +339,42 @@ func _BlocksService_ManualAction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlocksService_ListManualActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManualActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocksServiceServer).ListManualActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.blocks.BlocksService/ListManualActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocksServiceServer).ListManualActions(ctx, req.(*ListManualActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlocksService_DecideManualAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideManualActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocksServiceServer).DecideManualAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.blocks.BlocksService/DecideManualAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocksServiceServer).DecideManualAction(ctx, req.(*DecideManualActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlocksService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +393,60 @@ func _BlocksService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlocksService_PnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocksServiceServer).PnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.blocks.BlocksService/PnL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocksServiceServer).PnL(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlocksService_AccountPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountPnLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocksServiceServer).AccountPnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.blocks.BlocksService/AccountPnL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocksServiceServer).AccountPnL(ctx, req.(*AccountPnLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlocksService_PnLSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PnLSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocksServiceServer).PnLSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.blocks.BlocksService/PnLSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocksServiceServer).PnLSeries(ctx, req.(*PnLSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlocksService_CalcState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalcRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +483,24 @@ func _BlocksService_Find_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlocksService_ApplyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(orders.Order)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocksServiceServer).ApplyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.blocks.BlocksService/ApplyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocksServiceServer).ApplyOrder(ctx, req.(*orders.Order))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlocksService_ServiceDesc is the grpc.ServiceDesc for BlocksService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -335,10 +528,30 @@ var BlocksService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ManualAction",
 			Handler:    _BlocksService_ManualAction_Handler,
 		},
+		{
+			MethodName: "ListManualActions",
+			Handler:    _BlocksService_ListManualActions_Handler,
+		},
+		{
+			MethodName: "DecideManualAction",
+			Handler:    _BlocksService_DecideManualAction_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _BlocksService_Delete_Handler,
 		},
+		{
+			MethodName: "PnL",
+			Handler:    _BlocksService_PnL_Handler,
+		},
+		{
+			MethodName: "AccountPnL",
+			Handler:    _BlocksService_AccountPnL_Handler,
+		},
+		{
+			MethodName: "PnLSeries",
+			Handler:    _BlocksService_PnLSeries_Handler,
+		},
 		{
 			MethodName: "CalcState",
 			Handler:    _BlocksService_CalcState_Handler,
@@ -347,6 +560,10 @@ var BlocksService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Find",
 			Handler:    _BlocksService_Find_Handler,
 		},
+		{
+			MethodName: "ApplyOrder",
+			Handler:    _BlocksService_ApplyOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blocks.proto",
@@ -24,20 +24,109 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type Environment int32
+
+const (
+	Environment_PRODUCTION Environment = 0
+	Environment_TESTNET    Environment = 1
+)
+
+var Environment_name = map[int32]string{
+	0: "PRODUCTION",
+	1: "TESTNET",
+}
+
+var Environment_value = map[string]int32{
+	"PRODUCTION": 0,
+	"TESTNET":    1,
+}
+
+func (x Environment) String() string {
+	return proto.EnumName(Environment_name, int32(x))
+}
+
+func (Environment) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9fa1ad3351137f0f, []int{0}
+}
+
+type Permissions struct {
+	Read      bool `protobuf:"varint,1,opt,name=read,proto3" json:"read,omitempty"`
+	SpotTrade bool `protobuf:"varint,2,opt,name=spotTrade,proto3" json:"spotTrade,omitempty"`
+	Withdraw  bool `protobuf:"varint,3,opt,name=withdraw,proto3" json:"withdraw,omitempty"`
+}
+
+func (m *Permissions) Reset()         { *m = Permissions{} }
+func (m *Permissions) String() string { return proto.CompactTextString(m) }
+func (*Permissions) ProtoMessage()    {}
+func (*Permissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa1ad3351137f0f, []int{0}
+}
+func (m *Permissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Permissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Permissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permissions.Merge(m, src)
+}
+func (m *Permissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *Permissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permissions proto.InternalMessageInfo
+
+func (m *Permissions) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *Permissions) GetSpotTrade() bool {
+	if m != nil {
+		return m.SpotTrade
+	}
+	return false
+}
+
+func (m *Permissions) GetWithdraw() bool {
+	if m != nil {
+		return m.Withdraw
+	}
+	return false
+}
+
 type ExchangeCreds struct {
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Account   string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Exchange  string `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Key       string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	Secret    string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
-	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account     string       `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Exchange    string       `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Key         string       `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Secret      string       `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt   string       `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Environment Environment  `protobuf:"varint,7,opt,name=environment,proto3,enum=ataas.excreds.Environment" json:"environment,omitempty"`
+	Endpoint    string       `protobuf:"bytes,8,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Permissions *Permissions `protobuf:"bytes,9,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	CheckedAt   string       `protobuf:"bytes,10,opt,name=checkedAt,proto3" json:"checkedAt,omitempty"`
 }
 
 func (m *ExchangeCreds) Reset()         { *m = ExchangeCreds{} }
 func (m *ExchangeCreds) String() string { return proto.CompactTextString(m) }
 func (*ExchangeCreds) ProtoMessage()    {}
 func (*ExchangeCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fa1ad3351137f0f, []int{0}
+	return fileDescriptor_9fa1ad3351137f0f, []int{1}
 }
 func (m *ExchangeCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,6 +197,34 @@ func (m *ExchangeCreds) GetCreatedAt() string {
 	return ""
 }
 
+func (m *ExchangeCreds) GetEnvironment() Environment {
+	if m != nil {
+		return m.Environment
+	}
+	return Environment_PRODUCTION
+}
+
+func (m *ExchangeCreds) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *ExchangeCreds) GetPermissions() *Permissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *ExchangeCreds) GetCheckedAt() string {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return ""
+}
+
 type ListRequest struct {
 }
 
@@ -115,7 +232,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fa1ad3351137f0f, []int{1}
+	return fileDescriptor_9fa1ad3351137f0f, []int{2}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +269,7 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fa1ad3351137f0f, []int{2}
+	return fileDescriptor_9fa1ad3351137f0f, []int{3}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +315,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fa1ad3351137f0f, []int{3}
+	return fileDescriptor_9fa1ad3351137f0f, []int{4}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +373,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fa1ad3351137f0f, []int{4}
+	return fileDescriptor_9fa1ad3351137f0f, []int{5}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +416,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fa1ad3351137f0f, []int{5}
+	return fileDescriptor_9fa1ad3351137f0f, []int{6}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,49 +445,263 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+type TestRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *TestRequest) Reset()         { *m = TestRequest{} }
+func (m *TestRequest) String() string { return proto.CompactTextString(m) }
+func (*TestRequest) ProtoMessage()    {}
+func (*TestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa1ad3351137f0f, []int{7}
+}
+func (m *TestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestRequest.Merge(m, src)
+}
+func (m *TestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestRequest proto.InternalMessageInfo
+
+func (m *TestRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RotateKeysRequest struct {
+}
+
+func (m *RotateKeysRequest) Reset()         { *m = RotateKeysRequest{} }
+func (m *RotateKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateKeysRequest) ProtoMessage()    {}
+func (*RotateKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa1ad3351137f0f, []int{8}
+}
+func (m *RotateKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeysRequest.Merge(m, src)
+}
+func (m *RotateKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKeysRequest proto.InternalMessageInfo
+
+type RotateKeysResponse struct {
+	KeyVersion uint32 `protobuf:"varint,1,opt,name=keyVersion,proto3" json:"keyVersion,omitempty"`
+	Rewrapped  int32  `protobuf:"varint,2,opt,name=rewrapped,proto3" json:"rewrapped,omitempty"`
+	Failed     int32  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *RotateKeysResponse) Reset()         { *m = RotateKeysResponse{} }
+func (m *RotateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateKeysResponse) ProtoMessage()    {}
+func (*RotateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa1ad3351137f0f, []int{9}
+}
+func (m *RotateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeysResponse.Merge(m, src)
+}
+func (m *RotateKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKeysResponse proto.InternalMessageInfo
+
+func (m *RotateKeysResponse) GetKeyVersion() uint32 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
+func (m *RotateKeysResponse) GetRewrapped() int32 {
+	if m != nil {
+		return m.Rewrapped
+	}
+	return 0
+}
+
+func (m *RotateKeysResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ataas.excreds.Environment", Environment_name, Environment_value)
+	proto.RegisterType((*Permissions)(nil), "ataas.excreds.Permissions")
 	proto.RegisterType((*ExchangeCreds)(nil), "ataas.excreds.ExchangeCreds")
 	proto.RegisterType((*ListRequest)(nil), "ataas.excreds.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "ataas.excreds.ListResponse")
 	proto.RegisterType((*GetRequest)(nil), "ataas.excreds.GetRequest")
 	proto.RegisterType((*DeleteRequest)(nil), "ataas.excreds.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "ataas.excreds.DeleteResponse")
+	proto.RegisterType((*TestRequest)(nil), "ataas.excreds.TestRequest")
+	proto.RegisterType((*RotateKeysRequest)(nil), "ataas.excreds.RotateKeysRequest")
+	proto.RegisterType((*RotateKeysResponse)(nil), "ataas.excreds.RotateKeysResponse")
 }
 
 func init() { proto.RegisterFile("excreds.proto", fileDescriptor_9fa1ad3351137f0f) }
 
 var fileDescriptor_9fa1ad3351137f0f = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0xcd, 0xda, 0x6d, 0xda, 0x4e, 0x3e, 0x47, 0xd1, 0x7c, 0x52, 0xb5, 0x98, 0x60, 0xaa, 0x3d,
-	0x55, 0x39, 0xd8, 0x22, 0xdc, 0x38, 0x20, 0x51, 0x5a, 0xf5, 0x82, 0x38, 0x04, 0x2e, 0x20, 0x24,
-	0xb4, 0x5d, 0x0f, 0xc6, 0xa2, 0xf5, 0x1a, 0x7b, 0xd3, 0xa6, 0x42, 0x5c, 0xf8, 0x05, 0x48, 0x9c,
-	0xf9, 0x3f, 0x1c, 0x2b, 0x71, 0xe1, 0x88, 0x12, 0x7e, 0x08, 0xca, 0xda, 0xa6, 0x49, 0xa0, 0xb9,
-	0xed, 0xbc, 0x37, 0xf3, 0x3c, 0xef, 0x59, 0x03, 0x1e, 0x4d, 0x54, 0x41, 0x71, 0x19, 0xe6, 0x85,
-	0x36, 0x1a, 0x3d, 0x69, 0xa4, 0x2c, 0xc3, 0x1a, 0xf4, 0xfb, 0x89, 0xd6, 0xc9, 0x29, 0x45, 0x32,
-	0x4f, 0x23, 0x99, 0x65, 0xda, 0x48, 0x93, 0xea, 0xac, 0x6e, 0xf6, 0x21, 0xd1, 0x89, 0xae, 0xde,
-	0xe2, 0x2b, 0x03, 0xef, 0x68, 0xa2, 0xde, 0xca, 0x2c, 0xa1, 0xc7, 0xf3, 0x59, 0xec, 0x82, 0x93,
-	0xc6, 0x9c, 0xed, 0xb1, 0xfd, 0x9d, 0x91, 0x93, 0xc6, 0xc8, 0x61, 0x4b, 0x2a, 0xa5, 0xc7, 0x99,
-	0xe1, 0x8e, 0x05, 0x9b, 0x12, 0x7d, 0xd8, 0xa6, 0x7a, 0x94, 0xbb, 0x96, 0xfa, 0x53, 0x63, 0x0f,
-	0xdc, 0x77, 0x74, 0xc9, 0x37, 0x2c, 0x3c, 0x7f, 0xe2, 0x2e, 0xb4, 0x4b, 0x52, 0x05, 0x19, 0xbe,
-	0x69, 0xc1, 0xba, 0xc2, 0x3e, 0xec, 0xa8, 0x82, 0xa4, 0xa1, 0xf8, 0x91, 0xe1, 0x6d, 0x4b, 0x5d,
-	0x03, 0xc2, 0x83, 0xce, 0x93, 0xb4, 0x34, 0x23, 0x7a, 0x3f, 0xa6, 0xd2, 0x88, 0x03, 0xf8, 0xaf,
-	0x2a, 0xcb, 0x5c, 0x67, 0x25, 0xe1, 0x10, 0x36, 0xad, 0x63, 0xce, 0xf6, 0xdc, 0xfd, 0xce, 0xb0,
-	0x1f, 0x2e, 0xe5, 0x10, 0x2e, 0x39, 0x1b, 0x55, 0xad, 0xe2, 0x15, 0xc0, 0x31, 0x35, 0x8a, 0x8b,
-	0xf6, 0xd8, 0xcd, 0xf6, 0x9c, 0x15, 0x7b, 0x1c, 0xb6, 0x62, 0x52, 0xc5, 0x65, 0x6e, 0xac, 0xf3,
-	0xed, 0x51, 0x53, 0x8a, 0xbb, 0xe0, 0x1d, 0xd2, 0x29, 0x19, 0x6a, 0x3e, 0xb0, 0x92, 0xa7, 0xe8,
-	0x41, 0xb7, 0x69, 0xa8, 0x4c, 0x0c, 0xa7, 0x0e, 0x74, 0x8f, 0x26, 0x76, 0xc7, 0x67, 0x54, 0x9c,
-	0xa7, 0x8a, 0xf0, 0x05, 0xb8, 0x4f, 0xe9, 0x02, 0xd7, 0xfa, 0xf1, 0xd7, 0xb2, 0x62, 0xf7, 0xd3,
-	0xf7, 0x5f, 0x5f, 0x9c, 0x9e, 0xe8, 0x44, 0xe7, 0xf7, 0xa2, 0xba, 0xe5, 0x01, 0x1b, 0xe0, 0x73,
-	0xd8, 0x98, 0x47, 0x88, 0xfe, 0xca, 0xf4, 0x42, 0xcc, 0xfe, 0xed, 0x7f, 0x72, 0xd5, 0xba, 0xe2,
-	0x7f, 0x2b, 0xec, 0xe1, 0xa2, 0x30, 0xbe, 0x86, 0x76, 0xe5, 0xea, 0xaf, 0x9d, 0x97, 0xd2, 0xf0,
-	0xef, 0xdc, 0xc0, 0xd6, 0xda, 0xdc, 0x6a, 0xe3, 0xa0, 0xb7, 0xa0, 0x1d, 0x7d, 0x48, 0xe3, 0x8f,
-	0xf8, 0x10, 0xdc, 0x63, 0x32, 0x78, 0x6b, 0x65, 0xfe, 0xfa, 0x4f, 0xae, 0x8f, 0xe3, 0xe0, 0xf0,
-	0xdb, 0x34, 0x60, 0x57, 0xd3, 0x80, 0xfd, 0x9c, 0x06, 0xec, 0xf3, 0x2c, 0x68, 0x5d, 0xcd, 0x82,
-	0xd6, 0x8f, 0x59, 0xd0, 0x7a, 0x39, 0xc8, 0xcf, 0x42, 0xa3, 0xde, 0x5c, 0x84, 0x4a, 0x9f, 0x85,
-	0x72, 0x1c, 0x95, 0x7a, 0x5c, 0x28, 0x8a, 0xac, 0x98, 0xbd, 0xa0, 0xfc, 0xa4, 0x59, 0xe5, 0xa4,
-	0x6d, 0xaf, 0xe6, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x51, 0xa4, 0x11, 0x7f, 0x03,
-	0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xf3, 0x6c, 0xae, 0x49, 0x14, 0xa6, 0xa2, 0x18, 0xd3, 0x86, 0xe0, 0x55, 0x94, 0x45,
+	0x2c, 0xc2, 0xae, 0x42, 0x48, 0xb4, 0x8d, 0x2a, 0x04, 0x6a, 0x2b, 0xd7, 0x20, 0xf1, 0x90, 0xd0,
+	0xd4, 0xbe, 0x4d, 0x4d, 0x1b, 0x8f, 0x19, 0x4f, 0x9a, 0x46, 0x88, 0x0d, 0x5f, 0x80, 0xc4, 0xcf,
+	0xf0, 0x09, 0x2c, 0x2b, 0x75, 0xc3, 0x12, 0xb5, 0x7c, 0x08, 0xf2, 0xc4, 0x6e, 0x5c, 0xf7, 0x01,
+	0xbb, 0xb9, 0xf7, 0x1e, 0x9f, 0x39, 0x73, 0xee, 0x91, 0xa1, 0x86, 0x47, 0x0e, 0x47, 0x37, 0xec,
+	0x06, 0x9c, 0x09, 0x46, 0x6a, 0x54, 0x50, 0x1a, 0x76, 0xe3, 0xa6, 0xbe, 0x38, 0x60, 0x6c, 0x70,
+	0x80, 0x26, 0x0d, 0x3c, 0x93, 0xfa, 0x3e, 0x13, 0x54, 0x78, 0xcc, 0x8f, 0xc1, 0x3a, 0x0c, 0xd8,
+	0x80, 0x4d, 0xcf, 0xc6, 0x3b, 0x50, 0xb7, 0x90, 0x0f, 0xbd, 0x30, 0x8c, 0x00, 0x84, 0x40, 0x91,
+	0x23, 0x75, 0x35, 0xa5, 0xa5, 0xb4, 0xe7, 0x2c, 0x79, 0x26, 0x8b, 0x50, 0x0d, 0x03, 0x26, 0x6c,
+	0x4e, 0x5d, 0xd4, 0xf2, 0x72, 0x30, 0x6b, 0x10, 0x1d, 0xe6, 0xc6, 0x9e, 0xd8, 0x73, 0x39, 0x1d,
+	0x6b, 0x05, 0x39, 0x3c, 0xaf, 0x8d, 0x93, 0x3c, 0xd4, 0xfa, 0x47, 0xce, 0x1e, 0xf5, 0x07, 0xb8,
+	0x1a, 0x09, 0x23, 0x75, 0xc8, 0x7b, 0x53, 0xf6, 0xaa, 0x95, 0xf7, 0x5c, 0xa2, 0x41, 0x85, 0x3a,
+	0x0e, 0x1b, 0xf9, 0x42, 0x32, 0x57, 0xad, 0xa4, 0x8c, 0x78, 0x31, 0xfe, 0x54, 0xf2, 0x56, 0xad,
+	0xf3, 0x9a, 0x34, 0xa0, 0xb0, 0x8f, 0x13, 0xad, 0x28, 0xdb, 0xd1, 0x91, 0x2c, 0x40, 0x39, 0x44,
+	0x87, 0xa3, 0xd0, 0x4a, 0xb2, 0x19, 0x57, 0x91, 0x76, 0x87, 0x23, 0x15, 0xe8, 0x3e, 0x13, 0x5a,
+	0x59, 0x8e, 0x66, 0x0d, 0xf2, 0x04, 0x54, 0xf4, 0x0f, 0x3d, 0xce, 0xfc, 0x21, 0xfa, 0x42, 0xab,
+	0xb4, 0x94, 0x76, 0xbd, 0xa7, 0x77, 0x2f, 0x78, 0xd9, 0xed, 0xcf, 0x10, 0x56, 0x1a, 0x2e, 0x15,
+	0xfa, 0x6e, 0xc0, 0x3c, 0x5f, 0x68, 0x73, 0xb1, 0xc2, 0xb8, 0x8e, 0x98, 0x83, 0x99, 0xad, 0x5a,
+	0xb5, 0xa5, 0xb4, 0xd5, 0x4b, 0xcc, 0x29, 0xe3, 0xad, 0x34, 0x5c, 0xaa, 0xde, 0x43, 0x67, 0x5f,
+	0xaa, 0x86, 0x58, 0x75, 0xd2, 0x30, 0x6a, 0xa0, 0xbe, 0xf4, 0x42, 0x61, 0xe1, 0xa7, 0x11, 0x86,
+	0xc2, 0x58, 0x81, 0x5b, 0xd3, 0x32, 0x0c, 0x98, 0x1f, 0x22, 0xe9, 0x41, 0x49, 0xd2, 0x6b, 0x4a,
+	0xab, 0xd0, 0x56, 0x7b, 0x8b, 0xd9, 0xe7, 0xa4, 0xf7, 0x61, 0x4d, 0xa1, 0xc6, 0x7b, 0x80, 0x75,
+	0x4c, 0x18, 0xd3, 0x4b, 0x51, 0xae, 0x5f, 0x4a, 0x3e, 0xb3, 0x14, 0x0d, 0x2a, 0x2e, 0x3a, 0x7c,
+	0x12, 0x88, 0x38, 0x07, 0x49, 0x69, 0x3c, 0x80, 0xda, 0x1a, 0x1e, 0xa0, 0xc0, 0xe4, 0x82, 0x4c,
+	0x0a, 0x8c, 0x06, 0xd4, 0x13, 0xc0, 0xf4, 0x11, 0xc6, 0x12, 0xa8, 0x36, 0x86, 0xe2, 0xba, 0x0f,
+	0xe6, 0xe1, 0xb6, 0x15, 0x85, 0x1a, 0x5f, 0xe0, 0x24, 0x4c, 0x8c, 0xf8, 0x08, 0x24, 0xdd, 0x8c,
+	0xed, 0x68, 0x02, 0xec, 0xe3, 0xe4, 0x35, 0xf2, 0xc8, 0x5a, 0x49, 0x51, 0xb3, 0x52, 0x9d, 0xc8,
+	0x6b, 0x8e, 0x63, 0x4e, 0x83, 0x00, 0x5d, 0xf9, 0xa6, 0x92, 0x35, 0x6b, 0x44, 0xb9, 0xda, 0xa5,
+	0xde, 0x01, 0xba, 0xf2, 0x4d, 0x25, 0x2b, 0xae, 0x3a, 0x1d, 0x50, 0x53, 0xb9, 0x20, 0x75, 0x80,
+	0x2d, 0x6b, 0x73, 0xed, 0xd5, 0xaa, 0xfd, 0x7c, 0x73, 0xa3, 0x91, 0x23, 0x2a, 0x54, 0xec, 0xfe,
+	0xb6, 0xbd, 0xd1, 0xb7, 0x1b, 0x4a, 0xef, 0x47, 0x11, 0xea, 0xfd, 0x23, 0xe9, 0xf7, 0x36, 0xf2,
+	0x43, 0xcf, 0x41, 0xf2, 0x06, 0x0a, 0x1b, 0x38, 0x26, 0x37, 0xee, 0x46, 0xbf, 0x71, 0x6a, 0x2c,
+	0x7c, 0x3d, 0xf9, 0xf3, 0x3d, 0xdf, 0x30, 0x54, 0xf3, 0xf0, 0x91, 0x19, 0x43, 0x96, 0x95, 0x0e,
+	0xb1, 0xa1, 0x18, 0xc5, 0x81, 0x64, 0xc3, 0x96, 0x8a, 0x8c, 0x7e, 0xff, 0xca, 0x59, 0x6c, 0xfd,
+	0xbc, 0x24, 0xae, 0x91, 0x34, 0x31, 0xf9, 0x00, 0xe5, 0xe9, 0x86, 0x2e, 0x69, 0xbe, 0xb0, 0x59,
+	0x7d, 0xe9, 0x9a, 0x69, 0xcc, 0xad, 0x49, 0x6e, 0xd2, 0x69, 0xa4, 0xb8, 0xcd, 0xcf, 0x9e, 0xfb,
+	0x85, 0xec, 0x40, 0xd1, 0xc6, 0x2b, 0x64, 0xa7, 0x52, 0xf0, 0x0f, 0x43, 0x5a, 0x92, 0x5b, 0x37,
+	0xee, 0x64, 0xb9, 0x4d, 0x81, 0xa1, 0x88, 0xac, 0x79, 0x0a, 0x85, 0x75, 0x14, 0xe4, 0x5e, 0x86,
+	0x66, 0x1d, 0xff, 0xef, 0x06, 0x12, 0x00, 0xcc, 0x02, 0x46, 0x5a, 0x19, 0xec, 0xa5, 0x40, 0xea,
+	0x0f, 0x6f, 0x40, 0x24, 0x39, 0x97, 0xa2, 0xef, 0x1a, 0x24, 0x2d, 0x9a, 0x4b, 0xdc, 0xb2, 0xd2,
+	0x59, 0x59, 0xfb, 0x79, 0xda, 0x54, 0x8e, 0x4f, 0x9b, 0xca, 0xef, 0xd3, 0xa6, 0xf2, 0xed, 0xac,
+	0x99, 0x3b, 0x3e, 0x6b, 0xe6, 0x7e, 0x9d, 0x35, 0x73, 0x6f, 0x3b, 0xc1, 0xb0, 0x2b, 0x9c, 0xdd,
+	0x71, 0xd7, 0x61, 0xc3, 0x2e, 0x1d, 0x99, 0x21, 0x1b, 0x71, 0x07, 0x4d, 0x79, 0xa1, 0xfc, 0xed,
+	0x07, 0x3b, 0x09, 0xdf, 0x4e, 0x59, 0xfe, 0xea, 0x1f, 0xff, 0x1d, 0x00, 0x0a, 0x4b, 0xb1, 0x91,
+	0x34, 0x06, 0x00, 0x00,
+}
+
+func (m *Permissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Permissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Withdraw {
+		i--
+		if m.Withdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpotTrade {
+		i--
+		if m.SpotTrade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Read {
+		i--
+		if m.Read {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *ExchangeCreds) Marshal() (dAtA []byte, err error) {
@@ -393,6 +724,37 @@ func (m *ExchangeCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CheckedAt) > 0 {
+		i -= len(m.CheckedAt)
+		copy(dAtA[i:], m.CheckedAt)
+		i = encodeVarintExcreds(dAtA, i, uint64(len(m.CheckedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExcreds(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintExcreds(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Environment != 0 {
+		i = encodeVarintExcreds(dAtA, i, uint64(m.Environment))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CreatedAt) > 0 {
 		i -= len(m.CreatedAt)
 		copy(dAtA[i:], m.CreatedAt)
@@ -598,36 +960,145 @@ func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintExcreds(dAtA []byte, offset int, v uint64) int {
-	offset -= sovExcreds(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ExchangeCreds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovExcreds(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovExcreds(uint64(l))
-	}
-	l = len(m.Exchange)
-	if l > 0 {
-		n += 1 + l + sovExcreds(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintExcreds(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Key)
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintExcreds(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rewrapped != 0 {
+		i = encodeVarintExcreds(dAtA, i, uint64(m.Rewrapped))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyVersion != 0 {
+		i = encodeVarintExcreds(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExcreds(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExcreds(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Read {
+		n += 2
+	}
+	if m.SpotTrade {
+		n += 2
+	}
+	if m.Withdraw {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExchangeCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovExcreds(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovExcreds(uint64(l))
+	}
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovExcreds(uint64(l))
+	}
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovExcreds(uint64(l))
 	}
@@ -639,6 +1110,21 @@ func (m *ExchangeCreds) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovExcreds(uint64(l))
 	}
+	if m.Environment != 0 {
+		n += 1 + sovExcreds(uint64(m.Environment))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovExcreds(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovExcreds(uint64(l))
+	}
+	l = len(m.CheckedAt)
+	if l > 0 {
+		n += 1 + l + sovExcreds(uint64(l))
+	}
 	return n
 }
 
@@ -708,12 +1194,165 @@ func (m *DeleteResponse) Size() (n int) {
 	return n
 }
 
+func (m *TestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovExcreds(uint64(l))
+	}
+	return n
+}
+
+func (m *RotateKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RotateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyVersion != 0 {
+		n += 1 + sovExcreds(uint64(m.KeyVersion))
+	}
+	if m.Rewrapped != 0 {
+		n += 1 + sovExcreds(uint64(m.Rewrapped))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovExcreds(uint64(m.Failed))
+	}
+	return n
+}
+
 func sovExcreds(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozExcreds(x uint64) (n int) {
 	return sovExcreds(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *Permissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExcreds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Read = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotTrade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpotTrade = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdraw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExcreds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExchangeCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -837,11 +1476,126 @@ func (m *ExchangeCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exchange = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			m.Environment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Environment |= Environment(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -869,13 +1623,13 @@ func (m *ExchangeCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExcreds
@@ -885,27 +1639,31 @@ func (m *ExchangeCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExcreds
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExcreds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secret = string(dAtA[iNdEx:postIndex])
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -933,7 +1691,7 @@ func (m *ExchangeCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.CheckedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1374,6 +2132,254 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExcreds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExcreds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExcreds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExcreds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExcreds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
+			}
+			m.KeyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewrapped", wireType)
+			}
+			m.Rewrapped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rewrapped |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExcreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExcreds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExcreds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipExcreds(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,7 +21,9 @@ type ExCredsServiceClient interface {
 	New(ctx context.Context, in *ExchangeCreds, opts ...grpc.CallOption) (*ExchangeCreds, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (*ExchangeCreds, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ExchangeCreds, error)
+	RotateKeys(ctx context.Context, in *RotateKeysRequest, opts ...grpc.CallOption) (*RotateKeysResponse, error)
 }
 
 type exCredsServiceClient struct {
@@ -59,6 +61,15 @@ func (c *exCredsServiceClient) Delete(ctx context.Context, in *DeleteRequest, op
 	return out, nil
 }
 
+func (c *exCredsServiceClient) Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (*ExchangeCreds, error) {
+	out := new(ExchangeCreds)
+	err := c.cc.Invoke(ctx, "/ataas.excreds.ExCredsService/Test", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *exCredsServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ExchangeCreds, error) {
 	out := new(ExchangeCreds)
 	err := c.cc.Invoke(ctx, "/ataas.excreds.ExCredsService/Get", in, out, opts...)
@@ -68,6 +79,15 @@ func (c *exCredsServiceClient) Get(ctx context.Context, in *GetRequest, opts ...
 	return out, nil
 }
 
+func (c *exCredsServiceClient) RotateKeys(ctx context.Context, in *RotateKeysRequest, opts ...grpc.CallOption) (*RotateKeysResponse, error) {
+	out := new(RotateKeysResponse)
+	err := c.cc.Invoke(ctx, "/ataas.excreds.ExCredsService/RotateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExCredsServiceServer is the server API for ExCredsService service.
 // All implementations must embed UnimplementedExCredsServiceServer
 // for forward compatibility
@@ -75,7 +95,9 @@ type ExCredsServiceServer interface {
 	New(context.Context, *ExchangeCreds) (*ExchangeCreds, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Test(context.Context, *TestRequest) (*ExchangeCreds, error)
 	Get(context.Context, *GetRequest) (*ExchangeCreds, error)
+	RotateKeys(context.Context, *RotateKeysRequest) (*RotateKeysResponse, error)
 	mustEmbedUnimplementedExCredsServiceServer()
 }
 
@@ -92,9 +114,15 @@ func (UnimplementedExCredsServiceServer) List(context.Context, *ListRequest) (*L
 func (UnimplementedExCredsServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedExCredsServiceServer) Test(context.Context, *TestRequest) (*ExchangeCreds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Test not implemented")
+}
 func (UnimplementedExCredsServiceServer) Get(context.Context, *GetRequest) (*ExchangeCreds, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedExCredsServiceServer) RotateKeys(context.Context, *RotateKeysRequest) (*RotateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKeys not implemented")
+}
 func (UnimplementedExCredsServiceServer) mustEmbedUnimplementedExCredsServiceServer() {}
 
 // UnsafeExCredsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +190,24 @@ func _ExCredsService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExCredsService_Test_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExCredsServiceServer).Test(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.excreds.ExCredsService/Test",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExCredsServiceServer).Test(ctx, req.(*TestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExCredsService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRequest)
 	if err := dec(in); err != nil {
@@ -180,6 +226,24 @@ func _ExCredsService_Get_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExCredsService_RotateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExCredsServiceServer).RotateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.excreds.ExCredsService/RotateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExCredsServiceServer).RotateKeys(ctx, req.(*RotateKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExCredsService_ServiceDesc is the grpc.ServiceDesc for ExCredsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,10 +263,18 @@ var ExCredsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ExCredsService_Delete_Handler,
 		},
+		{
+			MethodName: "Test",
+			Handler:    _ExCredsService_Test_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _ExCredsService_Get_Handler,
 		},
+		{
+			MethodName: "RotateKeys",
+			Handler:    _ExCredsService_RotateKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "excreds.proto",
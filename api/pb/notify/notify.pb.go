@@ -27,16 +27,20 @@ type SendRequest_MsgType int32
 const (
 	SendRequest_MARKETING SendRequest_MsgType = 0
 	SendRequest_BLOCK     SendRequest_MsgType = 1
+	//ACCOUNT messages are sent to the user uid, including pending users
+	SendRequest_ACCOUNT SendRequest_MsgType = 2
 )
 
 var SendRequest_MsgType_name = map[int32]string{
 	0: "MARKETING",
 	1: "BLOCK",
+	2: "ACCOUNT",
 }
 
 var SendRequest_MsgType_value = map[string]int32{
 	"MARKETING": 0,
 	"BLOCK":     1,
+	"ACCOUNT":   2,
 }
 
 func (x SendRequest_MsgType) String() string {
@@ -160,25 +164,26 @@ func init() {
 func init() { proto.RegisterFile("notify.proto", fileDescriptor_aba76cc4ebe272d4) }
 
 var fileDescriptor_aba76cc4ebe272d4 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xc9, 0xcb, 0x2f, 0xc9,
-	0x4c, 0xab, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x49, 0x2c, 0x49, 0x4c, 0x2c, 0xd6,
-	0x83, 0x88, 0x29, 0x2d, 0x65, 0xe4, 0xe2, 0x0e, 0x4e, 0xcd, 0x4b, 0x09, 0x4a, 0x2d, 0x2c, 0x4d,
-	0x2d, 0x2e, 0x11, 0x12, 0xe0, 0x62, 0x2e, 0xcd, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c,
-	0x02, 0x31, 0x85, 0x4c, 0xb9, 0x58, 0x4a, 0x2a, 0x0b, 0x52, 0x25, 0x98, 0x14, 0x18, 0x35, 0xf8,
-	0x8c, 0x14, 0xf5, 0x90, 0xb5, 0xeb, 0x21, 0x69, 0xd5, 0xf3, 0x2d, 0x4e, 0x0f, 0xa9, 0x2c, 0x48,
-	0x0d, 0x02, 0x2b, 0x17, 0x12, 0xe1, 0x62, 0x2d, 0xc9, 0x2c, 0xc9, 0x49, 0x95, 0x60, 0x06, 0x1b,
-	0x05, 0xe1, 0x08, 0x09, 0x71, 0xb1, 0x24, 0xe5, 0xa7, 0x54, 0x4a, 0xb0, 0x80, 0x05, 0xc1, 0x6c,
-	0x25, 0x65, 0x2e, 0x76, 0xa8, 0x56, 0x21, 0x5e, 0x2e, 0x4e, 0x5f, 0xc7, 0x20, 0x6f, 0xd7, 0x10,
-	0x4f, 0x3f, 0x77, 0x01, 0x06, 0x21, 0x4e, 0x2e, 0x56, 0x27, 0x1f, 0x7f, 0x67, 0x6f, 0x01, 0x46,
-	0x25, 0x3e, 0x2e, 0x1e, 0x88, 0x5d, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x7e, 0x5c, 0xbc,
-	0x7e, 0x60, 0x27, 0x04, 0xa7, 0x16, 0x95, 0x65, 0x26, 0xa7, 0x0a, 0xd9, 0x72, 0xb1, 0x80, 0x14,
-	0x08, 0x49, 0xe2, 0x74, 0xa0, 0x94, 0x14, 0x36, 0x29, 0x88, 0x79, 0x4e, 0xce, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x59, 0x90, 0xab, 0x57, 0x92, 0x9c, 0x56, 0xae,
-	0x97, 0x9c, 0x9f, 0xab, 0x97, 0x58, 0xaa, 0x5f, 0x9c, 0x5f, 0x5a, 0x94, 0x9c, 0xaa, 0x0f, 0x36,
-	0x4a, 0x3f, 0xb1, 0x20, 0x53, 0xbf, 0x20, 0x49, 0x1f, 0x62, 0x62, 0x12, 0x1b, 0x38, 0x84, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x99, 0x4b, 0x8e, 0x71, 0x01, 0x00, 0x00,
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbb, 0x4e, 0xf3, 0x30,
+	0x1c, 0xc5, 0xe3, 0x36, 0xfd, 0xaa, 0xfe, 0x7b, 0x51, 0x64, 0x7d, 0x43, 0xe8, 0x60, 0x95, 0x4e,
+	0x65, 0x71, 0x50, 0x11, 0x23, 0x43, 0x1b, 0x21, 0x84, 0x4a, 0x53, 0x29, 0x2d, 0x0b, 0x5b, 0x2e,
+	0x2e, 0x8a, 0x44, 0x63, 0x13, 0x3b, 0xa0, 0xbc, 0x05, 0xcf, 0xc2, 0x53, 0x30, 0x76, 0x64, 0x44,
+	0xc9, 0x8b, 0xa0, 0x3a, 0x0c, 0x1d, 0x60, 0x3b, 0x3e, 0x3e, 0xe7, 0xe7, 0x23, 0x43, 0x2f, 0xe5,
+	0x2a, 0xd9, 0x16, 0x54, 0x64, 0x5c, 0x71, 0xdc, 0x0b, 0x54, 0x10, 0x48, 0x5a, 0x7b, 0xe3, 0x77,
+	0x04, 0xdd, 0x35, 0x4b, 0x63, 0x9f, 0x3d, 0xe7, 0x4c, 0x2a, 0x6c, 0x41, 0x33, 0x4f, 0x62, 0x1b,
+	0x8d, 0xd0, 0xa4, 0xe3, 0x1f, 0x24, 0xbe, 0x04, 0x53, 0x15, 0x82, 0xd9, 0x8d, 0x11, 0x9a, 0x0c,
+	0xa6, 0xa7, 0xf4, 0xb8, 0x4e, 0x8f, 0xaa, 0x74, 0x29, 0x1f, 0x37, 0x85, 0x60, 0xbe, 0x8e, 0xe3,
+	0xff, 0xd0, 0x52, 0x89, 0x7a, 0x62, 0x76, 0x53, 0xa3, 0xea, 0x03, 0xc6, 0x60, 0x86, 0x3c, 0x2e,
+	0x6c, 0x53, 0x9b, 0x5a, 0x8f, 0xcf, 0xa1, 0xfd, 0x53, 0xc5, 0x7d, 0xe8, 0x2c, 0x67, 0xfe, 0xe2,
+	0x7a, 0x73, 0xeb, 0xdd, 0x58, 0x06, 0xee, 0x40, 0x6b, 0x7e, 0xb7, 0x72, 0x17, 0x16, 0xc2, 0x5d,
+	0x68, 0xcf, 0x5c, 0x77, 0x75, 0xef, 0x6d, 0xac, 0xc6, 0x78, 0x00, 0xbd, 0xfa, 0x61, 0x29, 0x78,
+	0x2a, 0xd9, 0xd4, 0x83, 0xbe, 0xa7, 0xf7, 0xac, 0x59, 0xf6, 0x92, 0x44, 0x0c, 0x5f, 0x81, 0x79,
+	0x08, 0xe0, 0x93, 0x3f, 0xd7, 0x0e, 0x87, 0xbf, 0x5d, 0xd5, 0xbc, 0xb9, 0xfb, 0x51, 0x12, 0xb4,
+	0x2f, 0x09, 0xfa, 0x2a, 0x09, 0x7a, 0xab, 0x88, 0xb1, 0xaf, 0x88, 0xf1, 0x59, 0x11, 0xe3, 0xe1,
+	0x4c, 0xec, 0xa8, 0x8a, 0xb6, 0xaf, 0x34, 0xe2, 0x3b, 0x1a, 0xe4, 0x8e, 0xe4, 0x79, 0x16, 0x31,
+	0x47, 0xa3, 0x9c, 0x40, 0x24, 0x8e, 0x08, 0x9d, 0x9a, 0x18, 0xfe, 0xd3, 0xdf, 0x7d, 0xf1, 0x3d,
+	0x00, 0x61, 0x09, 0x16, 0xa2, 0x7e, 0x01, 0x00, 0x00,
 }
 
 func (m *SendRequest) Marshal() (dAtA []byte, err error) {
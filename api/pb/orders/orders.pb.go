@@ -50,13 +50,56 @@ func (Action) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e0f5d4cf0fc9e41b, []int{0}
 }
 
+type OrderStatus int32
+
+const (
+	OrderStatus_OPEN             OrderStatus = 0
+	OrderStatus_PARTIALLY_FILLED OrderStatus = 1
+	OrderStatus_FILLED           OrderStatus = 2
+	OrderStatus_CANCELLED        OrderStatus = 3
+	OrderStatus_REJECTED         OrderStatus = 4
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "OPEN",
+	1: "PARTIALLY_FILLED",
+	2: "FILLED",
+	3: "CANCELLED",
+	4: "REJECTED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"OPEN":             0,
+	"PARTIALLY_FILLED": 1,
+	"FILLED":           2,
+	"CANCELLED":        3,
+	"REJECTED":         4,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{1}
+}
+
 type Order struct {
-	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Timestamp string  `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Action    Action  `protobuf:"varint,3,opt,name=action,proto3,enum=ataas.orders.Action" json:"action,omitempty"`
-	Units     float64 `protobuf:"fixed64,4,opt,name=units,proto3" json:"units,omitempty"`
-	Price     float32 `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	BlockID   string  `protobuf:"bytes,6,opt,name=blockID,proto3" json:"blockID,omitempty"`
+	Id              string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp       string      `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action          Action      `protobuf:"varint,3,opt,name=action,proto3,enum=ataas.orders.Action" json:"action,omitempty"`
+	Units           float64     `protobuf:"fixed64,4,opt,name=units,proto3" json:"units,omitempty"`
+	Price           float32     `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	BlockID         string      `protobuf:"bytes,6,opt,name=blockID,proto3" json:"blockID,omitempty"`
+	Status          OrderStatus `protobuf:"varint,7,opt,name=status,proto3,enum=ataas.orders.OrderStatus" json:"status,omitempty"`
+	FilledUnits     float64     `protobuf:"fixed64,8,opt,name=filledUnits,proto3" json:"filledUnits,omitempty"`
+	AvgPrice        float32     `protobuf:"fixed32,9,opt,name=avgPrice,proto3" json:"avgPrice,omitempty"`
+	Fees            float64     `protobuf:"fixed64,10,opt,name=fees,proto3" json:"fees,omitempty"`
+	ExchangeOrderID string      `protobuf:"bytes,11,opt,name=exchangeOrderID,proto3" json:"exchangeOrderID,omitempty"`
+	UpdatedAt       string      `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	FeeAsset        string      `protobuf:"bytes,13,opt,name=feeAsset,proto3" json:"feeAsset,omitempty"`
+	//createdBy the user who placed the order, empty for orders placed by strategies
+	CreatedBy string `protobuf:"bytes,14,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -134,6 +177,62 @@ func (m *Order) GetBlockID() string {
 	return ""
 }
 
+func (m *Order) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OrderStatus_OPEN
+}
+
+func (m *Order) GetFilledUnits() float64 {
+	if m != nil {
+		return m.FilledUnits
+	}
+	return 0
+}
+
+func (m *Order) GetAvgPrice() float32 {
+	if m != nil {
+		return m.AvgPrice
+	}
+	return 0
+}
+
+func (m *Order) GetFees() float64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *Order) GetExchangeOrderID() string {
+	if m != nil {
+		return m.ExchangeOrderID
+	}
+	return ""
+}
+
+func (m *Order) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *Order) GetFeeAsset() string {
+	if m != nil {
+		return m.FeeAsset
+	}
+	return ""
+}
+
+func (m *Order) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
 type GetRequest struct {
 	BlockID string `protobuf:"bytes,1,opt,name=blockID,proto3" json:"blockID,omitempty"`
 }
@@ -223,10 +322,11 @@ func (m *GetResponse) GetOrders() []*Order {
 }
 
 type CreateRequest struct {
-	BlockID string  `protobuf:"bytes,1,opt,name=blockID,proto3" json:"blockID,omitempty"`
-	Action  Action  `protobuf:"varint,2,opt,name=action,proto3,enum=ataas.orders.Action" json:"action,omitempty"`
-	Price   float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	Units   float64 `protobuf:"fixed64,4,opt,name=units,proto3" json:"units,omitempty"`
+	BlockID   string  `protobuf:"bytes,1,opt,name=blockID,proto3" json:"blockID,omitempty"`
+	Action    Action  `protobuf:"varint,2,opt,name=action,proto3,enum=ataas.orders.Action" json:"action,omitempty"`
+	Price     float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Units     float64 `protobuf:"fixed64,4,opt,name=units,proto3" json:"units,omitempty"`
+	CreatedBy string  `protobuf:"bytes,5,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
 }
 
 func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
@@ -290,6 +390,13 @@ func (m *CreateRequest) GetUnits() float64 {
 	return 0
 }
 
+func (m *CreateRequest) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
 type CreateResponse struct {
 	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
@@ -334,48 +441,342 @@ func (m *CreateResponse) GetOrder() *Order {
 	return nil
 }
 
+type ListOpenRequest struct {
+	BlockID string `protobuf:"bytes,1,opt,name=blockID,proto3" json:"blockID,omitempty"`
+}
+
+func (m *ListOpenRequest) Reset()         { *m = ListOpenRequest{} }
+func (m *ListOpenRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOpenRequest) ProtoMessage()    {}
+func (*ListOpenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{5}
+}
+func (m *ListOpenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOpenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOpenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListOpenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOpenRequest.Merge(m, src)
+}
+func (m *ListOpenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOpenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOpenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOpenRequest proto.InternalMessageInfo
+
+func (m *ListOpenRequest) GetBlockID() string {
+	if m != nil {
+		return m.BlockID
+	}
+	return ""
+}
+
+type CancelRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *CancelRequest) Reset()         { *m = CancelRequest{} }
+func (m *CancelRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRequest) ProtoMessage()    {}
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{6}
+}
+func (m *CancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRequest.Merge(m, src)
+}
+func (m *CancelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRequest proto.InternalMessageInfo
+
+func (m *CancelRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *CancelResponse) Reset()         { *m = CancelResponse{} }
+func (m *CancelResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelResponse) ProtoMessage()    {}
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{7}
+}
+func (m *CancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelResponse.Merge(m, src)
+}
+func (m *CancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelResponse proto.InternalMessageInfo
+
+func (m *CancelResponse) GetOrder() *Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+type PaperBalancesRequest struct {
+}
+
+func (m *PaperBalancesRequest) Reset()         { *m = PaperBalancesRequest{} }
+func (m *PaperBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*PaperBalancesRequest) ProtoMessage()    {}
+func (*PaperBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{8}
+}
+func (m *PaperBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaperBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaperBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaperBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaperBalancesRequest.Merge(m, src)
+}
+func (m *PaperBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaperBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaperBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaperBalancesRequest proto.InternalMessageInfo
+
+type PaperBalance struct {
+	Asset   string  `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *PaperBalance) Reset()         { *m = PaperBalance{} }
+func (m *PaperBalance) String() string { return proto.CompactTextString(m) }
+func (*PaperBalance) ProtoMessage()    {}
+func (*PaperBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{9}
+}
+func (m *PaperBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaperBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaperBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaperBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaperBalance.Merge(m, src)
+}
+func (m *PaperBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaperBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaperBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaperBalance proto.InternalMessageInfo
+
+func (m *PaperBalance) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *PaperBalance) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type PaperBalancesResponse struct {
+	Balances []*PaperBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (m *PaperBalancesResponse) Reset()         { *m = PaperBalancesResponse{} }
+func (m *PaperBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*PaperBalancesResponse) ProtoMessage()    {}
+func (*PaperBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{10}
+}
+func (m *PaperBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaperBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaperBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaperBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaperBalancesResponse.Merge(m, src)
+}
+func (m *PaperBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaperBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaperBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaperBalancesResponse proto.InternalMessageInfo
+
+func (m *PaperBalancesResponse) GetBalances() []*PaperBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ataas.orders.Action", Action_name, Action_value)
+	proto.RegisterEnum("ataas.orders.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterType((*Order)(nil), "ataas.orders.Order")
 	proto.RegisterType((*GetRequest)(nil), "ataas.orders.GetRequest")
 	proto.RegisterType((*GetResponse)(nil), "ataas.orders.GetResponse")
 	proto.RegisterType((*CreateRequest)(nil), "ataas.orders.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "ataas.orders.CreateResponse")
+	proto.RegisterType((*ListOpenRequest)(nil), "ataas.orders.ListOpenRequest")
+	proto.RegisterType((*CancelRequest)(nil), "ataas.orders.CancelRequest")
+	proto.RegisterType((*CancelResponse)(nil), "ataas.orders.CancelResponse")
+	proto.RegisterType((*PaperBalancesRequest)(nil), "ataas.orders.PaperBalancesRequest")
+	proto.RegisterType((*PaperBalance)(nil), "ataas.orders.PaperBalance")
+	proto.RegisterType((*PaperBalancesResponse)(nil), "ataas.orders.PaperBalancesResponse")
 }
 
 func init() { proto.RegisterFile("orders.proto", fileDescriptor_e0f5d4cf0fc9e41b) }
 
 var fileDescriptor_e0f5d4cf0fc9e41b = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6a, 0xd4, 0x40,
-	0x18, 0xdf, 0x2f, 0xe9, 0xa6, 0xf6, 0x6b, 0xbb, 0x96, 0x71, 0x95, 0x71, 0xbb, 0x84, 0x90, 0x83,
-	0xa4, 0x55, 0x12, 0x5c, 0x6f, 0xf5, 0x64, 0xab, 0x14, 0xa1, 0x20, 0xa4, 0x08, 0xda, 0xdb, 0x6c,
-	0x76, 0x5c, 0x82, 0xdd, 0x4c, 0xcc, 0x4c, 0xea, 0x41, 0xbc, 0xe8, 0x0b, 0x08, 0x3e, 0x85, 0x0f,
-	0x22, 0x78, 0x2c, 0x78, 0xf1, 0x28, 0xbb, 0x3e, 0x88, 0x64, 0x26, 0x65, 0x13, 0x58, 0xb1, 0xb7,
-	0xcc, 0xf7, 0xfd, 0xe6, 0xf7, 0x2f, 0x0c, 0x6e, 0x89, 0x62, 0xc2, 0x0b, 0x19, 0xe6, 0x85, 0x50,
-	0x82, 0x6c, 0x31, 0xc5, 0x98, 0x0c, 0xcd, 0x6c, 0x30, 0x9c, 0x0a, 0x31, 0x3d, 0xe7, 0x11, 0xcb,
-	0xd3, 0x88, 0x65, 0x99, 0x50, 0x4c, 0xa5, 0x22, 0xab, 0xb1, 0x03, 0x9c, 0x8a, 0xa9, 0x30, 0xdf,
-	0xfe, 0x37, 0xc0, 0xee, 0x8b, 0xea, 0x12, 0xe9, 0xa1, 0x95, 0x4e, 0x28, 0x78, 0x10, 0x6c, 0xc4,
-	0x56, 0x3a, 0x21, 0x43, 0xdc, 0x50, 0xe9, 0x8c, 0x4b, 0xc5, 0x66, 0x39, 0xb5, 0xf4, 0x78, 0x39,
-	0x20, 0x0f, 0xd0, 0x61, 0x49, 0x45, 0x4a, 0x6d, 0x0f, 0x82, 0xde, 0xa8, 0x1f, 0x36, 0x0d, 0x84,
-	0x4f, 0xf4, 0x2e, 0xae, 0x31, 0xa4, 0x8f, 0xdd, 0x32, 0x4b, 0x95, 0xa4, 0x6b, 0x1e, 0x04, 0x10,
-	0x9b, 0x43, 0x35, 0xcd, 0x8b, 0x34, 0xe1, 0xb4, 0xeb, 0x41, 0x60, 0xc5, 0xe6, 0x40, 0x28, 0xae,
-	0x8f, 0xcf, 0x45, 0xf2, 0xf6, 0xf9, 0x53, 0xea, 0x68, 0xd5, 0xab, 0xa3, 0x7f, 0x0f, 0xf1, 0x98,
-	0xab, 0x98, 0xbf, 0x2b, 0xb9, 0x54, 0x4d, 0x1c, 0xb4, 0x71, 0x07, 0xb8, 0xa9, 0x71, 0x32, 0x17,
-	0x99, 0xe4, 0xe4, 0x3e, 0x3a, 0xc6, 0x15, 0x05, 0xcf, 0x0e, 0x36, 0x47, 0xb7, 0xda, 0x56, 0x75,
-	0xfa, 0xb8, 0x86, 0xf8, 0x9f, 0x01, 0xb7, 0x8f, 0x0a, 0xce, 0x14, 0xff, 0xaf, 0x4e, 0xa3, 0x03,
-	0xeb, 0x7a, 0x1d, 0x98, 0xb4, 0x76, 0x33, 0xed, 0xca, 0x66, 0xfc, 0xc7, 0xd8, 0xbb, 0x32, 0x51,
-	0x87, 0xd8, 0xc3, 0xae, 0xa6, 0xd5, 0x1e, 0xfe, 0x91, 0xc1, 0x20, 0xf6, 0x77, 0xd1, 0x31, 0xd2,
-	0x64, 0x1d, 0xed, 0xc3, 0x97, 0xaf, 0x77, 0x3a, 0xe4, 0x06, 0xae, 0x9d, 0x3e, 0x3b, 0x39, 0xd9,
-	0x81, 0xd1, 0x77, 0xc0, 0x6d, 0x8d, 0x96, 0xa7, 0xbc, 0xb8, 0xa8, 0x1c, 0x9c, 0xa1, 0x63, 0xb4,
-	0xc8, 0x6e, 0x9b, 0xb4, 0x55, 0xc3, 0x60, 0xb8, 0x7a, 0x69, 0xec, 0xf9, 0xb7, 0x3f, 0xfd, 0xfc,
-	0xf3, 0xd5, 0xba, 0xe9, 0x63, 0x74, 0xf1, 0x30, 0x32, 0x90, 0x03, 0xd8, 0x27, 0xaf, 0xd0, 0x3e,
-	0xe6, 0x8a, 0xd0, 0xf6, 0xdd, 0xe5, 0x4f, 0x1c, 0xdc, 0x5d, 0xb1, 0xa9, 0x29, 0x87, 0x9a, 0xf2,
-	0x0e, 0xe9, 0x2f, 0x29, 0xa3, 0x0f, 0x75, 0xf5, 0x1f, 0x0f, 0x8f, 0x7e, 0xcc, 0x5d, 0xb8, 0x9c,
-	0xbb, 0xf0, 0x7b, 0xee, 0xc2, 0x97, 0x85, 0xdb, 0xb9, 0x5c, 0xb8, 0x9d, 0x5f, 0x0b, 0xb7, 0x73,
-	0xb6, 0x97, 0xcf, 0x42, 0x95, 0xbc, 0x79, 0x1f, 0x26, 0x62, 0x16, 0xb2, 0x32, 0x92, 0xa2, 0x2c,
-	0x12, 0x1e, 0x69, 0x1d, 0xfd, 0x20, 0xf2, 0x71, 0x4d, 0x37, 0x76, 0xf4, 0x1b, 0x78, 0xf4, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0xcd, 0x7d, 0xdc, 0x46, 0x4b, 0x03, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xd1, 0x8e, 0xdb, 0x44,
+	0x14, 0xcd, 0xc4, 0x1b, 0x6f, 0xf6, 0x26, 0xd9, 0x84, 0x21, 0x2d, 0xae, 0x1b, 0x42, 0x64, 0x24,
+	0x94, 0x6e, 0x51, 0xac, 0x2e, 0x12, 0x0f, 0x45, 0x42, 0xca, 0x66, 0x43, 0x15, 0x14, 0x35, 0x91,
+	0xb7, 0x45, 0xb4, 0x02, 0x55, 0xb3, 0xce, 0x24, 0x58, 0x24, 0xb6, 0xf1, 0x4c, 0x16, 0x10, 0xea,
+	0x0b, 0x5f, 0x80, 0xc4, 0x17, 0xf0, 0x07, 0x7c, 0x06, 0x8f, 0x95, 0x78, 0xe1, 0x11, 0xed, 0xf2,
+	0x11, 0x3c, 0x22, 0xdf, 0xb1, 0xb3, 0x76, 0x48, 0x5b, 0x78, 0xf3, 0xbd, 0x73, 0xe6, 0x9e, 0x33,
+	0x67, 0xce, 0xc8, 0x50, 0x0d, 0xa2, 0x19, 0x8f, 0x44, 0x2f, 0x8c, 0x02, 0x19, 0xd0, 0x2a, 0x93,
+	0x8c, 0x89, 0x9e, 0xea, 0x99, 0xad, 0x45, 0x10, 0x2c, 0x96, 0xdc, 0x66, 0xa1, 0x67, 0x33, 0xdf,
+	0x0f, 0x24, 0x93, 0x5e, 0xe0, 0x27, 0x58, 0x13, 0x16, 0xc1, 0x22, 0x50, 0xdf, 0xd6, 0xaf, 0x1a,
+	0x94, 0x26, 0xf1, 0x26, 0x7a, 0x08, 0x45, 0x6f, 0x66, 0x90, 0x0e, 0xe9, 0x1e, 0x38, 0x45, 0x6f,
+	0x46, 0x5b, 0x70, 0x20, 0xbd, 0x15, 0x17, 0x92, 0xad, 0x42, 0xa3, 0x88, 0xed, 0xeb, 0x06, 0x7d,
+	0x1f, 0x74, 0xe6, 0xc6, 0x43, 0x0d, 0xad, 0x43, 0xba, 0x87, 0xc7, 0xcd, 0x5e, 0x56, 0x40, 0xaf,
+	0x8f, 0x6b, 0x4e, 0x82, 0xa1, 0x4d, 0x28, 0xad, 0x7d, 0x4f, 0x0a, 0x63, 0xaf, 0x43, 0xba, 0xc4,
+	0x51, 0x45, 0xdc, 0x0d, 0x23, 0xcf, 0xe5, 0x46, 0xa9, 0x43, 0xba, 0x45, 0x47, 0x15, 0xd4, 0x80,
+	0xfd, 0xf3, 0x65, 0xe0, 0x7e, 0x3d, 0x3a, 0x35, 0x74, 0x64, 0x4d, 0x4b, 0x7a, 0x0f, 0x74, 0x21,
+	0x99, 0x5c, 0x0b, 0x63, 0x1f, 0x39, 0x6f, 0xe5, 0x39, 0xf1, 0x18, 0x67, 0x08, 0x70, 0x12, 0x20,
+	0xed, 0x40, 0x65, 0xee, 0x2d, 0x97, 0x7c, 0xf6, 0x18, 0xe9, 0xcb, 0x48, 0x9f, 0x6d, 0x51, 0x13,
+	0xca, 0xec, 0x62, 0x31, 0x45, 0x1d, 0x07, 0xa8, 0x63, 0x53, 0x53, 0x0a, 0x7b, 0x73, 0xce, 0x85,
+	0x01, 0xb8, 0x0d, 0xbf, 0x69, 0x17, 0xea, 0xfc, 0x3b, 0xf7, 0x2b, 0xe6, 0x2f, 0x38, 0x12, 0x8e,
+	0x4e, 0x8d, 0x0a, 0xca, 0xdc, 0x6e, 0xc7, 0x06, 0xae, 0xc3, 0x19, 0x93, 0x7c, 0xd6, 0x97, 0x46,
+	0x55, 0x19, 0xb8, 0x69, 0xc4, 0xbc, 0x73, 0xce, 0xfb, 0x42, 0x70, 0x69, 0xd4, 0x70, 0x71, 0x53,
+	0xc7, 0x3b, 0xdd, 0x88, 0xc7, 0xc0, 0x93, 0xef, 0x8d, 0x43, 0xb5, 0x73, 0xd3, 0xb0, 0xde, 0x03,
+	0x78, 0xc0, 0xa5, 0xc3, 0xbf, 0x59, 0x73, 0x21, 0xb3, 0x76, 0x91, 0x9c, 0x5d, 0xd6, 0x7d, 0xa8,
+	0x20, 0x4e, 0x84, 0x81, 0x2f, 0x38, 0xbd, 0x0b, 0xba, 0x32, 0xca, 0x20, 0x1d, 0xad, 0x5b, 0x39,
+	0x7e, 0x73, 0x87, 0x7b, 0x4e, 0x02, 0xb1, 0x7e, 0x21, 0x50, 0x1b, 0x20, 0xe3, 0x6b, 0x79, 0x32,
+	0x51, 0x28, 0xfe, 0xb7, 0x28, 0xa8, 0x4b, 0xd7, 0xb2, 0x97, 0xbe, 0x3b, 0x20, 0x39, 0x1f, 0x4a,
+	0xdb, 0x3e, 0x7c, 0x04, 0x87, 0xa9, 0xc4, 0xe4, 0x88, 0x77, 0xa0, 0x84, 0xa4, 0xa8, 0xf0, 0x25,
+	0x27, 0x54, 0x08, 0xeb, 0x2e, 0xd4, 0xc7, 0x9e, 0x90, 0x93, 0x90, 0xfb, 0xaf, 0x77, 0xf2, 0x1d,
+	0xa8, 0x0d, 0x98, 0xef, 0xf2, 0x65, 0x0a, 0xdd, 0x7a, 0x2b, 0x28, 0x25, 0x01, 0xfc, 0x7f, 0x29,
+	0x37, 0xa1, 0x39, 0x65, 0x21, 0x8f, 0x4e, 0xd8, 0x32, 0x9e, 0x21, 0x12, 0x12, 0xeb, 0x63, 0xa8,
+	0x66, 0xfb, 0xb1, 0x47, 0x0c, 0xe3, 0xa2, 0x78, 0x55, 0x81, 0xaa, 0x15, 0x00, 0xed, 0x27, 0x4e,
+	0x5a, 0x5a, 0x13, 0xb8, 0xb1, 0x35, 0x37, 0xd1, 0xf6, 0x21, 0x94, 0x13, 0x4c, 0x9a, 0x05, 0x33,
+	0x2f, 0x2f, 0xbb, 0xcd, 0xd9, 0x60, 0x8f, 0x6e, 0x83, 0xae, 0x2e, 0x93, 0xee, 0x83, 0x76, 0xf2,
+	0xf8, 0x49, 0xa3, 0x40, 0xcb, 0xb0, 0x77, 0x36, 0x1c, 0x8f, 0x1b, 0xe4, 0xe8, 0x33, 0xa8, 0x64,
+	0x1e, 0x60, 0xbc, 0x30, 0x99, 0x0e, 0x1f, 0x36, 0x0a, 0xb4, 0x09, 0x8d, 0x69, 0xdf, 0x79, 0x34,
+	0xea, 0x8f, 0xc7, 0x4f, 0x9e, 0x7d, 0x32, 0x1a, 0x8f, 0x87, 0xa7, 0x0d, 0x42, 0x01, 0xf4, 0xe4,
+	0xbb, 0x48, 0x6b, 0x70, 0x30, 0xe8, 0x3f, 0x1c, 0x0c, 0xb1, 0xd4, 0x68, 0x15, 0xca, 0xce, 0xf0,
+	0xd3, 0xe1, 0xe0, 0xd1, 0xf0, 0xb4, 0xb1, 0x77, 0xfc, 0xb7, 0x06, 0x35, 0x1c, 0x2c, 0xce, 0x78,
+	0x74, 0x11, 0x67, 0xe5, 0x29, 0xe8, 0xea, 0xde, 0xe9, 0xed, 0xbc, 0xec, 0x5c, 0x60, 0xcd, 0xd6,
+	0xee, 0x45, 0xe5, 0x81, 0x75, 0xe3, 0xc7, 0xdf, 0xff, 0xfa, 0xb9, 0x58, 0xb7, 0xc0, 0xbe, 0xb8,
+	0x67, 0x2b, 0xc8, 0x7d, 0x72, 0x44, 0x3f, 0x07, 0xed, 0x41, 0x6c, 0x6a, 0x7e, 0xef, 0xf5, 0x73,
+	0x33, 0x6f, 0xed, 0x58, 0x49, 0x46, 0xb6, 0x70, 0xe4, 0x4d, 0xda, 0xbc, 0x1e, 0x69, 0xff, 0x90,
+	0x44, 0xe8, 0x39, 0xfd, 0x12, 0xca, 0x69, 0xe0, 0xe8, 0xdb, 0xf9, 0x21, 0x5b, 0x41, 0x7c, 0x15,
+	0xc7, 0x5b, 0xc8, 0xf1, 0x06, 0xad, 0x23, 0x47, 0xc8, 0xfd, 0x67, 0x0a, 0x44, 0xbf, 0x00, 0x5d,
+	0x25, 0xf0, 0x5f, 0xa6, 0x64, 0x83, 0x6b, 0xb6, 0x76, 0x2f, 0xe6, 0xa7, 0x1f, 0xd5, 0xb3, 0x27,
+	0xf0, 0x66, 0xcf, 0xa9, 0x84, 0x5a, 0x2e, 0x4a, 0xd4, 0x7a, 0x79, 0x60, 0xd2, 0xfc, 0x9a, 0xef,
+	0xbe, 0x12, 0x93, 0x50, 0x9a, 0x48, 0xd9, 0xa4, 0x34, 0xa6, 0x0c, 0x63, 0x88, 0x9d, 0xe6, 0xed,
+	0x64, 0xf0, 0xdb, 0x65, 0x9b, 0xbc, 0xb8, 0x6c, 0x93, 0x3f, 0x2f, 0xdb, 0xe4, 0xa7, 0xab, 0x76,
+	0xe1, 0xc5, 0x55, 0xbb, 0xf0, 0xc7, 0x55, 0xbb, 0xf0, 0xf4, 0x4e, 0xb8, 0xea, 0x49, 0x77, 0xfe,
+	0x6d, 0xcf, 0x0d, 0x56, 0x3d, 0xb6, 0xb6, 0x45, 0xb0, 0x8e, 0x5c, 0x6e, 0x23, 0x1f, 0xfe, 0xf4,
+	0xc2, 0xf3, 0x44, 0xff, 0xb9, 0x8e, 0xff, 0xb9, 0x0f, 0xfe, 0x19, 0x00, 0x16, 0xdc, 0xc6, 0x0c,
+	0x2f, 0x07, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -398,6 +799,57 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FeeAsset) > 0 {
+		i -= len(m.FeeAsset)
+		copy(dAtA[i:], m.FeeAsset)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.FeeAsset)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExchangeOrderID) > 0 {
+		i -= len(m.ExchangeOrderID)
+		copy(dAtA[i:], m.ExchangeOrderID)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ExchangeOrderID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Fees != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fees))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.AvgPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AvgPrice))))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if m.FilledUnits != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FilledUnits))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.Status != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.BlockID) > 0 {
 		i -= len(m.BlockID)
 		copy(dAtA[i:], m.BlockID)
@@ -526,6 +978,13 @@ func (m *CreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Units != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Units))))
@@ -588,16 +1047,207 @@ func (m *CreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrders(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListOpenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *ListOpenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOpenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockID) > 0 {
+		i -= len(m.BlockID)
+		copy(dAtA[i:], m.BlockID)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.BlockID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaperBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaperBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaperBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PaperBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaperBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaperBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Balance))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaperBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaperBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaperBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrders(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Order) Size() (n int) {
 	if m == nil {
@@ -626,6 +1276,34 @@ func (m *Order) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovOrders(uint64(m.Status))
+	}
+	if m.FilledUnits != 0 {
+		n += 9
+	}
+	if m.AvgPrice != 0 {
+		n += 5
+	}
+	if m.Fees != 0 {
+		n += 9
+	}
+	l = len(m.ExchangeOrderID)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.FeeAsset)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
 	return n
 }
 
@@ -676,6 +1354,10 @@ func (m *CreateRequest) Size() (n int) {
 	if m.Units != 0 {
 		n += 9
 	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
 	return n
 }
 
@@ -689,16 +1371,795 @@ func (m *CreateResponse) Size() (n int) {
 		l = m.Order.Size()
 		n += 1 + l + sovOrders(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *ListOpenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockID)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *PaperBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PaperBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *PaperBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOrders(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrders(x uint64) (n int) {
+	return sovOrders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Units = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Price = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledUnits", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FilledUnits = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AvgPrice = float32(math.Float32frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Fees = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Price = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Units = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovOrders(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOrders(x uint64) (n int) {
-	return sovOrders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -721,17 +2182,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -741,98 +2202,82 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if m.Order == nil {
+				m.Order = &Order{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthOrders
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			m.Action = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListOpenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Units = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Price = float32(math.Float32frombits(v))
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListOpenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListOpenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
 			}
@@ -888,7 +2333,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -911,15 +2356,15 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -947,7 +2392,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockID = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -973,7 +2418,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -996,15 +2441,15 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1031,8 +2476,10 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1060,7 +2507,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+func (m *PaperBalancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1083,15 +2530,68 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaperBalancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaperBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaperBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaperBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaperBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1119,41 +2619,11 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockID = string(dAtA[iNdEx:postIndex])
+			m.Asset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			m.Action = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Price = float32(math.Float32frombits(v))
-		case 4:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -1161,7 +2631,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Units = float64(math.Float64frombits(v))
+			m.Balance = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -1186,7 +2656,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+func (m *PaperBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1209,15 +2679,15 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaperBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaperBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1244,10 +2714,8 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, &PaperBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20,6 +20,9 @@ const _ = grpc.SupportPackageIsVersion7
 type OrdersServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	ListOpen(ctx context.Context, in *ListOpenRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	PaperBalances(ctx context.Context, in *PaperBalancesRequest, opts ...grpc.CallOption) (*PaperBalancesResponse, error)
 }
 
 type ordersServiceClient struct {
@@ -48,12 +51,42 @@ func (c *ordersServiceClient) Get(ctx context.Context, in *GetRequest, opts ...g
 	return out, nil
 }
 
+func (c *ordersServiceClient) ListOpen(ctx context.Context, in *ListOpenRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/ataas.orders.OrdersService/ListOpen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, "/ataas.orders.OrdersService/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) PaperBalances(ctx context.Context, in *PaperBalancesRequest, opts ...grpc.CallOption) (*PaperBalancesResponse, error) {
+	out := new(PaperBalancesResponse)
+	err := c.cc.Invoke(ctx, "/ataas.orders.OrdersService/PaperBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility
 type OrdersServiceServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
+	ListOpen(context.Context, *ListOpenRequest) (*GetResponse, error)
+	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+	PaperBalances(context.Context, *PaperBalancesRequest) (*PaperBalancesResponse, error)
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
@@ -67,6 +100,15 @@ func (UnimplementedOrdersServiceServer) Create(context.Context, *CreateRequest)
 func (UnimplementedOrdersServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedOrdersServiceServer) ListOpen(context.Context, *ListOpenRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpen not implemented")
+}
+func (UnimplementedOrdersServiceServer) Cancel(context.Context, *CancelRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedOrdersServiceServer) PaperBalances(context.Context, *PaperBalancesRequest) (*PaperBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaperBalances not implemented")
+}
 func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
 
 // UnsafeOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -116,6 +158,60 @@ func _OrdersService_Get_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_ListOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.orders.OrdersService/ListOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListOpen(ctx, req.(*ListOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.orders.OrdersService/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).Cancel(ctx, req.(*CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_PaperBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaperBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).PaperBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.orders.OrdersService/PaperBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).PaperBalances(ctx, req.(*PaperBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -131,6 +227,18 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _OrdersService_Get_Handler,
 		},
+		{
+			MethodName: "ListOpen",
+			Handler:    _OrdersService_ListOpen_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _OrdersService_Cancel_Handler,
+		},
+		{
+			MethodName: "PaperBalances",
+			Handler:    _OrdersService_PaperBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders.proto",
@@ -891,9 +891,10 @@ func (m *Revoke) GetReason() string {
 }
 
 type Session struct {
-	UserAgent string `protobuf:"bytes,1,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
-	Ip        string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Jti       string `protobuf:"bytes,3,opt,name=jti,proto3" json:"jti,omitempty"`
+	UserAgent   string `protobuf:"bytes,1,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	Ip          string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Jti         string `protobuf:"bytes,3,opt,name=jti,proto3" json:"jti,omitempty"`
+	LastRefresh int64  `protobuf:"varint,4,opt,name=lastRefresh,proto3" json:"lastRefresh,omitempty"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -950,6 +951,13 @@ func (m *Session) GetJti() string {
 	return ""
 }
 
+func (m *Session) GetLastRefresh() int64 {
+	if m != nil {
+		return m.LastRefresh
+	}
+	return 0
+}
+
 type SessionList struct {
 	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 }
@@ -994,21 +1002,23 @@ func (m *SessionList) GetSessions() []*Session {
 	return nil
 }
 
-type Empty struct {
+type FIDORegistration struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Response string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (m *Empty) Reset()         { *m = Empty{} }
-func (m *Empty) String() string { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()    {}
-func (*Empty) Descriptor() ([]byte, []int) {
+func (m *FIDORegistration) Reset()         { *m = FIDORegistration{} }
+func (m *FIDORegistration) String() string { return proto.CompactTextString(m) }
+func (*FIDORegistration) ProtoMessage()    {}
+func (*FIDORegistration) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4affa6d033a78188, []int{15}
 }
-func (m *Empty) XXX_Unmarshal(b []byte) error {
+func (m *FIDORegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FIDORegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FIDORegistration.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1018,493 +1028,663 @@ func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Empty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Empty.Merge(m, src)
+func (m *FIDORegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FIDORegistration.Merge(m, src)
 }
-func (m *Empty) XXX_Size() int {
+func (m *FIDORegistration) XXX_Size() int {
 	return m.Size()
 }
-func (m *Empty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Empty.DiscardUnknown(m)
+func (m *FIDORegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_FIDORegistration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Empty proto.InternalMessageInfo
+var xxx_messageInfo_FIDORegistration proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("ataas.passport.MFAResponse_MFAType", MFAResponse_MFAType_name, MFAResponse_MFAType_value)
-	proto.RegisterType((*Tokens)(nil), "ataas.passport.Tokens")
-	proto.RegisterType((*VerifyTokenRequest)(nil), "ataas.passport.VerifyTokenRequest")
-	proto.RegisterType((*VerifyTokenResponse)(nil), "ataas.passport.VerifyTokenResponse")
-	proto.RegisterType((*UserCreds)(nil), "ataas.passport.UserCreds")
-	proto.RegisterType((*OAuthClientSecretCreds)(nil), "ataas.passport.OAuthClientSecretCreds")
-	proto.RegisterType((*OAuthCodeCreds)(nil), "ataas.passport.OAuthCodeCreds")
-	proto.RegisterType((*AuthRequest)(nil), "ataas.passport.AuthRequest")
-	proto.RegisterType((*AuthResponse)(nil), "ataas.passport.AuthResponse")
-	proto.RegisterType((*MFAResponse)(nil), "ataas.passport.MFAResponse")
-	proto.RegisterType((*FIDOChallenge)(nil), "ataas.passport.FIDOChallenge")
-	proto.RegisterType((*RefreshRequest)(nil), "ataas.passport.RefreshRequest")
-	proto.RegisterType((*SocialRequest)(nil), "ataas.passport.SocialRequest")
-	proto.RegisterType((*Revoke)(nil), "ataas.passport.Revoke")
-	proto.RegisterType((*Session)(nil), "ataas.passport.Session")
-	proto.RegisterType((*SessionList)(nil), "ataas.passport.SessionList")
-	proto.RegisterType((*Empty)(nil), "ataas.passport.Empty")
+func (m *FIDORegistration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("passport.proto", fileDescriptor_4affa6d033a78188) }
+func (m *FIDORegistration) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
 
-var fileDescriptor_4affa6d033a78188 = []byte{
-	// 1098 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0x59, 0x1f, 0xc3, 0x44, 0xd1, 0xbb, 0x49, 0x1c, 0x45, 0x76, 0x04, 0x63, 0x13,
-	0x04, 0x46, 0x5e, 0x40, 0x42, 0xe5, 0x00, 0x45, 0x0b, 0xf4, 0x60, 0xbb, 0x36, 0xec, 0x22, 0x86,
-	0x0d, 0xca, 0x4d, 0x8b, 0x1c, 0x8a, 0x6e, 0xc8, 0xb1, 0xcc, 0x5a, 0xe2, 0xb2, 0xdc, 0x95, 0x12,
-	0x5d, 0xdb, 0x43, 0x81, 0x02, 0x05, 0x0a, 0xf4, 0xd0, 0x9f, 0xd1, 0xfe, 0x87, 0x5e, 0x7a, 0x0c,
-	0xd0, 0x4b, 0x8f, 0x85, 0xdd, 0x1f, 0x52, 0xec, 0x07, 0x65, 0x8b, 0x92, 0x93, 0xdc, 0x66, 0x66,
-	0x67, 0x9e, 0x99, 0xd9, 0x7d, 0x66, 0x48, 0xa8, 0xc5, 0x4c, 0x88, 0x98, 0x27, 0xb2, 0x1d, 0x27,
-	0x5c, 0x72, 0x52, 0x63, 0x92, 0x31, 0xd1, 0x4e, 0xad, 0xcd, 0xd5, 0x3e, 0xe7, 0xfd, 0x01, 0x76,
-	0x58, 0x1c, 0x76, 0x58, 0x14, 0x71, 0xc9, 0x64, 0xc8, 0x23, 0x61, 0xbc, 0x9b, 0xd0, 0xe7, 0x7d,
-	0x6e, 0x64, 0xfa, 0xa3, 0x03, 0xa5, 0x63, 0x7e, 0x86, 0x91, 0x20, 0x77, 0x60, 0x49, 0x2a, 0xa9,
-	0xe1, 0xac, 0x39, 0xeb, 0x55, 0xcf, 0x28, 0x64, 0x0d, 0x5c, 0x2d, 0xec, 0xbc, 0x8e, 0xc3, 0x04,
-	0x1b, 0xf9, 0x35, 0x67, 0xbd, 0xe0, 0x5d, 0x35, 0x11, 0x0a, 0x37, 0x12, 0x3c, 0x49, 0x50, 0x9c,
-	0x6a, 0xa0, 0x46, 0x41, 0x87, 0xcf, 0xd8, 0xc8, 0x23, 0xb8, 0x69, 0x75, 0x8b, 0x53, 0xd4, 0x38,
-	0xb3, 0x46, 0xfa, 0x04, 0xc8, 0x73, 0x4c, 0xc2, 0x93, 0x89, 0x0e, 0xf2, 0xf0, 0xdb, 0x11, 0x0a,
-	0xb9, 0xb8, 0x2e, 0xfa, 0x93, 0x03, 0xb7, 0x67, 0x9c, 0x45, 0xcc, 0x23, 0x81, 0xca, 0x7b, 0xcc,
-	0x06, 0x61, 0xa0, 0xbd, 0x2b, 0x9e, 0x51, 0x48, 0x03, 0xca, 0x09, 0x8e, 0xf9, 0x19, 0x06, 0xba,
-	0x83, 0x8a, 0x97, 0xaa, 0xd9, 0xfe, 0x0a, 0xf3, 0xfd, 0xbd, 0x5f, 0xed, 0x7f, 0x38, 0x50, 0xfd,
-	0x5c, 0x60, 0xb2, 0x9d, 0x60, 0x20, 0x48, 0x13, 0x2a, 0x23, 0x81, 0x49, 0xc4, 0x86, 0x68, 0xcb,
-	0x9e, 0xea, 0xea, 0x4c, 0x3d, 0xd4, 0x2b, 0x9e, 0x98, 0x62, 0xaa, 0xde, 0x54, 0x27, 0x75, 0x28,
-	0x1c, 0xec, 0x6e, 0xda, 0x2b, 0x54, 0xa2, 0xea, 0x27, 0xc0, 0xf1, 0xee, 0x91, 0xce, 0x5a, 0xf5,
-	0x8c, 0xa2, 0x6a, 0x0a, 0x23, 0x81, 0xfe, 0x28, 0xc1, 0x67, 0xbc, 0x1f, 0x46, 0x8d, 0x25, 0xdd,
-	0xd5, 0xac, 0x91, 0xac, 0x42, 0x35, 0x41, 0x9f, 0xc5, 0xd2, 0x3f, 0x65, 0x8d, 0x92, 0x8e, 0xbf,
-	0x34, 0x10, 0x02, 0xc5, 0x08, 0x5f, 0xcb, 0x46, 0x59, 0x87, 0x6a, 0x99, 0xbe, 0x80, 0xe5, 0xc3,
-	0xcd, 0x91, 0x3c, 0xdd, 0x1e, 0x84, 0x18, 0xc9, 0x1e, 0xfa, 0x09, 0x4a, 0xd3, 0x51, 0x1d, 0x0a,
-	0x67, 0x38, 0xb1, 0xcd, 0x28, 0x91, 0x2c, 0x43, 0x49, 0x68, 0x07, 0xdb, 0x85, 0xd5, 0xb4, 0xdd,
-	0xe7, 0x31, 0x8a, 0x46, 0x61, 0xad, 0xa0, 0xed, 0x5a, 0xa3, 0x8f, 0xa0, 0x66, 0xb0, 0x79, 0x80,
-	0x06, 0x93, 0x40, 0xd1, 0xe7, 0x41, 0x7a, 0x43, 0x5a, 0xa6, 0xdf, 0xe7, 0xc1, 0x55, 0x5e, 0xe9,
-	0xeb, 0x7f, 0x04, 0xd5, 0x51, 0x7a, 0xad, 0xda, 0xd1, 0xed, 0xde, 0x6f, 0xcf, 0xd2, 0xbd, 0x3d,
-	0xbd, 0xf7, 0xbd, 0x9c, 0x77, 0xe9, 0x4d, 0xbe, 0x86, 0x65, 0xce, 0x16, 0x35, 0xa3, 0x0b, 0x76,
-	0xbb, 0x8f, 0xb3, 0x38, 0x8b, 0x5b, 0xdf, 0xcb, 0x79, 0xd7, 0xe0, 0x90, 0xbd, 0x6c, 0x4b, 0xfa,
-	0xe5, 0xdc, 0x6e, 0x6b, 0x31, 0x72, 0xea, 0xb5, 0x97, 0xf3, 0x32, 0x71, 0x5b, 0x65, 0x58, 0xf2,
-	0x95, 0xf0, 0x59, 0xb1, 0x52, 0xac, 0x6f, 0xd0, 0x5f, 0x1d, 0xb8, 0x61, 0x6e, 0xc1, 0xd2, 0xba,
-	0x01, 0x65, 0x31, 0xf2, 0x7d, 0x14, 0xc2, 0x12, 0x3b, 0x55, 0x49, 0x1b, 0x4a, 0x9a, 0xad, 0x69,
-	0x57, 0xcb, 0xd9, 0xdc, 0x66, 0xbc, 0x3d, 0xeb, 0x45, 0x3e, 0x01, 0xf7, 0x60, 0x77, 0x33, 0x05,
-	0xb6, 0x05, 0xaf, 0x64, 0x83, 0xae, 0xb8, 0x78, 0x57, 0xfd, 0xe9, 0xef, 0xce, 0x4c, 0x3c, 0xf9,
-	0x10, 0x8a, 0x72, 0x12, 0x9b, 0x37, 0xac, 0x75, 0x1f, 0xbe, 0x05, 0x47, 0xc9, 0xc7, 0x93, 0x18,
-	0x3d, 0x1d, 0x40, 0x36, 0xa0, 0x78, 0x12, 0x06, 0xdc, 0x56, 0xfd, 0x20, 0x1b, 0xb8, 0xbb, 0xff,
-	0xe9, 0xe1, 0xf6, 0x29, 0x1b, 0x0c, 0x30, 0xea, 0xe3, 0x5e, 0xce, 0xd3, 0xce, 0xf4, 0x31, 0x94,
-	0x2d, 0x0a, 0xa9, 0x40, 0xf1, 0xf8, 0xf0, 0xf8, 0xa8, 0x9e, 0x53, 0x92, 0xf2, 0xae, 0x3b, 0xa4,
-	0x0c, 0x85, 0xde, 0x41, 0xaf, 0x9e, 0xdf, 0x72, 0xa1, 0xea, 0xa7, 0xc1, 0xf4, 0x07, 0x07, 0x6e,
-	0xce, 0xc0, 0xa9, 0xc1, 0x98, 0x1e, 0x5b, 0xf6, 0x5d, 0x1a, 0xd4, 0xa9, 0x0c, 0x87, 0x28, 0x24,
-	0x1b, 0xc6, 0x76, 0xe1, 0x5d, 0x1a, 0xd4, 0x40, 0xb2, 0x38, 0xde, 0x0f, 0xec, 0x90, 0x1a, 0x45,
-	0x0d, 0xa4, 0x4c, 0x46, 0x42, 0x62, 0xb0, 0xcb, 0x7c, 0x94, 0xa2, 0x51, 0xd4, 0xdc, 0x9f, 0x35,
-	0xd2, 0xa7, 0x50, 0xf3, 0xcc, 0xd6, 0x48, 0xe9, 0x9d, 0x5d, 0x9e, 0xce, 0xfc, 0xf2, 0xa4, 0x0c,
-	0x6e, 0xf6, 0xb8, 0x1f, 0xb2, 0x41, 0x1a, 0xa4, 0x36, 0x48, 0xc2, 0xc7, 0x61, 0x80, 0x49, 0xba,
-	0x5d, 0x52, 0x9d, 0x3c, 0x85, 0x6a, 0x18, 0xc4, 0xc7, 0xef, 0xc3, 0x88, 0x4b, 0x47, 0xba, 0x05,
-	0x25, 0x4f, 0x2f, 0x44, 0x52, 0x83, 0xbc, 0x5d, 0x9e, 0x55, 0x2f, 0x1f, 0xea, 0x8d, 0xf4, 0x8d,
-	0x0c, 0xed, 0x88, 0x2b, 0x51, 0xcd, 0x77, 0x82, 0x4c, 0xf0, 0x74, 0xd3, 0x5b, 0x8d, 0xee, 0x43,
-	0xb9, 0x87, 0x42, 0x84, 0x5c, 0x2f, 0x1e, 0x35, 0x86, 0x9b, 0x7d, 0x8c, 0x64, 0x7a, 0xbf, 0x53,
-	0x83, 0x4e, 0x11, 0x5b, 0xc4, 0x7c, 0x18, 0xa7, 0x29, 0x0a, 0xd3, 0x14, 0x74, 0x0b, 0x5c, 0x0b,
-	0xf5, 0x2c, 0x14, 0x92, 0x6c, 0x40, 0x45, 0x18, 0x55, 0xb1, 0xbf, 0xb0, 0xee, 0x76, 0xef, 0x65,
-	0x5b, 0xb2, 0xee, 0xde, 0xd4, 0x91, 0x96, 0x61, 0x69, 0x67, 0x18, 0xcb, 0x49, 0xf7, 0xb7, 0x25,
-	0xa8, 0x1d, 0x59, 0xbf, 0x1e, 0x8e, 0x43, 0x1f, 0xc9, 0x73, 0x70, 0xaf, 0x7c, 0x3b, 0x08, 0xcd,
-	0xa2, 0xcd, 0x7f, 0x85, 0x9a, 0x0f, 0xdf, 0xea, 0x63, 0x87, 0x21, 0x30, 0x53, 0x8b, 0x91, 0x0c,
-	0x7d, 0x26, 0x91, 0xcc, 0x8d, 0xd5, 0x95, 0xcd, 0xd6, 0x5c, 0x5d, 0x7c, 0x68, 0xe7, 0xec, 0xfe,
-	0x77, 0x7f, 0xfd, 0xfb, 0x4b, 0xfe, 0x36, 0xad, 0x75, 0xc6, 0x1f, 0x74, 0xd4, 0xf6, 0xe9, 0x0c,
-	0xd4, 0x4e, 0xff, 0xd8, 0x79, 0x42, 0x02, 0x28, 0x5b, 0x16, 0x91, 0xb9, 0x45, 0x33, 0x4b, 0xaf,
-	0x77, 0xe4, 0x58, 0xd1, 0x39, 0xee, 0xd2, 0xfa, 0x34, 0x87, 0xe5, 0x9d, 0xca, 0x72, 0x02, 0xae,
-	0x61, 0x9d, 0xf9, 0x96, 0xcc, 0x0d, 0xe8, 0x0c, 0x25, 0xdf, 0x91, 0xa8, 0xa9, 0x13, 0xdd, 0xa1,
-	0xb7, 0xa6, 0x89, 0x84, 0x8e, 0x56, 0x79, 0xbe, 0x04, 0xd7, 0x50, 0xcf, 0xbc, 0xc5, 0xf2, 0x7c,
-	0x47, 0xea, 0xb0, 0x79, 0x37, 0x6b, 0xd7, 0x8f, 0xbb, 0x00, 0xd9, 0x7c, 0xd8, 0x15, 0xf2, 0x57,
-	0x70, 0xcb, 0x04, 0x6f, 0x0e, 0x06, 0xf6, 0x1f, 0x67, 0x31, 0xca, 0x75, 0xe0, 0x0f, 0x34, 0xf8,
-	0x3d, 0x4a, 0x32, 0xe0, 0x6c, 0xa0, 0x2b, 0xff, 0x02, 0x2a, 0x96, 0x76, 0xd7, 0x02, 0xaf, 0x5c,
-	0xc3, 0x53, 0x45, 0x6b, 0x7a, 0x4f, 0xc3, 0xff, 0x8f, 0xe8, 0xda, 0x87, 0x93, 0x4e, 0x4a, 0xdd,
-	0xad, 0x9d, 0x3f, 0xcf, 0x5b, 0xce, 0x9b, 0xf3, 0x96, 0xf3, 0xcf, 0x79, 0xcb, 0xf9, 0xf9, 0xa2,
-	0x95, 0x7b, 0x73, 0xd1, 0xca, 0xfd, 0x7d, 0xd1, 0xca, 0xbd, 0xf8, 0x7f, 0x3c, 0x6c, 0x4b, 0xff,
-	0xe4, 0x55, 0xdb, 0xe7, 0xc3, 0x36, 0x1b, 0x75, 0x04, 0x1f, 0x25, 0x3e, 0x76, 0x74, 0x12, 0xfd,
-	0xb7, 0x17, 0xbf, 0xec, 0xa4, 0xb9, 0x5e, 0x96, 0xf4, 0x2f, 0xde, 0xc6, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x60, 0x2d, 0x92, 0x65, 0x2e, 0x0a, 0x00, 0x00,
+type FIDOLoginRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Response string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (m *Tokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FIDOLoginRequest) Reset()         { *m = FIDOLoginRequest{} }
+func (m *FIDOLoginRequest) String() string { return proto.CompactTextString(m) }
+func (*FIDOLoginRequest) ProtoMessage()    {}
+func (*FIDOLoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{16}
+}
+func (m *FIDOLoginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FIDOLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FIDOLoginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Tokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FIDOLoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FIDOLoginRequest.Merge(m, src)
+}
+func (m *FIDOLoginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FIDOLoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FIDOLoginRequest.DiscardUnknown(m)
 }
 
-func (m *Tokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RefreshExpire != 0 {
-		i = encodeVarintPassport(dAtA, i, uint64(m.RefreshExpire))
-		i--
-		dAtA[i] = 0x20
+var xxx_messageInfo_FIDOLoginRequest proto.InternalMessageInfo
+
+func (m *FIDOLoginRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *FIDOLoginRequest) GetResponse() string {
+	if m != nil {
+		return m.Response
 	}
-	if m.TokenExpire != 0 {
-		i = encodeVarintPassport(dAtA, i, uint64(m.TokenExpire))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+type TOTPEnrolment struct {
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri    string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (m *TOTPEnrolment) Reset()         { *m = TOTPEnrolment{} }
+func (m *TOTPEnrolment) String() string { return proto.CompactTextString(m) }
+func (*TOTPEnrolment) ProtoMessage()    {}
+func (*TOTPEnrolment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{17}
+}
+func (m *TOTPEnrolment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TOTPEnrolment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TOTPEnrolment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TOTPEnrolment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TOTPEnrolment.Merge(m, src)
+}
+func (m *TOTPEnrolment) XXX_Size() int {
+	return m.Size()
+}
+func (m *TOTPEnrolment) XXX_DiscardUnknown() {
+	xxx_messageInfo_TOTPEnrolment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TOTPEnrolment proto.InternalMessageInfo
+
+func (m *TOTPEnrolment) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VerifyTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TOTPEnrolment) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VerifyTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TOTPCode struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (m *VerifyTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+func (m *TOTPCode) Reset()         { *m = TOTPCode{} }
+func (m *TOTPCode) String() string { return proto.CompactTextString(m) }
+func (*TOTPCode) ProtoMessage()    {}
+func (*TOTPCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{18}
+}
+func (m *TOTPCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TOTPCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TOTPCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TOTPCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TOTPCode.Merge(m, src)
+}
+func (m *TOTPCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TOTPCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TOTPCode.DiscardUnknown(m)
 }
 
-func (m *VerifyTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TOTPCode proto.InternalMessageInfo
+
+func (m *TOTPCode) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VerifyTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BackupCodes struct {
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
 }
 
-func (m *VerifyTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RefreshExpire != 0 {
-		i = encodeVarintPassport(dAtA, i, uint64(m.RefreshExpire))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TokenExpire != 0 {
-		i = encodeVarintPassport(dAtA, i, uint64(m.TokenExpire))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Revoked {
-		i--
-		if m.Revoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BackupCodes) Reset()         { *m = BackupCodes{} }
+func (m *BackupCodes) String() string { return proto.CompactTextString(m) }
+func (*BackupCodes) ProtoMessage()    {}
+func (*BackupCodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{19}
+}
+func (m *BackupCodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupCodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupCodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BackupCodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupCodes.Merge(m, src)
+}
+func (m *BackupCodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupCodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupCodes.DiscardUnknown(m)
 }
 
-func (m *UserCreds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BackupCodes proto.InternalMessageInfo
+
+func (m *BackupCodes) GetCodes() []string {
+	if m != nil {
+		return m.Codes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UserCreds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type APIClient struct {
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes   []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Created  int64    `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	LastUsed int64    `protobuf:"varint,5,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"`
 }
 
-func (m *UserCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Next {
-		i--
-		if m.Next {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Recaptcha) > 0 {
-		i -= len(m.Recaptcha)
-		copy(dAtA[i:], m.Recaptcha)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Recaptcha)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.InsecureLogin {
-		i--
-		if m.InsecureLogin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *APIClient) Reset()         { *m = APIClient{} }
+func (m *APIClient) String() string { return proto.CompactTextString(m) }
+func (*APIClient) ProtoMessage()    {}
+func (*APIClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{20}
+}
+func (m *APIClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if len(m.DevFP) > 0 {
-		i -= len(m.DevFP)
-		copy(dAtA[i:], m.DevFP)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.DevFP)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *APIClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIClient.Merge(m, src)
+}
+func (m *APIClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIClient proto.InternalMessageInfo
+
+func (m *APIClient) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.MFA) > 0 {
-		i -= len(m.MFA)
-		copy(dAtA[i:], m.MFA)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.MFA)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *APIClient) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *APIClient) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *APIClient) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OAuthClientSecretCreds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *APIClient) GetLastUsed() int64 {
+	if m != nil {
+		return m.LastUsed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OAuthClientSecretCreds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NewAPIClientRequest struct {
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (m *OAuthClientSecretCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Scopes[iNdEx])
-			copy(dAtA[i:], m.Scopes[iNdEx])
-			i = encodeVarintPassport(dAtA, i, uint64(len(m.Scopes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *NewAPIClientRequest) Reset()         { *m = NewAPIClientRequest{} }
+func (m *NewAPIClientRequest) String() string { return proto.CompactTextString(m) }
+func (*NewAPIClientRequest) ProtoMessage()    {}
+func (*NewAPIClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{21}
+}
+func (m *NewAPIClientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewAPIClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewAPIClientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Secret) > 0 {
-		i -= len(m.Secret)
-		copy(dAtA[i:], m.Secret)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Secret)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *OAuthCodeCreds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *NewAPIClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAPIClientRequest.Merge(m, src)
 }
-
-func (m *OAuthCodeCreds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NewAPIClientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewAPIClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAPIClientRequest.DiscardUnknown(m)
 }
 
-func (m *OAuthCodeCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_NewAPIClientRequest proto.InternalMessageInfo
+
+func (m *NewAPIClientRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NewAPIClientRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NewAPIClientResponse struct {
+	Client *APIClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret string     `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Creds != nil {
-		{
-			size := m.Creds.Size()
-			i -= size
-			if _, err := m.Creds.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *NewAPIClientResponse) Reset()         { *m = NewAPIClientResponse{} }
+func (m *NewAPIClientResponse) String() string { return proto.CompactTextString(m) }
+func (*NewAPIClientResponse) ProtoMessage()    {}
+func (*NewAPIClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{22}
+}
+func (m *NewAPIClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewAPIClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewAPIClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NewAPIClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAPIClientResponse.Merge(m, src)
+}
+func (m *NewAPIClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewAPIClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAPIClientResponse.DiscardUnknown(m)
 }
 
-func (m *AuthRequest_UserCreds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_NewAPIClientResponse proto.InternalMessageInfo
+
+func (m *NewAPIClientResponse) GetClient() *APIClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
 }
 
-func (m *AuthRequest_UserCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UserCreds != nil {
-		{
-			size, err := m.UserCreds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *NewAPIClientResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *AuthRequest_OauthClientSecretCreds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type APIClientList struct {
+	Clients []*APIClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
 }
 
-func (m *AuthRequest_OauthClientSecretCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OauthClientSecretCreds != nil {
-		{
-			size, err := m.OauthClientSecretCreds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
+func (m *APIClientList) Reset()         { *m = APIClientList{} }
+func (m *APIClientList) String() string { return proto.CompactTextString(m) }
+func (*APIClientList) ProtoMessage()    {}
+func (*APIClientList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{23}
+}
+func (m *APIClientList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIClientList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIClientList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *AuthRequest_OAuthCodeCreds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *APIClientList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIClientList.Merge(m, src)
+}
+func (m *APIClientList) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIClientList) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIClientList.DiscardUnknown(m)
 }
 
-func (m *AuthRequest_OAuthCodeCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OAuthCodeCreds != nil {
-		{
-			size, err := m.OAuthCodeCreds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
+var xxx_messageInfo_APIClientList proto.InternalMessageInfo
+
+func (m *APIClientList) GetClients() []*APIClient {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+type RevokeAPIClientRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *RevokeAPIClientRequest) Reset()         { *m = RevokeAPIClientRequest{} }
+func (m *RevokeAPIClientRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIClientRequest) ProtoMessage()    {}
+func (*RevokeAPIClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{24}
+}
+func (m *RevokeAPIClientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeAPIClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeAPIClientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
+func (m *RevokeAPIClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIClientRequest.Merge(m, src)
+}
+func (m *RevokeAPIClientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeAPIClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIClientRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIClientRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4affa6d033a78188, []int{25}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("ataas.passport.MFAResponse_MFAType", MFAResponse_MFAType_name, MFAResponse_MFAType_value)
+	proto.RegisterType((*Tokens)(nil), "ataas.passport.Tokens")
+	proto.RegisterType((*VerifyTokenRequest)(nil), "ataas.passport.VerifyTokenRequest")
+	proto.RegisterType((*VerifyTokenResponse)(nil), "ataas.passport.VerifyTokenResponse")
+	proto.RegisterType((*UserCreds)(nil), "ataas.passport.UserCreds")
+	proto.RegisterType((*OAuthClientSecretCreds)(nil), "ataas.passport.OAuthClientSecretCreds")
+	proto.RegisterType((*OAuthCodeCreds)(nil), "ataas.passport.OAuthCodeCreds")
+	proto.RegisterType((*AuthRequest)(nil), "ataas.passport.AuthRequest")
+	proto.RegisterType((*AuthResponse)(nil), "ataas.passport.AuthResponse")
+	proto.RegisterType((*MFAResponse)(nil), "ataas.passport.MFAResponse")
+	proto.RegisterType((*FIDOChallenge)(nil), "ataas.passport.FIDOChallenge")
+	proto.RegisterType((*RefreshRequest)(nil), "ataas.passport.RefreshRequest")
+	proto.RegisterType((*SocialRequest)(nil), "ataas.passport.SocialRequest")
+	proto.RegisterType((*Revoke)(nil), "ataas.passport.Revoke")
+	proto.RegisterType((*Session)(nil), "ataas.passport.Session")
+	proto.RegisterType((*SessionList)(nil), "ataas.passport.SessionList")
+	proto.RegisterType((*FIDORegistration)(nil), "ataas.passport.FIDORegistration")
+	proto.RegisterType((*FIDOLoginRequest)(nil), "ataas.passport.FIDOLoginRequest")
+	proto.RegisterType((*TOTPEnrolment)(nil), "ataas.passport.TOTPEnrolment")
+	proto.RegisterType((*TOTPCode)(nil), "ataas.passport.TOTPCode")
+	proto.RegisterType((*BackupCodes)(nil), "ataas.passport.BackupCodes")
+	proto.RegisterType((*APIClient)(nil), "ataas.passport.APIClient")
+	proto.RegisterType((*NewAPIClientRequest)(nil), "ataas.passport.NewAPIClientRequest")
+	proto.RegisterType((*NewAPIClientResponse)(nil), "ataas.passport.NewAPIClientResponse")
+	proto.RegisterType((*APIClientList)(nil), "ataas.passport.APIClientList")
+	proto.RegisterType((*RevokeAPIClientRequest)(nil), "ataas.passport.RevokeAPIClientRequest")
+	proto.RegisterType((*Empty)(nil), "ataas.passport.Empty")
+}
+
+func init() { proto.RegisterFile("passport.proto", fileDescriptor_4affa6d033a78188) }
+
+var fileDescriptor_4affa6d033a78188 = []byte{
+	// 1559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x17, 0x25, 0xd9, 0x92, 0x86, 0xb1, 0xac, 0xb7, 0x89, 0x6d, 0x45, 0x71, 0xf4, 0x8c, 0x4d,
+	0x10, 0x18, 0x7e, 0x80, 0x84, 0xd8, 0x01, 0x1e, 0x12, 0xe0, 0x1d, 0x2c, 0xc7, 0x86, 0x13, 0x24,
+	0x2f, 0x06, 0xed, 0xa4, 0x45, 0x80, 0x36, 0xa5, 0xc9, 0xb5, 0xcc, 0x5a, 0x22, 0x99, 0xdd, 0x95,
+	0x13, 0x23, 0xc8, 0xa5, 0x3d, 0x14, 0x28, 0x50, 0xa0, 0x40, 0x0f, 0xfd, 0x1a, 0xfd, 0x0e, 0xbd,
+	0xf4, 0x18, 0xb4, 0x97, 0x1e, 0x8b, 0xa4, 0x1f, 0xa4, 0xd8, 0x3f, 0xa4, 0x48, 0x91, 0x72, 0x5c,
+	0xa0, 0xb7, 0x9d, 0xe5, 0xcc, 0xfc, 0x76, 0x66, 0x67, 0x7e, 0xb3, 0x12, 0xd4, 0x43, 0x9b, 0xb1,
+	0x30, 0xa0, 0xbc, 0x13, 0xd2, 0x80, 0x07, 0xa8, 0x6e, 0x73, 0xdb, 0x66, 0x9d, 0x68, 0xb7, 0xb5,
+	0xdc, 0x0f, 0x82, 0xfe, 0x80, 0x74, 0xed, 0xd0, 0xeb, 0xda, 0xbe, 0x1f, 0x70, 0x9b, 0x7b, 0x81,
+	0xcf, 0x94, 0x76, 0x0b, 0xfa, 0x41, 0x3f, 0x50, 0x6b, 0xfc, 0xad, 0x01, 0xb3, 0x07, 0xc1, 0x09,
+	0xf1, 0x19, 0xba, 0x02, 0x33, 0x5c, 0xac, 0x9a, 0xc6, 0x8a, 0xb1, 0x5a, 0xb3, 0x94, 0x80, 0x56,
+	0xc0, 0x94, 0x8b, 0xed, 0xd7, 0xa1, 0x47, 0x49, 0xb3, 0xb8, 0x62, 0xac, 0x96, 0xac, 0xe4, 0x16,
+	0xc2, 0x70, 0x89, 0x92, 0x23, 0x4a, 0xd8, 0xb1, 0x74, 0xd4, 0x2c, 0x49, 0xf3, 0xd4, 0x1e, 0xba,
+	0x09, 0x73, 0x5a, 0xd6, 0x7e, 0xca, 0xd2, 0x4f, 0x7a, 0x13, 0xaf, 0x01, 0x7a, 0x46, 0xa8, 0x77,
+	0x74, 0x26, 0x8d, 0x2c, 0xf2, 0x72, 0x44, 0x18, 0xcf, 0x3f, 0x17, 0xfe, 0xce, 0x80, 0xcb, 0x29,
+	0x65, 0x16, 0x06, 0x3e, 0x23, 0x42, 0xfb, 0xd4, 0x1e, 0x78, 0xae, 0xd4, 0xae, 0x5a, 0x4a, 0x40,
+	0x4d, 0xa8, 0x50, 0x72, 0x1a, 0x9c, 0x10, 0x57, 0x46, 0x50, 0xb5, 0x22, 0x71, 0x32, 0xbe, 0x52,
+	0x36, 0xbe, 0x8b, 0x9d, 0xfd, 0x67, 0x03, 0x6a, 0x4f, 0x19, 0xa1, 0x5b, 0x94, 0xb8, 0x0c, 0xb5,
+	0xa0, 0x3a, 0x62, 0x84, 0xfa, 0xf6, 0x90, 0xe8, 0x63, 0xc7, 0xb2, 0xf8, 0x26, 0x2e, 0xea, 0x55,
+	0x40, 0xd5, 0x61, 0x6a, 0x56, 0x2c, 0xa3, 0x06, 0x94, 0x1e, 0xef, 0x6c, 0xea, 0x14, 0x8a, 0xa5,
+	0x88, 0xc7, 0x25, 0xa7, 0x3b, 0x7b, 0x12, 0xb5, 0x66, 0x29, 0x41, 0x9c, 0xc9, 0xf3, 0x19, 0x71,
+	0x46, 0x94, 0x3c, 0x0a, 0xfa, 0x9e, 0xdf, 0x9c, 0x91, 0x51, 0xa5, 0x37, 0xd1, 0x32, 0xd4, 0x28,
+	0x71, 0xec, 0x90, 0x3b, 0xc7, 0x76, 0x73, 0x56, 0xda, 0x8f, 0x37, 0x10, 0x82, 0xb2, 0x4f, 0x5e,
+	0xf3, 0x66, 0x45, 0x9a, 0xca, 0x35, 0x7e, 0x0e, 0x8b, 0x4f, 0x36, 0x47, 0xfc, 0x78, 0x6b, 0xe0,
+	0x11, 0x9f, 0xef, 0x13, 0x87, 0x12, 0xae, 0x22, 0x6a, 0x40, 0xe9, 0x84, 0x9c, 0xe9, 0x60, 0xc4,
+	0x12, 0x2d, 0xc2, 0x2c, 0x93, 0x0a, 0x3a, 0x0a, 0x2d, 0xc9, 0x7d, 0x27, 0x08, 0x09, 0x6b, 0x96,
+	0x56, 0x4a, 0x72, 0x5f, 0x4a, 0xf8, 0x26, 0xd4, 0x95, 0xef, 0xc0, 0x25, 0xca, 0x27, 0x82, 0xb2,
+	0x13, 0xb8, 0x51, 0x86, 0xe4, 0x1a, 0x7f, 0x5d, 0x04, 0x53, 0x68, 0x45, 0xb7, 0x7f, 0x17, 0x6a,
+	0xa3, 0x28, 0xad, 0x52, 0xd1, 0x5c, 0xbf, 0xda, 0x49, 0x97, 0x7b, 0x27, 0xce, 0xfb, 0x6e, 0xc1,
+	0x1a, 0x6b, 0xa3, 0x2f, 0x60, 0x31, 0xb0, 0xf3, 0x82, 0x91, 0x07, 0x36, 0xd7, 0x6f, 0x4d, 0xfa,
+	0xc9, 0x0f, 0x7d, 0xb7, 0x60, 0x4d, 0xf1, 0x83, 0x76, 0x27, 0x43, 0x92, 0x37, 0x67, 0xae, 0xb7,
+	0xf3, 0x3d, 0x47, 0x5a, 0xbb, 0x05, 0x6b, 0xc2, 0xae, 0x57, 0x81, 0x19, 0x47, 0x2c, 0x1e, 0x96,
+	0xab, 0xe5, 0xc6, 0x06, 0xfe, 0xd1, 0x80, 0x4b, 0x2a, 0x0b, 0xba, 0xac, 0x9b, 0x50, 0x61, 0x23,
+	0xc7, 0x21, 0x8c, 0xe9, 0xc2, 0x8e, 0x44, 0xd4, 0x81, 0x59, 0x59, 0xad, 0x51, 0x54, 0x8b, 0x93,
+	0xd8, 0xaa, 0xbd, 0x2d, 0xad, 0x85, 0xfe, 0x07, 0xe6, 0xe3, 0x9d, 0xcd, 0xc8, 0xb1, 0x3e, 0xf0,
+	0xb5, 0x49, 0xa3, 0x84, 0x8a, 0x95, 0xd4, 0xc7, 0x3f, 0x19, 0x29, 0x7b, 0xf4, 0x5f, 0x28, 0xf3,
+	0xb3, 0x50, 0xdd, 0x61, 0x7d, 0xfd, 0xc6, 0x39, 0x7e, 0xc4, 0xfa, 0xe0, 0x2c, 0x24, 0x96, 0x34,
+	0x40, 0x1b, 0x50, 0x3e, 0xf2, 0xdc, 0x40, 0x9f, 0xfa, 0xfa, 0xa4, 0xe1, 0xce, 0x83, 0xfb, 0x4f,
+	0xb6, 0x8e, 0xed, 0xc1, 0x80, 0xf8, 0x7d, 0xb2, 0x5b, 0xb0, 0xa4, 0x32, 0xbe, 0x05, 0x15, 0xed,
+	0x05, 0x55, 0xa1, 0x7c, 0xf0, 0xe4, 0x60, 0xaf, 0x51, 0x10, 0x2b, 0xa1, 0xdd, 0x30, 0x50, 0x05,
+	0x4a, 0xfb, 0x8f, 0xf7, 0x1b, 0xc5, 0x9e, 0x09, 0x35, 0x27, 0x32, 0xc6, 0xdf, 0x18, 0x30, 0x97,
+	0x72, 0x87, 0x96, 0x13, 0x9f, 0x75, 0xf5, 0xd5, 0x9c, 0xe4, 0x57, 0xee, 0x0d, 0x09, 0xe3, 0xf6,
+	0x30, 0xd4, 0x84, 0x37, 0xde, 0x10, 0x0d, 0x69, 0x87, 0xe1, 0x03, 0x57, 0x37, 0xa9, 0x12, 0x44,
+	0x43, 0x72, 0x3a, 0x62, 0x9c, 0xb8, 0x3b, 0xb6, 0x43, 0x38, 0x6b, 0x96, 0x65, 0xed, 0xa7, 0x37,
+	0xf1, 0x1d, 0xa8, 0x5b, 0x8a, 0x35, 0xa2, 0xf2, 0x9e, 0x24, 0x4f, 0x23, 0x4b, 0x9e, 0xd8, 0x86,
+	0xb9, 0xfd, 0xc0, 0xf1, 0xec, 0x41, 0x64, 0x24, 0x18, 0x84, 0x06, 0xa7, 0x9e, 0x4b, 0x68, 0xc4,
+	0x2e, 0x91, 0x8c, 0xee, 0x40, 0xcd, 0x73, 0xc3, 0x83, 0x8b, 0x54, 0xc4, 0x58, 0x11, 0xf7, 0x60,
+	0xd6, 0x92, 0x84, 0x88, 0xea, 0x50, 0xd4, 0xe4, 0x59, 0xb3, 0x8a, 0x9e, 0x64, 0xa4, 0x2f, 0xb9,
+	0xa7, 0x5b, 0x5c, 0x2c, 0x45, 0x7f, 0x53, 0x62, 0xb3, 0x20, 0x62, 0x7a, 0x2d, 0xe1, 0x13, 0xa8,
+	0xec, 0x13, 0xc6, 0xbc, 0x40, 0x12, 0x8f, 0x68, 0xc3, 0xcd, 0x3e, 0xf1, 0x79, 0x94, 0xdf, 0x78,
+	0x43, 0x42, 0x84, 0xda, 0x63, 0xd1, 0x0b, 0x23, 0x88, 0xd2, 0x18, 0x62, 0x05, 0xcc, 0x81, 0xcd,
+	0xb8, 0xce, 0x95, 0x26, 0xdc, 0xe4, 0x16, 0xee, 0x81, 0xa9, 0xc1, 0x1e, 0x79, 0x8c, 0xa3, 0x0d,
+	0xa8, 0x32, 0x25, 0x8a, 0xfe, 0x28, 0xad, 0x9a, 0xeb, 0x4b, 0x93, 0x41, 0x6b, 0x75, 0x2b, 0x56,
+	0xc4, 0x3d, 0x68, 0x88, 0xb2, 0xb0, 0x48, 0xdf, 0x63, 0x9c, 0xca, 0x11, 0x29, 0x49, 0x71, 0x4c,
+	0xda, 0xe5, 0x88, 0xb0, 0x69, 0xd4, 0x2e, 0x9a, 0xb0, 0x23, 0x19, 0x3f, 0x54, 0x3e, 0x24, 0xdf,
+	0x26, 0xae, 0xe7, 0x3c, 0xf2, 0x9f, 0xea, 0xeb, 0x2e, 0xcc, 0x89, 0x8a, 0xde, 0xf6, 0x69, 0x30,
+	0x18, 0x12, 0x5f, 0x31, 0xa9, 0x62, 0x58, 0x23, 0xc5, 0xb0, 0x0d, 0x28, 0x8d, 0x68, 0x7c, 0x27,
+	0x23, 0xea, 0xe1, 0x36, 0x54, 0x85, 0xa9, 0xe0, 0x93, 0x5c, 0x56, 0xbd, 0x01, 0x66, 0xcf, 0x76,
+	0x4e, 0x46, 0xa1, 0xd0, 0x90, 0xa3, 0x5e, 0x6c, 0xab, 0x5c, 0xd5, 0x2c, 0x25, 0xe0, 0xb7, 0x50,
+	0xdb, 0xdc, 0x7b, 0xa0, 0x58, 0x2e, 0x53, 0x07, 0x51, 0x62, 0x8a, 0x89, 0xc4, 0x4c, 0x61, 0x7a,
+	0x41, 0x56, 0x0e, 0x25, 0x36, 0x27, 0xae, 0xbe, 0xba, 0x48, 0x14, 0xe1, 0x8b, 0x5b, 0x7c, 0xca,
+	0x88, 0x2b, 0x47, 0x56, 0xc9, 0x8a, 0x65, 0xbc, 0x09, 0x97, 0xff, 0x4f, 0x5e, 0xc5, 0x27, 0x88,
+	0xb2, 0x99, 0x77, 0x23, 0x63, 0xe0, 0x62, 0x6a, 0xc4, 0xd8, 0x70, 0x25, 0xed, 0x42, 0x93, 0xd4,
+	0x6d, 0x98, 0x75, 0xe4, 0xce, 0xb4, 0x09, 0x32, 0x36, 0xd1, 0x8a, 0xd3, 0xa6, 0x1b, 0xbe, 0x0f,
+	0x73, 0xb1, 0xb2, 0x2e, 0xbd, 0x8a, 0x32, 0x89, 0x2a, 0xef, 0x1c, 0xe7, 0x91, 0x26, 0x5e, 0x85,
+	0x45, 0xd5, 0x6f, 0x99, 0x70, 0x27, 0xf2, 0x8e, 0x2b, 0x30, 0xb3, 0x3d, 0x0c, 0xf9, 0xd9, 0xfa,
+	0xaf, 0x73, 0x50, 0xdf, 0xd3, 0x2e, 0xf7, 0xc9, 0xa9, 0xe7, 0x10, 0xf4, 0x0c, 0xcc, 0xc4, 0x13,
+	0x08, 0xe1, 0x49, 0xe0, 0xec, 0x63, 0xaa, 0x75, 0xe3, 0x5c, 0x1d, 0x9d, 0x2e, 0x57, 0x0d, 0x1f,
+	0xe2, 0x73, 0xcf, 0xb1, 0x39, 0x41, 0x99, 0xe9, 0x90, 0x18, 0xd0, 0xad, 0xe5, 0xfc, 0x8f, 0xba,
+	0xa6, 0xaf, 0x7e, 0xf5, 0xdb, 0x9f, 0x3f, 0x14, 0x2f, 0xe3, 0x7a, 0xf7, 0xf4, 0x76, 0x57, 0x0c,
+	0xd1, 0xee, 0x40, 0xb4, 0xca, 0x3d, 0x63, 0x0d, 0xb9, 0x50, 0xd1, 0xdd, 0x8c, 0x32, 0xf3, 0x32,
+	0xcd, 0x92, 0x1f, 0xc1, 0xb8, 0x26, 0x31, 0x16, 0x70, 0x23, 0xc6, 0xd0, 0xf4, 0x29, 0x50, 0x8e,
+	0xc0, 0x54, 0xe4, 0xa9, 0x9e, 0x44, 0x99, 0x39, 0x93, 0x62, 0xd6, 0x8f, 0x00, 0xb5, 0x24, 0xd0,
+	0x15, 0x3c, 0x1f, 0x03, 0x31, 0x69, 0x2d, 0x70, 0x3e, 0x05, 0x53, 0xdd, 0xa8, 0xba, 0x8b, 0xc5,
+	0x6c, 0x44, 0xe2, 0x63, 0x6b, 0x61, 0x72, 0x5f, 0x5e, 0x6e, 0x8e, 0x67, 0xf5, 0x3e, 0x15, 0x9e,
+	0x3f, 0x87, 0x79, 0x5d, 0x2b, 0x83, 0x81, 0x7e, 0xaa, 0xe7, 0x7b, 0x99, 0xe6, 0xfc, 0xba, 0x74,
+	0xbe, 0x84, 0xd1, 0x84, 0x73, 0x7b, 0x20, 0x4f, 0xbe, 0x09, 0x0d, 0xe5, 0x5f, 0x3c, 0xa3, 0x34,
+	0xc0, 0xdf, 0x3b, 0x3e, 0xfa, 0x04, 0xaa, 0x9a, 0x5e, 0xa7, 0x9e, 0xed, 0xda, 0x14, 0x3e, 0x16,
+	0x3d, 0x84, 0x97, 0xe4, 0x09, 0xff, 0x85, 0x64, 0xf8, 0xc3, 0xb3, 0x6e, 0x44, 0xd1, 0xe8, 0x0d,
+	0xcc, 0x6f, 0x49, 0xea, 0x18, 0x13, 0x53, 0xa6, 0x82, 0x73, 0x48, 0xa3, 0x75, 0xf3, 0x7c, 0x25,
+	0x7d, 0x9f, 0xa9, 0xc4, 0x0c, 0xcf, 0xc4, 0xcf, 0xa5, 0x17, 0xba, 0x43, 0x45, 0x62, 0x0e, 0xa1,
+	0x2e, 0x4e, 0x17, 0xdb, 0x4d, 0x8d, 0xed, 0xfa, 0xd4, 0x8e, 0x97, 0xd1, 0xe9, 0xcb, 0x45, 0x39,
+	0x30, 0xe8, 0x65, 0x7c, 0xb9, 0x71, 0x80, 0xb7, 0xf2, 0x73, 0x9f, 0x89, 0x71, 0xca, 0x6d, 0xff,
+	0x5b, 0xa2, 0x5d, 0x5d, 0x5b, 0xca, 0xa2, 0x75, 0xdf, 0x78, 0xee, 0x5b, 0x74, 0x02, 0xf3, 0x3b,
+	0x9e, 0xef, 0xb1, 0xe3, 0x78, 0x70, 0xa1, 0x95, 0xbc, 0xd7, 0x57, 0x72, 0xa6, 0x7d, 0xa4, 0x31,
+	0x9a, 0x12, 0x13, 0xe1, 0xb9, 0xb8, 0xc2, 0xc4, 0x6b, 0x4d, 0xe4, 0xd0, 0x83, 0x85, 0x1e, 0xe9,
+	0x7b, 0x7e, 0x66, 0xd0, 0x5e, 0x34, 0x95, 0xa9, 0x87, 0x5b, 0xba, 0x4f, 0x86, 0xa4, 0x3b, 0x3c,
+	0xb2, 0x63, 0xa8, 0x11, 0x2c, 0x8e, 0xe3, 0x4a, 0x61, 0xe5, 0x86, 0x97, 0xd4, 0x98, 0x96, 0x4b,
+	0x2c, 0xe1, 0x96, 0xf1, 0xd2, 0x04, 0x5c, 0xd7, 0x09, 0xfc, 0x23, 0x8f, 0x0e, 0x05, 0xec, 0x67,
+	0x50, 0x93, 0x13, 0x5b, 0xcc, 0xdf, 0x0b, 0x47, 0x95, 0x9a, 0xf3, 0xb9, 0x51, 0xf1, 0x80, 0x87,
+	0xc2, 0xfd, 0x31, 0x98, 0x5b, 0x0a, 0x4c, 0x02, 0x34, 0xf3, 0x3c, 0x89, 0xa1, 0x9e, 0x6d, 0xb0,
+	0xc4, 0xc0, 0xcf, 0x0d, 0x44, 0x20, 0x24, 0x03, 0xa1, 0xb0, 0x60, 0x91, 0x3e, 0xf1, 0x09, 0xb5,
+	0x39, 0x49, 0x18, 0xff, 0x93, 0x98, 0x87, 0xf2, 0xfb, 0x0b, 0xf9, 0xde, 0xb8, 0x67, 0xac, 0xf5,
+	0xb6, 0x7f, 0x79, 0xdf, 0x36, 0xde, 0xbd, 0x6f, 0x1b, 0x7f, 0xbc, 0x6f, 0x1b, 0xdf, 0x7f, 0x68,
+	0x17, 0xde, 0x7d, 0x68, 0x17, 0x7e, 0xff, 0xd0, 0x2e, 0x3c, 0xff, 0x4f, 0x38, 0xec, 0x70, 0xe7,
+	0xe8, 0x55, 0xc7, 0x09, 0x86, 0x1d, 0x7b, 0xd4, 0x65, 0xc1, 0x88, 0x3a, 0xa4, 0x2b, 0xf1, 0xe4,
+	0xff, 0x1a, 0xe1, 0x61, 0x37, 0x82, 0x3d, 0x9c, 0x95, 0x7f, 0x66, 0x6c, 0xfc, 0x35, 0x00, 0xb9,
+	0xa5, 0x23, 0x04, 0x18, 0x11, 0x00, 0x00,
+}
+
+func (m *Tokens) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1514,54 +1694,44 @@ func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tokens) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MFAResponse != nil {
-		{
-			size, err := m.MFAResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
-		}
+	if m.RefreshExpire != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.RefreshExpire))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if m.Tokens != nil {
-		{
-			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
-		}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.RefreshToken)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Success {
+	if m.TokenExpire != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.TokenExpire))
 		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Token)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFAResponse) Marshal() (dAtA []byte, err error) {
+func (m *VerifyTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1571,55 +1741,27 @@ func (m *MFAResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MFAResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyTokenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MFAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerifyTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Challenge != nil {
-		{
-			size := m.Challenge.Size()
-			i -= size
-			if _, err := m.Challenge.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.Type != 0 {
-		i = encodeVarintPassport(dAtA, i, uint64(m.Type))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Token)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFAResponse_Fido) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MFAResponse_Fido) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Fido != nil {
-		{
-			size, err := m.Fido.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *FIDOChallenge) Marshal() (dAtA []byte, err error) {
+func (m *VerifyTokenResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1629,48 +1771,50 @@ func (m *FIDOChallenge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FIDOChallenge) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FIDOChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerifyTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TrustedFacets) > 0 {
-		for iNdEx := len(m.TrustedFacets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TrustedFacets[iNdEx])
-			copy(dAtA[i:], m.TrustedFacets[iNdEx])
-			i = encodeVarintPassport(dAtA, i, uint64(len(m.TrustedFacets[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if m.RefreshExpire != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.RefreshExpire))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.AppId) > 0 {
-		i -= len(m.AppId)
-		copy(dAtA[i:], m.AppId)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.AppId)))
+	if m.TokenExpire != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.TokenExpire))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintPassport(dAtA, i, uint64(m.Timestamp))
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Challenge)))
+	if m.Valid {
 		i--
-		dAtA[i] = 0xa
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshRequest) Marshal() (dAtA []byte, err error) {
+func (m *UserCreds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1680,27 +1824,75 @@ func (m *RefreshRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserCreds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.RefreshToken)))
+	if m.Next {
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+		if m.Next {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Recaptcha) > 0 {
+		i -= len(m.Recaptcha)
+		copy(dAtA[i:], m.Recaptcha)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Recaptcha)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InsecureLogin {
+		i--
+		if m.InsecureLogin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DevFP) > 0 {
+		i -= len(m.DevFP)
+		copy(dAtA[i:], m.DevFP)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.DevFP)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MFA) > 0 {
+		i -= len(m.MFA)
+		copy(dAtA[i:], m.MFA)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.MFA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SocialRequest) Marshal() (dAtA []byte, err error) {
+func (m *OAuthClientSecretCreds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1710,39 +1902,43 @@ func (m *SocialRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SocialRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OAuthClientSecretCreds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SocialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OAuthClientSecretCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IdpTokens != nil {
-		{
-			size, err := m.IdpTokens.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPassport(dAtA, i, uint64(size))
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintPassport(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Secret)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Provider)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Revoke) Marshal() (dAtA []byte, err error) {
+func (m *OAuthCodeCreds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1752,41 +1948,27 @@ func (m *Revoke) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Revoke) MarshalTo(dAtA []byte) (int, error) {
+func (m *OAuthCodeCreds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Revoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OAuthCodeCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Jti) > 0 {
-		i -= len(m.Jti)
-		copy(dAtA[i:], m.Jti)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Jti)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Id)))
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Code)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Session) Marshal() (dAtA []byte, err error) {
+func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1796,41 +1978,92 @@ func (m *Session) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Jti) > 0 {
-		i -= len(m.Jti)
-		copy(dAtA[i:], m.Jti)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Jti)))
+	if m.Creds != nil {
+		{
+			size := m.Creds.Size()
+			i -= size
+			if _, err := m.Creds.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthRequest_UserCreds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthRequest_UserCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UserCreds != nil {
+		{
+			size, err := m.UserCreds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.Ip) > 0 {
-		i -= len(m.Ip)
-		copy(dAtA[i:], m.Ip)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.Ip)))
+	return len(dAtA) - i, nil
+}
+func (m *AuthRequest_OauthClientSecretCreds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthRequest_OauthClientSecretCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OauthClientSecretCreds != nil {
+		{
+			size, err := m.OauthClientSecretCreds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.UserAgent) > 0 {
-		i -= len(m.UserAgent)
-		copy(dAtA[i:], m.UserAgent)
-		i = encodeVarintPassport(dAtA, i, uint64(len(m.UserAgent)))
+	return len(dAtA) - i, nil
+}
+func (m *AuthRequest_OAuthCodeCreds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthRequest_OAuthCodeCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OAuthCodeCreds != nil {
+		{
+			size, err := m.OAuthCodeCreds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *SessionList) Marshal() (dAtA []byte, err error) {
+func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1840,34 +2073,54 @@ func (m *SessionList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPassport(dAtA, i, uint64(size))
+	if m.MFAResponse != nil {
+		{
+			size, err := m.MFAResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tokens != nil {
+		{
+			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Empty) Marshal() (dAtA []byte, err error) {
+func (m *MFAResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1877,382 +2130,2018 @@ func (m *Empty) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+func (m *MFAResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MFAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Challenge != nil {
+		{
+			size := m.Challenge.Size()
+			i -= size
+			if _, err := m.Challenge.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPassport(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPassport(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MFAResponse_Fido) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFAResponse_Fido) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Fido != nil {
+		{
+			size, err := m.Fido.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *Tokens) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FIDOChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FIDOChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FIDOChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.TrustedFacets) > 0 {
+		for iNdEx := len(m.TrustedFacets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrustedFacets[iNdEx])
+			copy(dAtA[i:], m.TrustedFacets[iNdEx])
+			i = encodeVarintPassport(dAtA, i, uint64(len(m.TrustedFacets[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.TokenExpire != 0 {
-		n += 1 + sovPassport(uint64(m.TokenExpire))
+	if len(m.AppId) > 0 {
+		i -= len(m.AppId)
+		copy(dAtA[i:], m.AppId)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.AppId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.RefreshExpire != 0 {
-		n += 1 + sovPassport(uint64(m.RefreshExpire))
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VerifyTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SocialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SocialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SocialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Valid {
-		n += 2
-	}
-	if m.Revoked {
-		n += 2
-	}
-	if m.TokenExpire != 0 {
-		n += 1 + sovPassport(uint64(m.TokenExpire))
+	if m.IdpTokens != nil {
+		{
+			size, err := m.IdpTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RefreshExpire != 0 {
-		n += 1 + sovPassport(uint64(m.RefreshExpire))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserCreds) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Revoke) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Revoke) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	l = len(m.MFA)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	l = len(m.DevFP)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	if m.InsecureLogin {
-		n += 2
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Recaptcha)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Jti) > 0 {
+		i -= len(m.Jti)
+		copy(dAtA[i:], m.Jti)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Jti)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Next {
-		n += 2
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OAuthClientSecretCreds) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	l = len(m.Secret)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	if len(m.Scopes) > 0 {
-		for _, s := range m.Scopes {
-			l = len(s)
-			n += 1 + l + sovPassport(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OAuthCodeCreds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	return n
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Creds != nil {
-		n += m.Creds.Size()
+	if m.LastRefresh != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.LastRefresh))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *AuthRequest_UserCreds) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Jti) > 0 {
+		i -= len(m.Jti)
+		copy(dAtA[i:], m.Jti)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Jti)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.UserCreds != nil {
-		l = m.UserCreds.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Ip) > 0 {
+		i -= len(m.Ip)
+		copy(dAtA[i:], m.Ip)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Ip)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.UserAgent) > 0 {
+		i -= len(m.UserAgent)
+		copy(dAtA[i:], m.UserAgent)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.UserAgent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *AuthRequest_OauthClientSecretCreds) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SessionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OauthClientSecretCreds != nil {
-		l = m.OauthClientSecretCreds.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPassport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *AuthRequest_OAuthCodeCreds) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FIDORegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FIDORegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FIDORegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OAuthCodeCreds != nil {
-		l = m.OAuthCodeCreds.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *AuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FIDOLoginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FIDOLoginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FIDOLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
-	if m.Tokens != nil {
-		l = m.Tokens.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MFAResponse != nil {
-		l = m.MFAResponse.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MFAResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TOTPEnrolment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TOTPEnrolment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TOTPEnrolment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPassport(uint64(m.Type))
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Challenge != nil {
-		n += m.Challenge.Size()
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MFAResponse_Fido) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TOTPCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TOTPCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TOTPCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Fido != nil {
-		l = m.Fido.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *FIDOChallenge) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BackupCodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupCodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupCodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovPassport(uint64(m.Timestamp))
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Codes[iNdEx])
+			copy(dAtA[i:], m.Codes[iNdEx])
+			i = encodeVarintPassport(dAtA, i, uint64(len(m.Codes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *APIClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TrustedFacets) > 0 {
-		for _, s := range m.TrustedFacets {
-			l = len(s)
-			n += 1 + l + sovPassport(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *APIClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUsed != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.LastUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Created != 0 {
+		i = encodeVarintPassport(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintPassport(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NewAPIClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NewAPIClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewAPIClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintPassport(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SocialRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NewAPIClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NewAPIClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewAPIClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IdpTokens != nil {
-		l = m.IdpTokens.Size()
-		n += 1 + l + sovPassport(uint64(l))
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPassport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Revoke) Size() (n int) {
+func (m *APIClientList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIClientList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIClientList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPassport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAPIClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAPIClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAPIClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPassport(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPassport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPassport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Tokens) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Token)
 	if l > 0 {
 		n += 1 + l + sovPassport(uint64(l))
 	}
-	l = len(m.Jti)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
+	if m.TokenExpire != 0 {
+		n += 1 + sovPassport(uint64(m.TokenExpire))
 	}
-	l = len(m.Reason)
+	l = len(m.RefreshToken)
 	if l > 0 {
 		n += 1 + l + sovPassport(uint64(l))
 	}
+	if m.RefreshExpire != 0 {
+		n += 1 + sovPassport(uint64(m.RefreshExpire))
+	}
 	return n
 }
 
-func (m *Session) Size() (n int) {
+func (m *VerifyTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.UserAgent)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	l = len(m.Ip)
-	if l > 0 {
-		n += 1 + l + sovPassport(uint64(l))
-	}
-	l = len(m.Jti)
+	l = len(m.Token)
 	if l > 0 {
 		n += 1 + l + sovPassport(uint64(l))
 	}
 	return n
 }
 
-func (m *SessionList) Size() (n int) {
+func (m *VerifyTokenResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovPassport(uint64(l))
+	if m.Valid {
+		n += 2
+	}
+	if m.Revoked {
+		n += 2
+	}
+	if m.TokenExpire != 0 {
+		n += 1 + sovPassport(uint64(m.TokenExpire))
+	}
+	if m.RefreshExpire != 0 {
+		n += 1 + sovPassport(uint64(m.RefreshExpire))
+	}
+	return n
+}
+
+func (m *UserCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.MFA)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.DevFP)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if m.InsecureLogin {
+		n += 2
+	}
+	l = len(m.Recaptcha)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if m.Next {
+		n += 2
+	}
+	return n
+}
+
+func (m *OAuthClientSecretCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OAuthCodeCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Creds != nil {
+		n += m.Creds.Size()
+	}
+	return n
+}
+
+func (m *AuthRequest_UserCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserCreds != nil {
+		l = m.UserCreds.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+func (m *AuthRequest_OauthClientSecretCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OauthClientSecretCreds != nil {
+		l = m.OauthClientSecretCreds.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+func (m *AuthRequest_OAuthCodeCreds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OAuthCodeCreds != nil {
+		l = m.OAuthCodeCreds.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+func (m *AuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.Tokens != nil {
+		l = m.Tokens.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if m.MFAResponse != nil {
+		l = m.MFAResponse.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *MFAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPassport(uint64(m.Type))
+	}
+	if m.Challenge != nil {
+		n += m.Challenge.Size()
+	}
+	return n
+}
+
+func (m *MFAResponse_Fido) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fido != nil {
+		l = m.Fido.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+func (m *FIDOChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPassport(uint64(m.Timestamp))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if len(m.TrustedFacets) > 0 {
+		for _, s := range m.TrustedFacets {
+			l = len(s)
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RefreshRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *SocialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if m.IdpTokens != nil {
+		l = m.IdpTokens.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *Revoke) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Jti)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserAgent)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Jti)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if m.LastRefresh != 0 {
+		n += 1 + sovPassport(uint64(m.LastRefresh))
+	}
+	return n
+}
+
+func (m *SessionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FIDORegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *FIDOLoginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *TOTPEnrolment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *TOTPCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *BackupCodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for _, s := range m.Codes {
+			l = len(s)
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *APIClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	if m.Created != 0 {
+		n += 1 + sovPassport(uint64(m.Created))
+	}
+	if m.LastUsed != 0 {
+		n += 1 + sovPassport(uint64(m.LastUsed))
+	}
+	return n
+}
+
+func (m *NewAPIClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NewAPIClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *APIClientList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovPassport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevokeAPIClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPassport(uint64(l))
+	}
+	return n
+}
+
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPassport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPassport(x uint64) (n int) {
+	return sovPassport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpire", wireType)
+			}
+			m.TokenExpire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenExpire |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshExpire", wireType)
+			}
+			m.RefreshExpire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshExpire |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpire", wireType)
+			}
+			m.TokenExpire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenExpire |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshExpire", wireType)
+			}
+			m.RefreshExpire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshExpire |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserCreds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserCreds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MFA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MFA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevFP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevFP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureLogin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureLogin = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recaptcha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recaptcha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Next = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuthClientSecretCreds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuthClientSecretCreds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuthClientSecretCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Empty) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovPassport(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPassport(x uint64) (n int) {
-	return sovPassport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Tokens) Unmarshal(dAtA []byte) error {
+func (m *OAuthCodeCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2275,15 +4164,15 @@ func (m *Tokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: OAuthCodeCreds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OAuthCodeCreds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2311,13 +4200,224 @@ func (m *Tokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCreds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UserCreds{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Creds = &AuthRequest_UserCreds{v}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OauthClientSecretCreds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OAuthClientSecretCreds{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Creds = &AuthRequest_OauthClientSecretCreds{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuthCodeCreds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OAuthCodeCreds{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Creds = &AuthRequest_OAuthCodeCreds{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpire", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.TokenExpire = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2327,16 +4427,17 @@ func (m *Tokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokenExpire |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2346,29 +4447,33 @@ func (m *Tokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			if m.Tokens == nil {
+				m.Tokens = &Tokens{}
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshExpire", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MFAResponse", wireType)
 			}
-			m.RefreshExpire = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2378,11 +4483,28 @@ func (m *Tokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RefreshExpire |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MFAResponse == nil {
+				m.MFAResponse = &MFAResponse{}
+			}
+			if err := m.MFAResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPassport(dAtA[iNdEx:])
@@ -2407,7 +4529,7 @@ func (m *Tokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *MFAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2430,17 +4552,36 @@ func (m *VerifyTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MFAResponse_MFAType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fido", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2450,23 +4591,26 @@ func (m *VerifyTokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			v := &FIDOChallenge{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Challenge = &MFAResponse_Fido{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2492,7 +4636,7 @@ func (m *VerifyTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,17 +4659,17 @@ func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FIDOChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FIDOChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2535,17 +4679,29 @@ func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2555,17 +4711,133 @@ func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Revoked = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpire", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedFacets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedFacets = append(m.TrustedFacets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
 			}
-			m.TokenExpire = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2575,30 +4847,24 @@ func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokenExpire |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshExpire", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
 			}
-			m.RefreshExpire = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RefreshExpire |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPassport(dAtA[iNdEx:])
@@ -2623,7 +4889,7 @@ func (m *VerifyTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserCreds) Unmarshal(dAtA []byte) error {
+func (m *SocialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,15 +4912,15 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: SocialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SocialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2682,13 +4948,13 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdpTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -2698,27 +4964,84 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			if m.IdpTokens == nil {
+				m.IdpTokens = &Tokens{}
+			}
+			if err := m.IdpTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Revoke) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Revoke: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Revoke: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MFA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2746,11 +5069,11 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MFA = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevFP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jti", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,31 +5101,11 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevFP = string(dAtA[iNdEx:postIndex])
+			m.Jti = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureLogin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureLogin = bool(v != 0)
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recaptcha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2830,28 +5133,8 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recaptcha = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Next = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPassport(dAtA[iNdEx:])
@@ -2876,7 +5159,7 @@ func (m *UserCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuthClientSecretCreds) Unmarshal(dAtA []byte) error {
+func (m *Session) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2899,15 +5182,15 @@ func (m *OAuthClientSecretCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuthClientSecretCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuthClientSecretCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2935,11 +5218,11 @@ func (m *OAuthClientSecretCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.UserAgent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2967,11 +5250,11 @@ func (m *OAuthClientSecretCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secret = string(dAtA[iNdEx:postIndex])
+			m.Ip = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jti", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2999,66 +5282,13 @@ func (m *OAuthClientSecretCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			m.Jti = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPassport(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPassport
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPassport
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OAuthCodeCreds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPassport
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OAuthCodeCreds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuthCodeCreds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefresh", wireType)
 			}
-			var stringLen uint64
+			m.LastRefresh = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -3068,24 +5298,11 @@ func (m *OAuthCodeCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastRefresh |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPassport
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPassport
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Code = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPassport(dAtA[iNdEx:])
@@ -3110,7 +5327,7 @@ func (m *OAuthCodeCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3133,85 +5350,15 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserCreds", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPassport
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPassport
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &UserCreds{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Creds = &AuthRequest_UserCreds{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OauthClientSecretCreds", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPassport
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPassport
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &OAuthClientSecretCreds{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Creds = &AuthRequest_OauthClientSecretCreds{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OAuthCodeCreds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3238,11 +5385,10 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OAuthCodeCreds{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Creds = &AuthRequest_OAuthCodeCreds{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3268,7 +5414,7 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthResponse) Unmarshal(dAtA []byte) error {
+func (m *FIDORegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,37 +5437,17 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FIDORegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FIDORegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -3331,33 +5457,29 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tokens == nil {
-				m.Tokens = &Tokens{}
-			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MFAResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -3367,27 +5489,23 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MFAResponse == nil {
-				m.MFAResponse = &MFAResponse{}
-			}
-			if err := m.MFAResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Response = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3413,7 +5531,7 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MFAResponse) Unmarshal(dAtA []byte) error {
+func (m *FIDOLoginRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3436,17 +5554,17 @@ func (m *MFAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MFAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FIDOLoginRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MFAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FIDOLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -3456,16 +5574,29 @@ func (m *MFAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= MFAResponse_MFAType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fido", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -3475,26 +5606,23 @@ func (m *MFAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &FIDOChallenge{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Challenge = &MFAResponse_Fido{v}
+			m.Response = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3520,7 +5648,7 @@ func (m *MFAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
+func (m *TOTPEnrolment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3543,15 +5671,15 @@ func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FIDOChallenge: wiretype end group for non-group")
+			return fmt.Errorf("proto: TOTPEnrolment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FIDOChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TOTPEnrolment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3579,30 +5707,11 @@ func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3630,11 +5739,64 @@ func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TOTPCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TOTPCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TOTPCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedFacets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3662,7 +5824,7 @@ func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedFacets = append(m.TrustedFacets, string(dAtA[iNdEx:postIndex]))
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3688,7 +5850,7 @@ func (m *FIDOChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupCodes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3711,15 +5873,15 @@ func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupCodes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupCodes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3747,7 +5909,7 @@ func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			m.Codes = append(m.Codes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3773,7 +5935,7 @@ func (m *RefreshRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SocialRequest) Unmarshal(dAtA []byte) error {
+func (m *APIClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3796,15 +5958,15 @@ func (m *SocialRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SocialRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: APIClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SocialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: APIClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3832,13 +5994,13 @@ func (m *SocialRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdpTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -3848,28 +6010,94 @@ func (m *SocialRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IdpTokens == nil {
-				m.IdpTokens = &Tokens{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			if err := m.IdpTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPassport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
+			m.LastUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPassport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPassport(dAtA[iNdEx:])
@@ -3894,7 +6122,7 @@ func (m *SocialRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Revoke) Unmarshal(dAtA []byte) error {
+func (m *NewAPIClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3917,15 +6145,15 @@ func (m *Revoke) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Revoke: wiretype end group for non-group")
+			return fmt.Errorf("proto: NewAPIClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Revoke: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NewAPIClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3953,43 +6181,11 @@ func (m *Revoke) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jti", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPassport
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPassport
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPassport
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Jti = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4017,7 +6213,7 @@ func (m *Revoke) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4043,7 +6239,7 @@ func (m *Revoke) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Session) Unmarshal(dAtA []byte) error {
+func (m *NewAPIClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4066,17 +6262,17 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+			return fmt.Errorf("proto: NewAPIClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NewAPIClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -4086,27 +6282,31 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAgent = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &APIClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4134,13 +6334,66 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = string(dAtA[iNdEx:postIndex])
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPassport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPassport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APIClientList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPassport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIClientList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIClientList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jti", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -4150,23 +6403,25 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jti = string(dAtA[iNdEx:postIndex])
+			m.Clients = append(m.Clients, &APIClient{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4192,7 +6447,7 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionList) Unmarshal(dAtA []byte) error {
+func (m *RevokeAPIClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4215,17 +6470,17 @@ func (m *SessionList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAPIClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAPIClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPassport
@@ -4235,25 +6490,23 @@ func (m *SessionList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPassport
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPassport
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &Session{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
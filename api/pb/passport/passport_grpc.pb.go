@@ -24,7 +24,17 @@ type PassportSeviceClient interface {
 	SocialLogin(ctx context.Context, in *SocialRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	RevokeToken(ctx context.Context, in *Revoke, opts ...grpc.CallOption) (*Empty, error)
 	RevokeAllTokens(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	RevokeUserTokens(ctx context.Context, in *Revoke, opts ...grpc.CallOption) (*Empty, error)
 	Sessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionList, error)
+	CreateAPIClient(ctx context.Context, in *NewAPIClientRequest, opts ...grpc.CallOption) (*NewAPIClientResponse, error)
+	ListAPIClients(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*APIClientList, error)
+	RevokeAPIClient(ctx context.Context, in *RevokeAPIClientRequest, opts ...grpc.CallOption) (*Empty, error)
+	FinishFIDOLogin(ctx context.Context, in *FIDOLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	BeginFIDORegistration(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FIDOChallenge, error)
+	FinishFIDORegistration(ctx context.Context, in *FIDORegistration, opts ...grpc.CallOption) (*Empty, error)
+	EnrolTOTP(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TOTPEnrolment, error)
+	ConfirmTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*BackupCodes, error)
+	RegenerateBackupCodes(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*BackupCodes, error)
 }
 
 type passportSeviceClient struct {
@@ -89,6 +99,15 @@ func (c *passportSeviceClient) RevokeAllTokens(ctx context.Context, in *Empty, o
 	return out, nil
 }
 
+func (c *passportSeviceClient) RevokeUserTokens(ctx context.Context, in *Revoke, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/RevokeUserTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *passportSeviceClient) Sessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionList, error) {
 	out := new(SessionList)
 	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/Sessions", in, out, opts...)
@@ -98,6 +117,87 @@ func (c *passportSeviceClient) Sessions(ctx context.Context, in *Empty, opts ...
 	return out, nil
 }
 
+func (c *passportSeviceClient) CreateAPIClient(ctx context.Context, in *NewAPIClientRequest, opts ...grpc.CallOption) (*NewAPIClientResponse, error) {
+	out := new(NewAPIClientResponse)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/CreateAPIClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) ListAPIClients(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*APIClientList, error) {
+	out := new(APIClientList)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/ListAPIClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) RevokeAPIClient(ctx context.Context, in *RevokeAPIClientRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/RevokeAPIClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) FinishFIDOLogin(ctx context.Context, in *FIDOLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/FinishFIDOLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) BeginFIDORegistration(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FIDOChallenge, error) {
+	out := new(FIDOChallenge)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/BeginFIDORegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) FinishFIDORegistration(ctx context.Context, in *FIDORegistration, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/FinishFIDORegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) EnrolTOTP(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TOTPEnrolment, error) {
+	out := new(TOTPEnrolment)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/EnrolTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) ConfirmTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*BackupCodes, error) {
+	out := new(BackupCodes)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportSeviceClient) RegenerateBackupCodes(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*BackupCodes, error) {
+	out := new(BackupCodes)
+	err := c.cc.Invoke(ctx, "/ataas.passport.PassportSevice/RegenerateBackupCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PassportSeviceServer is the server API for PassportSevice service.
 // All implementations must embed UnimplementedPassportSeviceServer
 // for forward compatibility
@@ -108,7 +208,17 @@ type PassportSeviceServer interface {
 	SocialLogin(context.Context, *SocialRequest) (*AuthResponse, error)
 	RevokeToken(context.Context, *Revoke) (*Empty, error)
 	RevokeAllTokens(context.Context, *Empty) (*Empty, error)
+	RevokeUserTokens(context.Context, *Revoke) (*Empty, error)
 	Sessions(context.Context, *Empty) (*SessionList, error)
+	CreateAPIClient(context.Context, *NewAPIClientRequest) (*NewAPIClientResponse, error)
+	ListAPIClients(context.Context, *Empty) (*APIClientList, error)
+	RevokeAPIClient(context.Context, *RevokeAPIClientRequest) (*Empty, error)
+	FinishFIDOLogin(context.Context, *FIDOLoginRequest) (*AuthResponse, error)
+	BeginFIDORegistration(context.Context, *Empty) (*FIDOChallenge, error)
+	FinishFIDORegistration(context.Context, *FIDORegistration) (*Empty, error)
+	EnrolTOTP(context.Context, *Empty) (*TOTPEnrolment, error)
+	ConfirmTOTP(context.Context, *TOTPCode) (*BackupCodes, error)
+	RegenerateBackupCodes(context.Context, *TOTPCode) (*BackupCodes, error)
 	mustEmbedUnimplementedPassportSeviceServer()
 }
 
@@ -134,9 +244,39 @@ func (UnimplementedPassportSeviceServer) RevokeToken(context.Context, *Revoke) (
 func (UnimplementedPassportSeviceServer) RevokeAllTokens(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllTokens not implemented")
 }
+func (UnimplementedPassportSeviceServer) RevokeUserTokens(context.Context, *Revoke) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
 func (UnimplementedPassportSeviceServer) Sessions(context.Context, *Empty) (*SessionList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
 }
+func (UnimplementedPassportSeviceServer) CreateAPIClient(context.Context, *NewAPIClientRequest) (*NewAPIClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIClient not implemented")
+}
+func (UnimplementedPassportSeviceServer) ListAPIClients(context.Context, *Empty) (*APIClientList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIClients not implemented")
+}
+func (UnimplementedPassportSeviceServer) RevokeAPIClient(context.Context, *RevokeAPIClientRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIClient not implemented")
+}
+func (UnimplementedPassportSeviceServer) FinishFIDOLogin(context.Context, *FIDOLoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishFIDOLogin not implemented")
+}
+func (UnimplementedPassportSeviceServer) BeginFIDORegistration(context.Context, *Empty) (*FIDOChallenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginFIDORegistration not implemented")
+}
+func (UnimplementedPassportSeviceServer) FinishFIDORegistration(context.Context, *FIDORegistration) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishFIDORegistration not implemented")
+}
+func (UnimplementedPassportSeviceServer) EnrolTOTP(context.Context, *Empty) (*TOTPEnrolment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrolTOTP not implemented")
+}
+func (UnimplementedPassportSeviceServer) ConfirmTOTP(context.Context, *TOTPCode) (*BackupCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedPassportSeviceServer) RegenerateBackupCodes(context.Context, *TOTPCode) (*BackupCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateBackupCodes not implemented")
+}
 func (UnimplementedPassportSeviceServer) mustEmbedUnimplementedPassportSeviceServer() {}
 
 // UnsafePassportSeviceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +398,24 @@ func _PassportSevice_RevokeAllTokens_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PassportSevice_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Revoke)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).RevokeUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/RevokeUserTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).RevokeUserTokens(ctx, req.(*Revoke))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PassportSevice_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -276,6 +434,168 @@ func _PassportSevice_Sessions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PassportSevice_CreateAPIClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAPIClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).CreateAPIClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/CreateAPIClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).CreateAPIClient(ctx, req.(*NewAPIClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_ListAPIClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).ListAPIClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/ListAPIClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).ListAPIClients(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_RevokeAPIClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).RevokeAPIClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/RevokeAPIClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).RevokeAPIClient(ctx, req.(*RevokeAPIClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_FinishFIDOLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FIDOLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).FinishFIDOLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/FinishFIDOLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).FinishFIDOLogin(ctx, req.(*FIDOLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_BeginFIDORegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).BeginFIDORegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/BeginFIDORegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).BeginFIDORegistration(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_FinishFIDORegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FIDORegistration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).FinishFIDORegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/FinishFIDORegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).FinishFIDORegistration(ctx, req.(*FIDORegistration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_EnrolTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).EnrolTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/EnrolTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).EnrolTOTP(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).ConfirmTOTP(ctx, req.(*TOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportSevice_RegenerateBackupCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportSeviceServer).RegenerateBackupCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.passport.PassportSevice/RegenerateBackupCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportSeviceServer).RegenerateBackupCodes(ctx, req.(*TOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PassportSevice_ServiceDesc is the grpc.ServiceDesc for PassportSevice service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -307,10 +627,50 @@ var PassportSevice_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllTokens",
 			Handler:    _PassportSevice_RevokeAllTokens_Handler,
 		},
+		{
+			MethodName: "RevokeUserTokens",
+			Handler:    _PassportSevice_RevokeUserTokens_Handler,
+		},
 		{
 			MethodName: "Sessions",
 			Handler:    _PassportSevice_Sessions_Handler,
 		},
+		{
+			MethodName: "CreateAPIClient",
+			Handler:    _PassportSevice_CreateAPIClient_Handler,
+		},
+		{
+			MethodName: "ListAPIClients",
+			Handler:    _PassportSevice_ListAPIClients_Handler,
+		},
+		{
+			MethodName: "RevokeAPIClient",
+			Handler:    _PassportSevice_RevokeAPIClient_Handler,
+		},
+		{
+			MethodName: "FinishFIDOLogin",
+			Handler:    _PassportSevice_FinishFIDOLogin_Handler,
+		},
+		{
+			MethodName: "BeginFIDORegistration",
+			Handler:    _PassportSevice_BeginFIDORegistration_Handler,
+		},
+		{
+			MethodName: "FinishFIDORegistration",
+			Handler:    _PassportSevice_FinishFIDORegistration_Handler,
+		},
+		{
+			MethodName: "EnrolTOTP",
+			Handler:    _PassportSevice_EnrolTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _PassportSevice_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateBackupCodes",
+			Handler:    _PassportSevice_RegenerateBackupCodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "passport.proto",
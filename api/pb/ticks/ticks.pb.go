@@ -798,912 +798,3626 @@ func (m *CompareResponse) GetDifference() float32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ataas.ticks.TradeDirection", TradeDirection_name, TradeDirection_value)
-	proto.RegisterType((*Tick)(nil), "ataas.ticks.Tick")
-	proto.RegisterType((*OHLCV)(nil), "ataas.ticks.OHLCV")
-	proto.RegisterType((*Trade)(nil), "ataas.ticks.Trade")
-	proto.RegisterType((*GetRequest)(nil), "ataas.ticks.GetRequest")
-	proto.RegisterType((*TicksResponse)(nil), "ataas.ticks.TicksResponse")
-	proto.RegisterType((*TradesResponse)(nil), "ataas.ticks.TradesResponse")
-	proto.RegisterType((*CandlesRequest)(nil), "ataas.ticks.CandlesRequest")
-	proto.RegisterType((*CandlesResponse)(nil), "ataas.ticks.CandlesResponse")
-	proto.RegisterType((*RangeRequest)(nil), "ataas.ticks.RangeRequest")
-	proto.RegisterType((*CompareRequest)(nil), "ataas.ticks.CompareRequest")
-	proto.RegisterType((*CompareResponse)(nil), "ataas.ticks.CompareResponse")
+type Symbol struct {
+	Market           string  `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Instrument       string  `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Base             string  `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Quote            string  `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	Status           string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	PriceDecimals    int32   `protobuf:"varint,6,opt,name=priceDecimals,proto3" json:"priceDecimals,omitempty"`
+	QuantityDecimals int32   `protobuf:"varint,7,opt,name=quantityDecimals,proto3" json:"quantityDecimals,omitempty"`
+	TickSize         float64 `protobuf:"fixed64,8,opt,name=tickSize,proto3" json:"tickSize,omitempty"`
+	StepSize         float64 `protobuf:"fixed64,9,opt,name=stepSize,proto3" json:"stepSize,omitempty"`
+	MinQuantity      float64 `protobuf:"fixed64,10,opt,name=minQuantity,proto3" json:"minQuantity,omitempty"`
+	MinNotional      float64 `protobuf:"fixed64,11,opt,name=minNotional,proto3" json:"minNotional,omitempty"`
+}
+
+func (m *Symbol) Reset()         { *m = Symbol{} }
+func (m *Symbol) String() string { return proto.CompactTextString(m) }
+func (*Symbol) ProtoMessage()    {}
+func (*Symbol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{11}
+}
+func (m *Symbol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Symbol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Symbol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Symbol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Symbol.Merge(m, src)
+}
+func (m *Symbol) XXX_Size() int {
+	return m.Size()
+}
+func (m *Symbol) XXX_DiscardUnknown() {
+	xxx_messageInfo_Symbol.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("ticks.proto", fileDescriptor_1d46c2f7535a5e32) }
+var xxx_messageInfo_Symbol proto.InternalMessageInfo
 
-var fileDescriptor_1d46c2f7535a5e32 = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0xaf, 0x93, 0xb8, 0x69, 0x26, 0x90, 0xf6, 0x96, 0x0a, 0x4c, 0xa8, 0x4c, 0xb5, 0x08, 0x28,
-	0x48, 0xc4, 0x77, 0x85, 0x87, 0x83, 0xb7, 0x4b, 0x8b, 0x38, 0x50, 0x25, 0x90, 0x7b, 0x20, 0xd1,
-	0x27, 0xb6, 0xf6, 0x36, 0x59, 0xc5, 0xf6, 0xfa, 0xbc, 0xeb, 0x56, 0x80, 0x78, 0xe1, 0x13, 0x20,
-	0xf1, 0x99, 0x40, 0x3c, 0x9e, 0xc4, 0x03, 0x3c, 0x21, 0xd4, 0xf2, 0x09, 0xf8, 0x02, 0xa0, 0xfd,
-	0x13, 0xc7, 0x4e, 0x43, 0x85, 0x0a, 0xf7, 0xb6, 0x33, 0xbf, 0xd9, 0x9d, 0xdf, 0xfc, 0x66, 0xc6,
-	0x86, 0xbe, 0x64, 0xd1, 0x4c, 0x8c, 0xf2, 0x82, 0x4b, 0x8e, 0xfa, 0x44, 0x12, 0x22, 0x46, 0xda,
-	0x35, 0xdc, 0x99, 0x70, 0x3e, 0x49, 0x68, 0x40, 0x72, 0x16, 0x90, 0x2c, 0xe3, 0x92, 0x48, 0xc6,
-	0x33, 0x1b, 0x3a, 0x84, 0x09, 0x9f, 0x70, 0x73, 0xc6, 0x3f, 0xb6, 0xa0, 0xf3, 0x88, 0x45, 0x33,
-	0xf4, 0x3c, 0xac, 0xa7, 0xa4, 0x98, 0x51, 0xe9, 0x39, 0xbb, 0xce, 0x5e, 0x2f, 0xb4, 0x16, 0xf2,
-	0x01, 0x58, 0x26, 0x64, 0x51, 0xa6, 0x34, 0x93, 0x5e, 0x4b, 0x63, 0x35, 0x0f, 0xf2, 0xa0, 0x7b,
-	0x4a, 0x85, 0x1c, 0xb3, 0xd8, 0x6b, 0xef, 0x3a, 0x7b, 0xad, 0x70, 0x6e, 0xce, 0x91, 0x07, 0x62,
-	0xe6, 0x75, 0x16, 0xc8, 0x03, 0x31, 0x43, 0x08, 0x3a, 0x09, 0x11, 0xd2, 0x73, 0xb5, 0x5b, 0x9f,
-	0xd1, 0x0e, 0xf4, 0x24, 0x4b, 0xa9, 0x90, 0x24, 0xcd, 0xbd, 0x75, 0x0d, 0x2c, 0x1c, 0x0a, 0x3d,
-	0xe7, 0x49, 0x99, 0xd2, 0xfd, 0x77, 0xa6, 0x5e, 0xd7, 0xa0, 0x95, 0x43, 0x71, 0x9c, 0xb2, 0xc9,
-	0x94, 0x0a, 0xa9, 0xe0, 0x0d, 0x0d, 0xd7, 0x3c, 0xea, 0x76, 0xc2, 0x2f, 0x2c, 0xdc, 0x33, 0xb7,
-	0x2b, 0x07, 0xba, 0x0b, 0xcf, 0x45, 0x09, 0x17, 0xf4, 0x93, 0x82, 0x45, 0xf4, 0x60, 0x4a, 0xb2,
-	0x89, 0xce, 0x02, 0x3a, 0x6e, 0x15, 0xa4, 0xf8, 0xf3, 0x9c, 0x66, 0x5e, 0xdf, 0xf0, 0x57, 0x67,
-	0xfc, 0x83, 0x03, 0xee, 0xc7, 0x0f, 0x8f, 0x0e, 0x3e, 0xbb, 0xb5, 0x92, 0xf3, 0x57, 0xdb, 0x8b,
-	0x57, 0x95, 0x4f, 0xd5, 0x61, 0x05, 0xd4, 0x67, 0xb4, 0x05, 0xed, 0x84, 0x5f, 0x58, 0xf1, 0xd4,
-	0x11, 0x6d, 0x83, 0xab, 0x69, 0x5a, 0xdd, 0x8c, 0xa1, 0x78, 0x18, 0x89, 0xac, 0x60, 0xd6, 0x6a,
-	0x2a, 0xad, 0xc4, 0x6a, 0xd7, 0x94, 0xc6, 0xbf, 0xb4, 0xc0, 0x7d, 0x54, 0x90, 0x98, 0xa2, 0xd7,
-	0x9b, 0x75, 0x8c, 0x37, 0xff, 0xfc, 0xed, 0xe5, 0x7e, 0x2a, 0x26, 0x39, 0x89, 0x66, 0xef, 0xe1,
-	0x14, 0x57, 0x85, 0x05, 0xd7, 0x0b, 0x5b, 0x0a, 0x16, 0xb8, 0x51, 0xe9, 0x1b, 0xd0, 0x95, 0x2a,
-	0xc5, 0x87, 0x87, 0xba, 0xd8, 0xe5, 0x68, 0x86, 0xc3, 0x39, 0x8e, 0x3e, 0x82, 0x5e, 0xcc, 0x0a,
-	0x1a, 0xa9, 0xf9, 0xd5, 0x2a, 0x0c, 0xf6, 0x5f, 0x1a, 0xd5, 0x46, 0x7d, 0xa4, 0xb9, 0x1e, 0xce,
-	0x43, 0x96, 0x5e, 0x8a, 0x71, 0xb8, 0xb8, 0xae, 0x0a, 0x22, 0x29, 0x2f, 0x33, 0x3b, 0x78, 0x4b,
-	0xb1, 0x39, 0x0e, 0x2d, 0x8c, 0x5e, 0x05, 0xb7, 0xcc, 0x98, 0x14, 0x46, 0xcf, 0xa5, 0xb8, 0xc7,
-	0x38, 0x34, 0x28, 0x7a, 0xab, 0x2e, 0xa4, 0xd2, 0xb8, 0xbd, 0x14, 0x2a, 0x71, 0x5d, 0xd9, 0x13,
-	0x80, 0x0f, 0xa8, 0x0c, 0xe9, 0xe3, 0x92, 0x0a, 0x79, 0xeb, 0x29, 0xd9, 0x06, 0x37, 0xa6, 0xb9,
-	0x34, 0x23, 0xe1, 0x86, 0xc6, 0xc0, 0xf7, 0xe1, 0x59, 0xb5, 0xc5, 0x22, 0xa4, 0x22, 0xe7, 0x99,
-	0x50, 0xcd, 0x73, 0xb5, 0x3e, 0x9e, 0xb3, 0xdb, 0xde, 0xeb, 0xef, 0xdf, 0x69, 0x6a, 0xc6, 0xa2,
-	0x59, 0x68, 0x70, 0x7c, 0x1f, 0x06, 0x5a, 0xc2, 0xc5, 0xd5, 0xd7, 0xa0, 0x13, 0x13, 0x49, 0xec,
-	0x4d, 0x74, 0x5d, 0xed, 0x50, 0xe3, 0xf8, 0x2b, 0x18, 0x1c, 0x90, 0x2c, 0x4e, 0xd4, 0xd5, 0xff,
-	0x56, 0xd3, 0x10, 0x36, 0x58, 0x26, 0x69, 0x71, 0x4e, 0x12, 0x33, 0x10, 0x61, 0x65, 0xff, 0x43,
-	0xbd, 0xef, 0xc2, 0x66, 0x95, 0xfb, 0x5f, 0xd0, 0xd6, 0x8b, 0x69, 0x69, 0x17, 0xf0, 0x4c, 0xa8,
-	0x16, 0xf9, 0x7f, 0x68, 0x84, 0x60, 0x59, 0x44, 0x2d, 0x63, 0x63, 0x28, 0x6f, 0x99, 0x49, 0x96,
-	0x68, 0xba, 0xbd, 0xd0, 0x18, 0x38, 0x86, 0xc1, 0x01, 0x4f, 0x73, 0x52, 0xd0, 0xa7, 0x28, 0x15,
-	0xbe, 0x07, 0x9b, 0x55, 0x16, 0x2b, 0x8a, 0x0f, 0x10, 0xb3, 0xb3, 0x33, 0x5a, 0x50, 0xc5, 0xd4,
-	0x31, 0x5f, 0xc6, 0x85, 0xe7, 0xcd, 0x57, 0x6c, 0xf7, 0xab, 0x05, 0x42, 0x5d, 0x68, 0x8f, 0x3f,
-	0xfd, 0x7c, 0x6b, 0x0d, 0x6d, 0x40, 0xe7, 0xf8, 0xfd, 0xa3, 0xa3, 0x2d, 0x67, 0xff, 0xaf, 0x16,
-	0x0c, 0x1e, 0x32, 0x21, 0x79, 0xf1, 0xe5, 0x31, 0x2d, 0xce, 0x59, 0x44, 0xd1, 0x09, 0xac, 0x9b,
-	0xa9, 0x41, 0x2f, 0x34, 0x84, 0x5e, 0x0c, 0xf8, 0x70, 0xc5, 0x9a, 0x56, 0xcd, 0xc2, 0xc3, 0x6f,
-	0x7f, 0xfe, 0xe3, 0xfb, 0xd6, 0x36, 0x42, 0xc1, 0xf9, 0xbd, 0x60, 0x6a, 0xde, 0x0e, 0xa4, 0x79,
-	0x91, 0x41, 0xdf, 0x46, 0xab, 0x36, 0xa1, 0x17, 0x1b, 0xef, 0xd4, 0x5b, 0x77, 0x73, 0x0a, 0xac,
-	0x53, 0xec, 0xa0, 0xe1, 0xf5, 0x14, 0xc1, 0xd7, 0xba, 0x59, 0xdf, 0xa0, 0x43, 0xb8, 0x53, 0x4b,
-	0x75, 0x2c, 0x0b, 0x4a, 0xd2, 0x9b, 0x12, 0xae, 0x58, 0x86, 0xbb, 0x0e, 0xfa, 0x02, 0xba, 0x76,
-	0x18, 0x51, 0x93, 0x51, 0x73, 0x3d, 0x86, 0x3b, 0xab, 0xc1, 0x9b, 0x24, 0x89, 0x74, 0xd0, 0x78,
-	0xfc, 0xd3, 0xa5, 0xef, 0x3c, 0xb9, 0xf4, 0x9d, 0xdf, 0x2f, 0x7d, 0xe7, 0xbb, 0x2b, 0x7f, 0xed,
-	0xc9, 0x95, 0xbf, 0xf6, 0xeb, 0x95, 0xbf, 0x76, 0xb2, 0x97, 0xa7, 0x23, 0x19, 0x9d, 0x5d, 0x8c,
-	0x22, 0x9e, 0x8e, 0x48, 0x19, 0x08, 0x5e, 0x16, 0x11, 0x0d, 0x74, 0x22, 0xfd, 0xfb, 0xcf, 0x4f,
-	0x03, 0x9d, 0xef, 0x74, 0x5d, 0xff, 0xf0, 0xdf, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x53, 0xb3,
-	0x72, 0x4e, 0x36, 0x08, 0x00, 0x00,
+func (m *Symbol) GetMarket() string {
+	if m != nil {
+		return m.Market
+	}
+	return ""
 }
 
-func (m *Tick) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Symbol) GetInstrument() string {
+	if m != nil {
+		return m.Instrument
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Tick) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Symbol) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
 }
 
-func (m *Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Open != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Open))))
-		i--
-		dAtA[i] = 0x5d
-	}
-	if m.ClosePriceChange24H != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ClosePriceChange24H))))
-		i--
-		dAtA[i] = 0x55
-	}
-	if m.Lowest24H != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lowest24H))))
-		i--
-		dAtA[i] = 0x4d
-	}
-	if m.Highest24H != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Highest24H))))
-		i--
-		dAtA[i] = 0x45
-	}
-	if m.Volume24H != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Volume24H))))
-		i--
-		dAtA[i] = 0x3d
+func (m *Symbol) GetQuote() string {
+	if m != nil {
+		return m.Quote
 	}
-	if m.Timestamp != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Timestamp))))
-		i--
-		dAtA[i] = 0x35
+	return ""
+}
+
+func (m *Symbol) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if m.Last != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Last))))
-		i--
-		dAtA[i] = 0x2d
+	return ""
+}
+
+func (m *Symbol) GetPriceDecimals() int32 {
+	if m != nil {
+		return m.PriceDecimals
 	}
-	if m.BestAsk != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BestAsk))))
-		i--
-		dAtA[i] = 0x25
+	return 0
+}
+
+func (m *Symbol) GetQuantityDecimals() int32 {
+	if m != nil {
+		return m.QuantityDecimals
 	}
-	if m.BestBid != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BestBid))))
-		i--
-		dAtA[i] = 0x1d
+	return 0
+}
+
+func (m *Symbol) GetTickSize() float64 {
+	if m != nil {
+		return m.TickSize
 	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Symbol) GetStepSize() float64 {
+	if m != nil {
+		return m.StepSize
 	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Symbol) GetMinQuantity() float64 {
+	if m != nil {
+		return m.MinQuantity
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OHLCV) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Symbol) GetMinNotional() float64 {
+	if m != nil {
+		return m.MinNotional
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OHLCV) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MarketsRequest struct {
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Quote  string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
-func (m *OHLCV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintTicks(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Volume != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Volume))))
-		i--
-		dAtA[i] = 0x3d
-	}
-	if m.Close != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Close))))
-		i--
-		dAtA[i] = 0x35
-	}
-	if m.Low != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Low))))
-		i--
-		dAtA[i] = 0x2d
-	}
-	if m.High != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.High))))
-		i--
-		dAtA[i] = 0x25
-	}
-	if m.Open != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Open))))
-		i--
-		dAtA[i] = 0x1d
-	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
+func (m *MarketsRequest) Reset()         { *m = MarketsRequest{} }
+func (m *MarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketsRequest) ProtoMessage()    {}
+func (*MarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{12}
+}
+func (m *MarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketsRequest.Merge(m, src)
+}
+func (m *MarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketsRequest proto.InternalMessageInfo
+
+func (m *MarketsRequest) GetMarket() string {
+	if m != nil {
+		return m.Market
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Trade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketsRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MarketsResponse struct {
+	Symbols []*Symbol `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
 }
 
-func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintTicks(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Units != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Units))))
-		i--
-		dAtA[i] = 0x35
-	}
-	if m.Amount != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Amount))))
-		i--
-		dAtA[i] = 0x2d
-	}
-	if m.Direction != 0 {
-		i = encodeVarintTicks(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TradeID) > 0 {
-		i -= len(m.TradeID)
-		copy(dAtA[i:], m.TradeID)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.TradeID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
+func (m *MarketsResponse) Reset()         { *m = MarketsResponse{} }
+func (m *MarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketsResponse) ProtoMessage()    {}
+func (*MarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{13}
+}
+func (m *MarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketsResponse.Merge(m, src)
+}
+func (m *MarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketsResponse.DiscardUnknown(m)
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketsResponse proto.InternalMessageInfo
+
+func (m *MarketsResponse) GetSymbols() []*Symbol {
+	if m != nil {
+		return m.Symbols
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CollectedInstrument struct {
+	Market     string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Instrument string `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	CreatedAt  string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	//rawRetention duration raw trades are kept, empty for the default
+	RawRetention string `protobuf:"bytes,4,opt,name=rawRetention,proto3" json:"rawRetention,omitempty"`
+	//compactedRetention duration compacted trades are kept, empty for the default
+	CompactedRetention string `protobuf:"bytes,5,opt,name=compactedRetention,proto3" json:"compactedRetention,omitempty"`
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Depth != 0 {
-		i = encodeVarintTicks(dAtA, i, uint64(m.Depth))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
+func (m *CollectedInstrument) Reset()         { *m = CollectedInstrument{} }
+func (m *CollectedInstrument) String() string { return proto.CompactTextString(m) }
+func (*CollectedInstrument) ProtoMessage()    {}
+func (*CollectedInstrument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{14}
+}
+func (m *CollectedInstrument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedInstrument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedInstrument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CollectedInstrument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedInstrument.Merge(m, src)
+}
+func (m *CollectedInstrument) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedInstrument) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedInstrument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectedInstrument proto.InternalMessageInfo
+
+func (m *CollectedInstrument) GetMarket() string {
+	if m != nil {
+		return m.Market
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TicksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CollectedInstrument) GetInstrument() string {
+	if m != nil {
+		return m.Instrument
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TicksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CollectedInstrument) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
 }
 
-func (m *TicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ticks) > 0 {
-		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTicks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CollectedInstrument) GetRawRetention() string {
+	if m != nil {
+		return m.RawRetention
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TradesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CollectedInstrument) GetCompactedRetention() string {
+	if m != nil {
+		return m.CompactedRetention
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TradesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CollectedRequest struct {
+	Market     string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Instrument string `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
 }
 
-func (m *TradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTicks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CollectedRequest) Reset()         { *m = CollectedRequest{} }
+func (m *CollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*CollectedRequest) ProtoMessage()    {}
+func (*CollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{15}
+}
+func (m *CollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CandlesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedRequest.Merge(m, src)
 }
-
-func (m *CandlesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedRequest.DiscardUnknown(m)
 }
 
-func (m *CandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Depth != 0 {
-		i = encodeVarintTicks(dAtA, i, uint64(m.Depth))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Interval) > 0 {
-		i -= len(m.Interval)
-		copy(dAtA[i:], m.Interval)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Interval)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_CollectedRequest proto.InternalMessageInfo
+
+func (m *CollectedRequest) GetMarket() string {
+	if m != nil {
+		return m.Market
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CandlesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CollectedRequest) GetInstrument() string {
+	if m != nil {
+		return m.Instrument
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CandlesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CollectedResponse struct {
+	Instruments []*CollectedInstrument `protobuf:"bytes,1,rep,name=instruments,proto3" json:"instruments,omitempty"`
 }
 
-func (m *CandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTicks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CollectedResponse) Reset()         { *m = CollectedResponse{} }
+func (m *CollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*CollectedResponse) ProtoMessage()    {}
+func (*CollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{16}
+}
+func (m *CollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedResponse.Merge(m, src)
+}
+func (m *CollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedResponse.DiscardUnknown(m)
 }
 
-func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CollectedResponse proto.InternalMessageInfo
+
+func (m *CollectedResponse) GetInstruments() []*CollectedInstrument {
+	if m != nil {
+		return m.Instruments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RemoveCollectedResponse struct {
 }
 
-func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Until) > 0 {
-		i -= len(m.Until)
-		copy(dAtA[i:], m.Until)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Until)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Since) > 0 {
-		i -= len(m.Since)
-		copy(dAtA[i:], m.Since)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Since)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *RemoveCollectedResponse) Reset()         { *m = RemoveCollectedResponse{} }
+func (m *RemoveCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveCollectedResponse) ProtoMessage()    {}
+func (*RemoveCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{17}
 }
-
-func (m *CompareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoveCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RemoveCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCollectedResponse.Merge(m, src)
+}
+func (m *RemoveCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCollectedResponse.DiscardUnknown(m)
 }
 
-func (m *CompareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RemoveCollectedResponse proto.InternalMessageInfo
+
+type PriceLevel struct {
+	Price    float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty" msgpack:"p"`
+	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty" msgpack:"q"`
 }
 
-func (m *CompareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Interval) > 0 {
-		i -= len(m.Interval)
-		copy(dAtA[i:], m.Interval)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Interval)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Instrument) > 0 {
-		i -= len(m.Instrument)
-		copy(dAtA[i:], m.Instrument)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
-		i--
-		dAtA[i] = 0x12
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{18}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Market) > 0 {
-		i -= len(m.Market)
-		copy(dAtA[i:], m.Market)
-		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+func (m *PriceLevel) GetPrice() float64 {
+	if m != nil {
+		return m.Price
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CompareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceLevel) GetQuantity() float64 {
+	if m != nil {
+		return m.Quantity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CompareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Depth struct {
+	Market     string        `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty" msgpack:"m"`
+	Instrument string        `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty" msgpack:"s"`
+	Bids       []*PriceLevel `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty" msgpack:"b"`
+	Asks       []*PriceLevel `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty" msgpack:"a"`
+	Timestamp  int64         `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty" msgpack:"t"`
+	Spread     float64       `protobuf:"fixed64,6,opt,name=spread,proto3" json:"spread,omitempty" msgpack:"-"`
+	Mid        float64       `protobuf:"fixed64,7,opt,name=mid,proto3" json:"mid,omitempty" msgpack:"-"`
+	Imbalance  float64       `protobuf:"fixed64,8,opt,name=imbalance,proto3" json:"imbalance,omitempty" msgpack:"-"`
 }
 
-func (m *CompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Difference != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Difference))))
-		i--
-		dAtA[i] = 0xd
+func (m *Depth) Reset()         { *m = Depth{} }
+func (m *Depth) String() string { return proto.CompactTextString(m) }
+func (*Depth) ProtoMessage()    {}
+func (*Depth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{19}
+}
+func (m *Depth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Depth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Depth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Depth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Depth.Merge(m, src)
+}
+func (m *Depth) XXX_Size() int {
+	return m.Size()
+}
+func (m *Depth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Depth.DiscardUnknown(m)
 }
 
-func encodeVarintTicks(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTicks(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Depth proto.InternalMessageInfo
+
+func (m *Depth) GetMarket() string {
+	if m != nil {
+		return m.Market
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Tick) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	if m.BestBid != 0 {
-		n += 5
-	}
-	if m.BestAsk != 0 {
-		n += 5
-	}
-	if m.Last != 0 {
-		n += 5
-	}
-	if m.Timestamp != 0 {
-		n += 5
-	}
-	if m.Volume24H != 0 {
-		n += 5
+
+func (m *Depth) GetInstrument() string {
+	if m != nil {
+		return m.Instrument
 	}
-	if m.Highest24H != 0 {
-		n += 5
+	return ""
+}
+
+func (m *Depth) GetBids() []*PriceLevel {
+	if m != nil {
+		return m.Bids
 	}
-	if m.Lowest24H != 0 {
-		n += 5
+	return nil
+}
+
+func (m *Depth) GetAsks() []*PriceLevel {
+	if m != nil {
+		return m.Asks
 	}
-	if m.ClosePriceChange24H != 0 {
-		n += 5
+	return nil
+}
+
+func (m *Depth) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.Open != 0 {
-		n += 5
+	return 0
+}
+
+func (m *Depth) GetSpread() float64 {
+	if m != nil {
+		return m.Spread
 	}
-	return n
+	return 0
 }
 
-func (m *OHLCV) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Depth) GetMid() float64 {
+	if m != nil {
+		return m.Mid
 	}
-	var l int
-	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	if m.Open != 0 {
-		n += 5
-	}
-	if m.High != 0 {
-		n += 5
-	}
-	if m.Low != 0 {
-		n += 5
-	}
-	if m.Close != 0 {
-		n += 5
-	}
-	if m.Volume != 0 {
-		n += 5
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTicks(uint64(m.Timestamp))
-	}
-	return n
+	return 0
 }
 
-func (m *Trade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.TradeID)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	if m.Direction != 0 {
-		n += 1 + sovTicks(uint64(m.Direction))
-	}
-	if m.Amount != 0 {
-		n += 5
-	}
-	if m.Units != 0 {
-		n += 5
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTicks(uint64(m.Timestamp))
+func (m *Depth) GetImbalance() float64 {
+	if m != nil {
+		return m.Imbalance
 	}
-	return n
+	return 0
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	if m.Depth != 0 {
-		n += 1 + sovTicks(uint64(m.Depth))
-	}
-	return n
+type DepthRequest struct {
+	Market     string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Instrument string `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Since      string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until      string `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	Levels     int32  `protobuf:"varint,5,opt,name=levels,proto3" json:"levels,omitempty"`
 }
 
-func (m *TicksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ticks) > 0 {
-		for _, e := range m.Ticks {
-			l = e.Size()
-			n += 1 + l + sovTicks(uint64(l))
+func (m *DepthRequest) Reset()         { *m = DepthRequest{} }
+func (m *DepthRequest) String() string { return proto.CompactTextString(m) }
+func (*DepthRequest) ProtoMessage()    {}
+func (*DepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{20}
+}
+func (m *DepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepthRequest.Merge(m, src)
+}
+func (m *DepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepthRequest.DiscardUnknown(m)
 }
 
-func (m *TradesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovTicks(uint64(l))
-		}
+var xxx_messageInfo_DepthRequest proto.InternalMessageInfo
+
+func (m *DepthRequest) GetMarket() string {
+	if m != nil {
+		return m.Market
 	}
-	return n
+	return ""
 }
 
-func (m *CandlesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
+func (m *DepthRequest) GetInstrument() string {
+	if m != nil {
+		return m.Instrument
 	}
-	l = len(m.Interval)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
+	return ""
+}
+
+func (m *DepthRequest) GetSince() string {
+	if m != nil {
+		return m.Since
 	}
-	if m.Depth != 0 {
-		n += 1 + sovTicks(uint64(m.Depth))
+	return ""
+}
+
+func (m *DepthRequest) GetUntil() string {
+	if m != nil {
+		return m.Until
 	}
-	return n
+	return ""
 }
 
-func (m *CandlesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DepthRequest) GetLevels() int32 {
+	if m != nil {
+		return m.Levels
 	}
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovTicks(uint64(l))
+	return 0
+}
+
+type DepthResponse struct {
+	Data []*Depth `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *DepthResponse) Reset()         { *m = DepthResponse{} }
+func (m *DepthResponse) String() string { return proto.CompactTextString(m) }
+func (*DepthResponse) ProtoMessage()    {}
+func (*DepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d46c2f7535a5e32, []int{21}
+}
+func (m *DepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepthResponse.Merge(m, src)
+}
+func (m *DepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepthResponse.DiscardUnknown(m)
 }
 
-func (m *RangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DepthResponse proto.InternalMessageInfo
+
+func (m *DepthResponse) GetData() []*Depth {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ataas.ticks.TradeDirection", TradeDirection_name, TradeDirection_value)
+	proto.RegisterType((*Tick)(nil), "ataas.ticks.Tick")
+	proto.RegisterType((*OHLCV)(nil), "ataas.ticks.OHLCV")
+	proto.RegisterType((*Trade)(nil), "ataas.ticks.Trade")
+	proto.RegisterType((*GetRequest)(nil), "ataas.ticks.GetRequest")
+	proto.RegisterType((*TicksResponse)(nil), "ataas.ticks.TicksResponse")
+	proto.RegisterType((*TradesResponse)(nil), "ataas.ticks.TradesResponse")
+	proto.RegisterType((*CandlesRequest)(nil), "ataas.ticks.CandlesRequest")
+	proto.RegisterType((*CandlesResponse)(nil), "ataas.ticks.CandlesResponse")
+	proto.RegisterType((*RangeRequest)(nil), "ataas.ticks.RangeRequest")
+	proto.RegisterType((*CompareRequest)(nil), "ataas.ticks.CompareRequest")
+	proto.RegisterType((*CompareResponse)(nil), "ataas.ticks.CompareResponse")
+	proto.RegisterType((*Symbol)(nil), "ataas.ticks.Symbol")
+	proto.RegisterType((*MarketsRequest)(nil), "ataas.ticks.MarketsRequest")
+	proto.RegisterType((*MarketsResponse)(nil), "ataas.ticks.MarketsResponse")
+	proto.RegisterType((*CollectedInstrument)(nil), "ataas.ticks.CollectedInstrument")
+	proto.RegisterType((*CollectedRequest)(nil), "ataas.ticks.CollectedRequest")
+	proto.RegisterType((*CollectedResponse)(nil), "ataas.ticks.CollectedResponse")
+	proto.RegisterType((*RemoveCollectedResponse)(nil), "ataas.ticks.RemoveCollectedResponse")
+	proto.RegisterType((*PriceLevel)(nil), "ataas.ticks.PriceLevel")
+	proto.RegisterType((*Depth)(nil), "ataas.ticks.Depth")
+	proto.RegisterType((*DepthRequest)(nil), "ataas.ticks.DepthRequest")
+	proto.RegisterType((*DepthResponse)(nil), "ataas.ticks.DepthResponse")
+}
+
+func init() { proto.RegisterFile("ticks.proto", fileDescriptor_1d46c2f7535a5e32) }
+
+var fileDescriptor_1d46c2f7535a5e32 = []byte{
+	// 1397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6f, 0x1c, 0x45,
+	0x13, 0xf6, 0xec, 0x7a, 0xbd, 0xde, 0x5a, 0x7f, 0xb6, 0xa3, 0x37, 0xe3, 0x8d, 0xdf, 0x7d, 0x9d,
+	0x7e, 0x03, 0x18, 0xa3, 0xec, 0x24, 0x06, 0x29, 0x21, 0x42, 0x08, 0x7f, 0x20, 0x92, 0xc8, 0x7c,
+	0xb5, 0x03, 0x28, 0x39, 0xa5, 0x77, 0xa6, 0x63, 0x8f, 0x76, 0xbe, 0x3c, 0xdd, 0x6b, 0x93, 0x44,
+	0xb9, 0x70, 0x41, 0xe2, 0x02, 0x12, 0x7f, 0x84, 0x7f, 0xc0, 0x09, 0xc4, 0x31, 0x12, 0x07, 0x38,
+	0x21, 0x94, 0xf0, 0x0b, 0xf8, 0x05, 0xa8, 0x7b, 0x7a, 0xbe, 0xbc, 0xe3, 0x38, 0x24, 0x84, 0xdb,
+	0x54, 0x3d, 0xd5, 0x55, 0xd5, 0x4f, 0x57, 0x57, 0xb5, 0x06, 0xda, 0xc2, 0xb5, 0x07, 0xbc, 0x17,
+	0xc5, 0xa1, 0x08, 0x51, 0x9b, 0x0a, 0x4a, 0x79, 0x4f, 0xa9, 0x3a, 0x4b, 0xbb, 0x61, 0xb8, 0xeb,
+	0x31, 0x8b, 0x46, 0xae, 0x45, 0x83, 0x20, 0x14, 0x54, 0xb8, 0x61, 0xa0, 0x4d, 0x3b, 0xb0, 0x1b,
+	0xee, 0x86, 0xc9, 0x37, 0xfe, 0xb1, 0x06, 0xe3, 0x37, 0x5c, 0x7b, 0x80, 0xfe, 0x03, 0x13, 0x3e,
+	0x8d, 0x07, 0x4c, 0x98, 0xc6, 0xb2, 0xb1, 0xd2, 0x22, 0x5a, 0x42, 0x5d, 0x00, 0x37, 0xe0, 0x22,
+	0x1e, 0xfa, 0x2c, 0x10, 0x66, 0x4d, 0x61, 0x05, 0x0d, 0x32, 0xa1, 0xd9, 0x67, 0x5c, 0x6c, 0xb8,
+	0x8e, 0x59, 0x5f, 0x36, 0x56, 0x6a, 0x24, 0x15, 0x53, 0x64, 0x9d, 0x0f, 0xcc, 0xf1, 0x1c, 0x59,
+	0xe7, 0x03, 0x84, 0x60, 0xdc, 0xa3, 0x5c, 0x98, 0x0d, 0xa5, 0x56, 0xdf, 0x68, 0x09, 0x5a, 0xc2,
+	0xf5, 0x19, 0x17, 0xd4, 0x8f, 0xcc, 0x09, 0x05, 0xe4, 0x0a, 0x89, 0x1e, 0x84, 0xde, 0xd0, 0x67,
+	0x6b, 0x6f, 0xec, 0x99, 0xcd, 0x04, 0xcd, 0x14, 0x32, 0xc7, 0x3d, 0x77, 0x77, 0x8f, 0x71, 0x21,
+	0xe1, 0x49, 0x05, 0x17, 0x34, 0x72, 0xb5, 0x17, 0x1e, 0x6a, 0xb8, 0x95, 0xac, 0xce, 0x14, 0xe8,
+	0x02, 0x2c, 0xd8, 0x5e, 0xc8, 0xd9, 0x47, 0xb1, 0x6b, 0xb3, 0xcd, 0x3d, 0x1a, 0xec, 0xaa, 0x28,
+	0xa0, 0xec, 0xaa, 0x20, 0x99, 0x7f, 0x18, 0xb1, 0xc0, 0x6c, 0x27, 0xf9, 0xcb, 0x6f, 0xfc, 0x83,
+	0x01, 0x8d, 0x0f, 0xaf, 0x6e, 0x6f, 0x7e, 0xfa, 0xcc, 0x4c, 0xa6, 0x5e, 0xeb, 0xb9, 0x57, 0xa9,
+	0x93, 0xfb, 0xd0, 0x04, 0xaa, 0x6f, 0x34, 0x07, 0x75, 0x2f, 0x3c, 0xd4, 0xe4, 0xc9, 0x4f, 0x74,
+	0x0a, 0x1a, 0x2a, 0x4d, 0xcd, 0x5b, 0x22, 0xc8, 0x3c, 0x12, 0x8a, 0x34, 0x61, 0x5a, 0x2a, 0x33,
+	0x2d, 0xc9, 0xaa, 0x17, 0x98, 0xc6, 0xbf, 0xd4, 0xa0, 0x71, 0x23, 0xa6, 0x0e, 0x43, 0xaf, 0x94,
+	0xf7, 0xb1, 0x31, 0xfb, 0xe7, 0x6f, 0xff, 0x6b, 0xfb, 0x7c, 0x37, 0xa2, 0xf6, 0xe0, 0x0a, 0xf6,
+	0x71, 0xb6, 0x31, 0x6b, 0x74, 0x63, 0x47, 0x8c, 0x39, 0x2e, 0xed, 0xf4, 0x55, 0x68, 0x0a, 0x19,
+	0xe2, 0xda, 0x96, 0x59, 0xaf, 0xb0, 0x76, 0x31, 0x49, 0x71, 0x74, 0x1d, 0x5a, 0x8e, 0x1b, 0x33,
+	0x5b, 0xd6, 0xaf, 0x62, 0x61, 0x66, 0xed, 0x4c, 0xaf, 0x50, 0xea, 0x3d, 0x95, 0xeb, 0x56, 0x6a,
+	0x72, 0xc4, 0x93, 0x83, 0x49, 0xbe, 0x5c, 0x6e, 0x88, 0xfa, 0xe1, 0x30, 0xd0, 0x85, 0x77, 0xc4,
+	0x36, 0xc2, 0x44, 0xc3, 0xe8, 0x25, 0x68, 0x0c, 0x03, 0x57, 0x70, 0x73, 0xa2, 0xc2, 0x6e, 0x1f,
+	0x93, 0x04, 0x45, 0xe7, 0x8b, 0x44, 0x4a, 0x8e, 0xeb, 0x47, 0x4c, 0x05, 0x2e, 0x32, 0x7b, 0x0b,
+	0xe0, 0x3d, 0x26, 0x08, 0xdb, 0x1f, 0x32, 0x2e, 0x9e, 0xb9, 0x4a, 0x4e, 0x41, 0xc3, 0x61, 0x91,
+	0x48, 0x4a, 0xa2, 0x41, 0x12, 0x01, 0x5f, 0x86, 0x69, 0x79, 0x8b, 0x39, 0x61, 0x3c, 0x0a, 0x03,
+	0x2e, 0x0f, 0xaf, 0xa1, 0xf8, 0x31, 0x8d, 0xe5, 0xfa, 0x4a, 0x7b, 0x6d, 0xbe, 0xcc, 0x99, 0x6b,
+	0x0f, 0x48, 0x82, 0xe3, 0xcb, 0x30, 0xa3, 0x28, 0xcc, 0x97, 0xbe, 0x0c, 0xe3, 0x0e, 0x15, 0x54,
+	0xaf, 0x44, 0xa3, 0x6c, 0x13, 0x85, 0xe3, 0x7b, 0x30, 0xb3, 0x49, 0x03, 0xc7, 0x63, 0xfc, 0x79,
+	0xf7, 0xd4, 0x81, 0x49, 0x37, 0x10, 0x2c, 0x3e, 0xa0, 0x5e, 0x52, 0x10, 0x24, 0x93, 0x8f, 0xd9,
+	0xef, 0x9b, 0x30, 0x9b, 0xc5, 0x7e, 0x8a, 0xb4, 0xd5, 0xc5, 0xd4, 0x69, 0xc7, 0x30, 0x45, 0xe4,
+	0x45, 0xfe, 0x07, 0x0e, 0x82, 0xbb, 0x81, 0xcd, 0x74, 0xc6, 0x89, 0x20, 0xb5, 0xc3, 0x40, 0xb8,
+	0x9e, 0x4a, 0xb7, 0x45, 0x12, 0x01, 0x3b, 0x30, 0xb3, 0x19, 0xfa, 0x11, 0x8d, 0xd9, 0x0b, 0xa4,
+	0x0a, 0x5f, 0x84, 0xd9, 0x2c, 0x8a, 0x26, 0xa5, 0x0b, 0xe0, 0xb8, 0x77, 0xee, 0xb0, 0x98, 0xc9,
+	0x4c, 0x8d, 0xa4, 0x33, 0xe6, 0x1a, 0xfc, 0xb0, 0x06, 0x13, 0x3b, 0x77, 0xfd, 0x7e, 0xe8, 0x3d,
+	0x4f, 0xdb, 0xea, 0x53, 0x9e, 0xd2, 0xa0, 0xbe, 0x25, 0x0b, 0xfb, 0xc3, 0x50, 0xb0, 0x94, 0x05,
+	0x25, 0xc8, 0x08, 0x5c, 0x50, 0x31, 0xe4, 0xea, 0xfe, 0xb5, 0x88, 0x96, 0xd0, 0x39, 0x98, 0x8e,
+	0x64, 0x83, 0xdd, 0x62, 0xb6, 0xeb, 0x53, 0x2f, 0xb9, 0x76, 0x0d, 0x52, 0x56, 0xa2, 0x55, 0x98,
+	0xdb, 0x1f, 0xd2, 0x40, 0xb8, 0xe2, 0x6e, 0x66, 0xd8, 0x54, 0x86, 0x23, 0x7a, 0xc9, 0x92, 0x3c,
+	0xf8, 0x1d, 0xf7, 0x1e, 0x53, 0x1d, 0xce, 0x20, 0x99, 0x2c, 0x31, 0x2e, 0x58, 0xa4, 0xb0, 0x56,
+	0x82, 0xa5, 0x32, 0x5a, 0x86, 0xb6, 0xef, 0x06, 0x1f, 0x6b, 0x77, 0x6a, 0x04, 0x18, 0xa4, 0xa8,
+	0xd2, 0x16, 0x1f, 0x84, 0xb2, 0xa1, 0x50, 0xcf, 0x6c, 0x67, 0x16, 0xa9, 0x0a, 0xbf, 0x0d, 0x33,
+	0xef, 0x2b, 0xe6, 0x4e, 0xbc, 0x16, 0x19, 0x4b, 0xb5, 0x02, 0x4b, 0xf8, 0x1d, 0x98, 0xcd, 0xd6,
+	0xeb, 0x53, 0x3c, 0x0f, 0x4d, 0xae, 0x0e, 0x29, 0xbd, 0xce, 0x0b, 0xa5, 0xea, 0x4e, 0x0e, 0x90,
+	0xa4, 0x36, 0xf8, 0x7b, 0x03, 0x16, 0x36, 0x43, 0xcf, 0x63, 0xb6, 0x60, 0xce, 0xb5, 0xfc, 0xa4,
+	0x9e, 0xf5, 0x84, 0x97, 0xa0, 0x65, 0xc7, 0x8c, 0x0a, 0xe6, 0xac, 0x0b, 0x7d, 0xcc, 0xb9, 0x02,
+	0x61, 0x98, 0x8a, 0xe9, 0x21, 0x61, 0x82, 0x05, 0x59, 0x93, 0x6e, 0x91, 0x92, 0x0e, 0xf5, 0x00,
+	0xd9, 0xb2, 0x32, 0x65, 0x42, 0xb9, 0x65, 0x52, 0x05, 0x15, 0x08, 0xbe, 0x0e, 0x73, 0xd9, 0x06,
+	0x9e, 0xf3, 0xc6, 0xe0, 0xcf, 0x60, 0xbe, 0xe0, 0x4b, 0x33, 0xba, 0x01, 0xed, 0xdc, 0x24, 0x65,
+	0x75, 0xb9, 0xc4, 0x6a, 0x05, 0x83, 0xa4, 0xb8, 0x08, 0x2f, 0xc2, 0x69, 0xc2, 0xfc, 0xf0, 0x80,
+	0x8d, 0xb8, 0xc7, 0xb7, 0x01, 0xd4, 0x93, 0x61, 0x9b, 0x1d, 0x30, 0x4f, 0x8e, 0x13, 0x55, 0xca,
+	0x2a, 0x71, 0x63, 0x74, 0xec, 0x24, 0x28, 0x7a, 0x0d, 0x26, 0xd3, 0x42, 0x36, 0x6b, 0x15, 0x96,
+	0xfb, 0x98, 0x64, 0x06, 0xf8, 0xcb, 0x3a, 0x34, 0xb6, 0x64, 0x2b, 0x7c, 0x81, 0x63, 0xfa, 0x2d,
+	0x18, 0xef, 0xbb, 0x0e, 0x37, 0xeb, 0x8a, 0x9d, 0xd3, 0x25, 0x76, 0xf2, 0xed, 0x1d, 0xf1, 0xd1,
+	0xc7, 0x44, 0xad, 0x92, 0xab, 0x29, 0x1f, 0x70, 0x73, 0xfc, 0xef, 0xac, 0xa6, 0x98, 0xa8, 0x55,
+	0xe5, 0xd9, 0xda, 0x38, 0x69, 0xb6, 0x4a, 0x12, 0x78, 0x14, 0x33, 0xea, 0x98, 0x13, 0x15, 0xcc,
+	0x9d, 0xc7, 0x44, 0xc3, 0xe8, 0x2c, 0xd4, 0x7d, 0xd7, 0x31, 0x9b, 0xd5, 0x56, 0x12, 0x93, 0xa1,
+	0x5d, 0xbf, 0x4f, 0x3d, 0x1a, 0xd8, 0xba, 0x7b, 0x8c, 0x1a, 0xe6, 0x16, 0xf8, 0x2b, 0x03, 0xa6,
+	0xd4, 0x49, 0xfc, 0x8b, 0x03, 0x45, 0xc6, 0xf0, 0x24, 0x7d, 0x49, 0x2b, 0x6d, 0x10, 0x2d, 0xe1,
+	0x4b, 0x30, 0xad, 0x73, 0x79, 0x8a, 0xa9, 0x98, 0x58, 0x2a, 0x7c, 0xf5, 0xff, 0x30, 0x53, 0x7e,
+	0x49, 0xa1, 0x26, 0xd4, 0x37, 0x3e, 0xb9, 0x39, 0x37, 0x86, 0x26, 0x61, 0x7c, 0xe7, 0xdd, 0xed,
+	0xed, 0x39, 0x63, 0xed, 0xbb, 0x26, 0xcc, 0x5c, 0x75, 0xb9, 0x08, 0xe3, 0xbb, 0x3b, 0x2c, 0x3e,
+	0x90, 0x45, 0x7b, 0x0b, 0x26, 0xd4, 0x3a, 0x8e, 0xca, 0x27, 0x9c, 0xbf, 0x74, 0x3a, 0x15, 0xef,
+	0xb5, 0xac, 0xb5, 0xe1, 0xce, 0x17, 0x3f, 0xff, 0xf1, 0x6d, 0xed, 0x14, 0x42, 0xd6, 0xc1, 0x45,
+	0x6b, 0x2f, 0xf1, 0x6d, 0x89, 0xc4, 0xa3, 0x0b, 0x6d, 0x6d, 0x2d, 0xe7, 0x35, 0x5a, 0x2c, 0xf9,
+	0x29, 0xce, 0xf0, 0x27, 0x87, 0xc0, 0x2a, 0xc4, 0x12, 0xea, 0x8c, 0x86, 0xb0, 0xee, 0x2b, 0x92,
+	0x1f, 0xa0, 0x2d, 0x98, 0x2f, 0x84, 0xda, 0x11, 0x31, 0xa3, 0xfe, 0x93, 0x02, 0x56, 0xbc, 0x8a,
+	0x2e, 0x18, 0xe8, 0x36, 0x34, 0xf5, 0xab, 0x04, 0x95, 0x33, 0x2a, 0xbf, 0x93, 0x3a, 0x4b, 0xd5,
+	0xe0, 0x93, 0x28, 0xb1, 0x95, 0x11, 0xba, 0x99, 0xde, 0xfa, 0xc5, 0x8a, 0x93, 0xd4, 0xde, 0x3b,
+	0x55, 0x90, 0xf6, 0xbd, 0xa8, 0x7c, 0x2f, 0xa0, 0xf9, 0xa2, 0x6f, 0xf5, 0xa4, 0x42, 0x37, 0xa1,
+	0xa9, 0xe7, 0xce, 0x91, 0xe4, 0xcb, 0xd3, 0xac, 0xb3, 0x54, 0x0d, 0xea, 0x00, 0x0b, 0x2a, 0xc0,
+	0x34, 0x6a, 0xcb, 0x00, 0xbe, 0xf6, 0xe7, 0x43, 0x2b, 0xeb, 0x91, 0xe8, 0xbf, 0xd5, 0x5d, 0x36,
+	0x75, 0xdf, 0x3d, 0x0e, 0xd6, 0x01, 0xce, 0xaa, 0x00, 0x67, 0xd0, 0xa2, 0x0c, 0x60, 0x27, 0x70,
+	0x18, 0x5b, 0x85, 0xc6, 0x8c, 0x3e, 0x87, 0xa9, 0x75, 0xc7, 0xc9, 0x23, 0x9e, 0xd8, 0xd7, 0x3b,
+	0x27, 0x5a, 0xe0, 0x73, 0x2a, 0x6c, 0x17, 0x1f, 0x1f, 0xf6, 0x8a, 0xb1, 0x8a, 0xbe, 0x36, 0x60,
+	0xf6, 0xc8, 0x4c, 0x38, 0x69, 0xbf, 0xe7, 0x4a, 0xf0, 0x71, 0x03, 0xe5, 0x92, 0x0a, 0x7f, 0x71,
+	0xd5, 0x3a, 0x36, 0xbc, 0x75, 0x3f, 0x61, 0xfb, 0x81, 0x75, 0x3f, 0xd7, 0x3e, 0xd8, 0xd8, 0xf8,
+	0xe9, 0x51, 0xd7, 0x78, 0xf8, 0xa8, 0x6b, 0xfc, 0xfe, 0xa8, 0x6b, 0x7c, 0xf3, 0xb8, 0x3b, 0xf6,
+	0xf0, 0x71, 0x77, 0xec, 0xd7, 0xc7, 0xdd, 0xb1, 0x5b, 0x2b, 0x91, 0xdf, 0x13, 0xf6, 0x9d, 0xc3,
+	0x9e, 0x1d, 0xfa, 0x3d, 0x3a, 0xb4, 0x78, 0x38, 0x8c, 0x6d, 0x66, 0xa9, 0x6c, 0xd4, 0x8f, 0x83,
+	0xa8, 0x6f, 0xa9, 0xa4, 0xfa, 0x13, 0xea, 0x57, 0xc1, 0xeb, 0x7f, 0x0d, 0x00, 0x87, 0xfb, 0xf4,
+	0x73, 0x70, 0x10, 0x00, 0x00,
+}
+
+func (m *Tick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
+	if m.Open != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Open))))
+		i--
+		dAtA[i] = 0x5d
 	}
-	l = len(m.Since)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
+	if m.ClosePriceChange24H != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ClosePriceChange24H))))
+		i--
+		dAtA[i] = 0x55
 	}
-	l = len(m.Until)
-	if l > 0 {
+	if m.Lowest24H != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lowest24H))))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if m.Highest24H != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Highest24H))))
+		i--
+		dAtA[i] = 0x45
+	}
+	if m.Volume24H != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Volume24H))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.Timestamp != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Timestamp))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.Last != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Last))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.BestAsk != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BestAsk))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.BestBid != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BestBid))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OHLCV) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OHLCV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OHLCV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Volume != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Volume))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.Close != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Close))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.Low != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Low))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.High != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.High))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Open != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Open))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Units != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Units))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.Amount != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Amount))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.Direction != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TradeID) > 0 {
+		i -= len(m.TradeID)
+		copy(dAtA[i:], m.TradeID)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.TradeID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Since)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Difference != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Difference))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Symbol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Symbol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Symbol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinNotional != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinNotional))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.MinQuantity != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinQuantity))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.StepSize != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.StepSize))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.TickSize != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TickSize))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.QuantityDecimals != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.QuantityDecimals))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PriceDecimals != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.PriceDecimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Symbols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedInstrument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedInstrument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedInstrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CompactedRetention) > 0 {
+		i -= len(m.CompactedRetention)
+		copy(dAtA[i:], m.CompactedRetention)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.CompactedRetention)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RawRetention) > 0 {
+		i -= len(m.RawRetention)
+		copy(dAtA[i:], m.RawRetention)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.RawRetention)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Instruments) > 0 {
+		for iNdEx := len(m.Instruments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Instruments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantity != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Quantity))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Depth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Depth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Depth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Imbalance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Imbalance))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.Mid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mid))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.Spread != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Spread))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Levels != 0 {
+		i = encodeVarintTicks(dAtA, i, uint64(m.Levels))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Since)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Instrument) > 0 {
+		i -= len(m.Instrument)
+		copy(dAtA[i:], m.Instrument)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Instrument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		i -= len(m.Market)
+		copy(dAtA[i:], m.Market)
+		i = encodeVarintTicks(dAtA, i, uint64(len(m.Market)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTicks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTicks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if m.BestBid != 0 {
+		n += 5
+	}
+	if m.BestAsk != 0 {
+		n += 5
+	}
+	if m.Last != 0 {
+		n += 5
+	}
+	if m.Timestamp != 0 {
+		n += 5
+	}
+	if m.Volume24H != 0 {
+		n += 5
+	}
+	if m.Highest24H != 0 {
+		n += 5
+	}
+	if m.Lowest24H != 0 {
+		n += 5
+	}
+	if m.ClosePriceChange24H != 0 {
+		n += 5
+	}
+	if m.Open != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *OHLCV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if m.Open != 0 {
+		n += 5
+	}
+	if m.High != 0 {
+		n += 5
+	}
+	if m.Low != 0 {
+		n += 5
+	}
+	if m.Close != 0 {
+		n += 5
+	}
+	if m.Volume != 0 {
+		n += 5
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTicks(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.TradeID)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTicks(uint64(m.Direction))
+	}
+	if m.Amount != 0 {
+		n += 5
+	}
+	if m.Units != 0 {
+		n += 5
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTicks(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovTicks(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *TicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovTicks(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *CandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Difference != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Symbol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if m.PriceDecimals != 0 {
+		n += 1 + sovTicks(uint64(m.PriceDecimals))
+	}
+	if m.QuantityDecimals != 0 {
+		n += 1 + sovTicks(uint64(m.QuantityDecimals))
+	}
+	if m.TickSize != 0 {
+		n += 9
+	}
+	if m.StepSize != 0 {
+		n += 9
+	}
+	if m.MinQuantity != 0 {
+		n += 9
+	}
+	if m.MinNotional != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *MarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Symbols) > 0 {
+		for _, e := range m.Symbols {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CollectedInstrument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.RawRetention)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.CompactedRetention)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Instruments) > 0 {
+		for _, e := range m.Instruments {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != 0 {
+		n += 9
+	}
+	if m.Quantity != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Depth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTicks(uint64(m.Timestamp))
+	}
+	if m.Spread != 0 {
+		n += 9
+	}
+	if m.Mid != 0 {
+		n += 9
+	}
+	if m.Imbalance != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *DepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Market)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Instrument)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovTicks(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
 		n += 1 + l + sovTicks(uint64(l))
 	}
-	return n
-}
+	if m.Levels != 0 {
+		n += 1 + sovTicks(uint64(m.Levels))
+	}
+	return n
+}
+
+func (m *DepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovTicks(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTicks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTicks(x uint64) (n int) {
+	return sovTicks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.BestBid = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.BestAsk = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Last = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Timestamp = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume24H", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Volume24H = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Highest24H", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Highest24H = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lowest24H", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lowest24H = float32(math.Float32frombits(v))
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosePriceChange24H", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.ClosePriceChange24H = float32(math.Float32frombits(v))
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Open = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OHLCV) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OHLCV: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OHLCV: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Open = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.High = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Low = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Close = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Volume = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TradeDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Amount = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Units = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TicksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TicksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, &Tick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &Trade{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandlesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *CompareRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Market)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Instrument)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
-	}
-	l = len(m.Interval)
-	if l > 0 {
-		n += 1 + l + sovTicks(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *CompareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Difference != 0 {
-		n += 5
+func (m *CandlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &OHLCV{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTicks(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTicks(x uint64) (n int) {
-	return sovTicks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Tick) Unmarshal(dAtA []byte) error {
+func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1726,10 +4440,10 @@ func (m *Tick) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1797,104 +4511,69 @@ func (m *Tick) Unmarshal(dAtA []byte) error {
 			m.Instrument = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.BestBid = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.BestAsk = float32(math.Float32frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Last = float32(math.Float32frombits(v))
-		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Timestamp = float32(math.Float32frombits(v))
-		case 7:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume24H", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Volume24H = float32(math.Float32frombits(v))
-		case 8:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Highest24H", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Highest24H = float32(math.Float32frombits(v))
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lowest24H", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Since = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Lowest24H = float32(math.Float32frombits(v))
-		case 10:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosePriceChange24H", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.ClosePriceChange24H = float32(math.Float32frombits(v))
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Open = float32(math.Float32frombits(v))
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -1919,7 +4598,7 @@ func (m *Tick) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OHLCV) Unmarshal(dAtA []byte) error {
+func (m *CompareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1942,10 +4621,10 @@ func (m *OHLCV) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OHLCV: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OHLCV: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2013,52 +4692,93 @@ func (m *OHLCV) Unmarshal(dAtA []byte) error {
 			m.Instrument = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Open = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.High = float32(math.Float32frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if skippy < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Low = float32(math.Float32frombits(v))
-		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Close = float32(math.Float32frombits(v))
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Difference", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -2066,26 +4786,7 @@ func (m *OHLCV) Unmarshal(dAtA []byte) error {
 			}
 			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
 			iNdEx += 4
-			m.Volume = float32(math.Float32frombits(v))
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTicks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.Difference = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -2110,7 +4811,7 @@ func (m *OHLCV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trade) Unmarshal(dAtA []byte) error {
+func (m *Symbol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2133,10 +4834,10 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+			return fmt.Errorf("proto: Symbol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Symbol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2205,7 +4906,7 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2233,13 +4934,13 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradeID = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			m.Direction = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicks
@@ -2249,38 +4950,80 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= TradeDirection(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Amount = float32(math.Float32frombits(v))
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecimals", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.PriceDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceDecimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Units = float32(math.Float32frombits(v))
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityDecimals", wireType)
 			}
-			m.Timestamp = 0
+			m.QuantityDecimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicks
@@ -2290,11 +5033,55 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.QuantityDecimals |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TickSize = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.StepSize = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinQuantity = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNotional", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinNotional = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -2319,7 +5106,7 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2342,10 +5129,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2382,7 +5169,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2410,27 +5197,8 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instrument = string(dAtA[iNdEx:postIndex])
+			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			m.Depth = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTicks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Depth |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -2455,7 +5223,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TicksResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2478,15 +5246,15 @@ func (m *TicksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TicksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2513,8 +5281,8 @@ func (m *TicksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticks = append(m.Ticks, &Tick{})
-			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Symbols = append(m.Symbols, &Symbol{})
+			if err := m.Symbols[len(m.Symbols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2542,7 +5310,7 @@ func (m *TicksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradesResponse) Unmarshal(dAtA []byte) error {
+func (m *CollectedInstrument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2565,17 +5333,17 @@ func (m *TradesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectedInstrument: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectedInstrument: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicks
@@ -2585,25 +5353,151 @@ func (m *TradesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTicks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &Trade{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Market = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instrument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instrument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRetention", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawRetention = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactedRetention", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.CompactedRetention = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2629,7 +5523,7 @@ func (m *TradesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CandlesRequest) Unmarshal(dAtA []byte) error {
+func (m *CollectedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2652,10 +5546,10 @@ func (m *CandlesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CandlesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2722,43 +5616,64 @@ func (m *CandlesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Instrument = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTicks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTicks
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTicks
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instruments", wireType)
 			}
-			m.Depth = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicks
@@ -2768,11 +5683,26 @@ func (m *CandlesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Depth |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instruments = append(m.Instruments, &CollectedInstrument{})
+			if err := m.Instruments[len(m.Instruments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -2797,7 +5727,7 @@ func (m *CandlesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CandlesResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveCollectedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2820,46 +5750,87 @@ func (m *CandlesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CandlesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveCollectedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTicks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTicks
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTicks
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &OHLCV{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicks
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Quantity = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -2884,7 +5855,7 @@ func (m *CandlesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RangeRequest) Unmarshal(dAtA []byte) error {
+func (m *Depth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2907,10 +5878,10 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Depth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Depth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2979,9 +5950,9 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicks
@@ -2991,29 +5962,31 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTicks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Since = string(dAtA[iNdEx:postIndex])
+			m.Bids = append(m.Bids, &PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicks
@@ -3023,24 +5996,78 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTicks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Until = string(dAtA[iNdEx:postIndex])
+			m.Asks = append(m.Asks, &PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Spread = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mid", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mid = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imbalance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Imbalance = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -3065,7 +6092,7 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompareRequest) Unmarshal(dAtA []byte) error {
+func (m *DepthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3088,10 +6115,10 @@ func (m *CompareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3160,7 +6187,7 @@ func (m *CompareRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3188,8 +6215,59 @@ func (m *CompareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = string(dAtA[iNdEx:postIndex])
+			m.Since = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			m.Levels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Levels |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -3214,7 +6292,7 @@ func (m *CompareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompareResponse) Unmarshal(dAtA []byte) error {
+func (m *DepthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3237,23 +6315,46 @@ func (m *CompareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Difference", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicks
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Difference = float32(math.Float32frombits(v))
+			m.Data = append(m.Data, &Depth{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicks(dAtA[iNdEx:])
@@ -22,6 +22,11 @@ type HistoryServiceClient interface {
 	TradesRange(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*TradesResponse, error)
 	TradesRangeStream(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (HistoryService_TradesRangeStreamClient, error)
 	Candles(ctx context.Context, in *CandlesRequest, opts ...grpc.CallOption) (*CandlesResponse, error)
+	Depth(ctx context.Context, in *DepthRequest, opts ...grpc.CallOption) (*DepthResponse, error)
+	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
+	Collected(ctx context.Context, in *CollectedRequest, opts ...grpc.CallOption) (*CollectedResponse, error)
+	AddCollected(ctx context.Context, in *CollectedInstrument, opts ...grpc.CallOption) (*CollectedInstrument, error)
+	RemoveCollected(ctx context.Context, in *CollectedRequest, opts ...grpc.CallOption) (*RemoveCollectedResponse, error)
 }
 
 type historyServiceClient struct {
@@ -91,6 +96,51 @@ func (c *historyServiceClient) Candles(ctx context.Context, in *CandlesRequest,
 	return out, nil
 }
 
+func (c *historyServiceClient) Depth(ctx context.Context, in *DepthRequest, opts ...grpc.CallOption) (*DepthResponse, error) {
+	out := new(DepthResponse)
+	err := c.cc.Invoke(ctx, "/ataas.ticks.HistoryService/Depth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
+	out := new(MarketsResponse)
+	err := c.cc.Invoke(ctx, "/ataas.ticks.HistoryService/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) Collected(ctx context.Context, in *CollectedRequest, opts ...grpc.CallOption) (*CollectedResponse, error) {
+	out := new(CollectedResponse)
+	err := c.cc.Invoke(ctx, "/ataas.ticks.HistoryService/Collected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) AddCollected(ctx context.Context, in *CollectedInstrument, opts ...grpc.CallOption) (*CollectedInstrument, error) {
+	out := new(CollectedInstrument)
+	err := c.cc.Invoke(ctx, "/ataas.ticks.HistoryService/AddCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) RemoveCollected(ctx context.Context, in *CollectedRequest, opts ...grpc.CallOption) (*RemoveCollectedResponse, error) {
+	out := new(RemoveCollectedResponse)
+	err := c.cc.Invoke(ctx, "/ataas.ticks.HistoryService/RemoveCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -99,6 +149,11 @@ type HistoryServiceServer interface {
 	TradesRange(context.Context, *RangeRequest) (*TradesResponse, error)
 	TradesRangeStream(*RangeRequest, HistoryService_TradesRangeStreamServer) error
 	Candles(context.Context, *CandlesRequest) (*CandlesResponse, error)
+	Depth(context.Context, *DepthRequest) (*DepthResponse, error)
+	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
+	Collected(context.Context, *CollectedRequest) (*CollectedResponse, error)
+	AddCollected(context.Context, *CollectedInstrument) (*CollectedInstrument, error)
+	RemoveCollected(context.Context, *CollectedRequest) (*RemoveCollectedResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -118,6 +173,21 @@ func (UnimplementedHistoryServiceServer) TradesRangeStream(*RangeRequest, Histor
 func (UnimplementedHistoryServiceServer) Candles(context.Context, *CandlesRequest) (*CandlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
 }
+func (UnimplementedHistoryServiceServer) Depth(context.Context, *DepthRequest) (*DepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Depth not implemented")
+}
+func (UnimplementedHistoryServiceServer) Markets(context.Context, *MarketsRequest) (*MarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (UnimplementedHistoryServiceServer) Collected(context.Context, *CollectedRequest) (*CollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collected not implemented")
+}
+func (UnimplementedHistoryServiceServer) AddCollected(context.Context, *CollectedInstrument) (*CollectedInstrument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollected not implemented")
+}
+func (UnimplementedHistoryServiceServer) RemoveCollected(context.Context, *CollectedRequest) (*RemoveCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollected not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +276,96 @@ func _HistoryService_Candles_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_Depth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).Depth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.ticks.HistoryService/Depth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).Depth(ctx, req.(*DepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.ticks.HistoryService/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).Markets(ctx, req.(*MarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_Collected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).Collected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.ticks.HistoryService/Collected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).Collected(ctx, req.(*CollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_AddCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectedInstrument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).AddCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.ticks.HistoryService/AddCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).AddCollected(ctx, req.(*CollectedInstrument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_RemoveCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RemoveCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.ticks.HistoryService/RemoveCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RemoveCollected(ctx, req.(*CollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +385,26 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Candles",
 			Handler:    _HistoryService_Candles_Handler,
 		},
+		{
+			MethodName: "Depth",
+			Handler:    _HistoryService_Depth_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _HistoryService_Markets_Handler,
+		},
+		{
+			MethodName: "Collected",
+			Handler:    _HistoryService_Collected_Handler,
+		},
+		{
+			MethodName: "AddCollected",
+			Handler:    _HistoryService_AddCollected_Handler,
+		},
+		{
+			MethodName: "RemoveCollected",
+			Handler:    _HistoryService_RemoveCollected_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -24,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type OrgRole int32
+
+const (
+	OrgRole_VIEWER OrgRole = 0
+	OrgRole_TRADER OrgRole = 1
+	OrgRole_OWNER  OrgRole = 2
+)
+
+var OrgRole_name = map[int32]string{
+	0: "VIEWER",
+	1: "TRADER",
+	2: "OWNER",
+}
+
+var OrgRole_value = map[string]int32{
+	"VIEWER": 0,
+	"TRADER": 1,
+	"OWNER":  2,
+}
+
+func (x OrgRole) String() string {
+	return proto.EnumName(OrgRole_name, int32(x))
+}
+
+func (OrgRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{0}
+}
+
 type User_Status int32
 
 const (
@@ -434,8 +462,9 @@ func (m *PasswordUpdateRequest) GetCurrentPassword() string {
 }
 
 type UpdateRequest struct {
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	User *User  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User      *User  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	SetStatus bool   `protobuf:"varint,3,opt,name=setStatus,proto3" json:"setStatus,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
@@ -485,6 +514,13 @@ func (m *UpdateRequest) GetUser() *User {
 	return nil
 }
 
+func (m *UpdateRequest) GetSetStatus() bool {
+	if m != nil {
+		return m.SetStatus
+	}
+	return false
+}
+
 type AuthRequest struct {
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
@@ -542,6 +578,7 @@ type MFAFIDO struct {
 	Pk              []byte             `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
 	AttestationType string             `protobuf:"bytes,3,opt,name=attestationType,proto3" json:"attestationType,omitempty"`
 	Authenticator   *FIDOAuthenticator `protobuf:"bytes,4,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+	Name            string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *MFAFIDO) Reset()         { *m = MFAFIDO{} }
@@ -605,6 +642,57 @@ func (m *MFAFIDO) GetAuthenticator() *FIDOAuthenticator {
 	return nil
 }
 
+func (m *MFAFIDO) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type MFAFIDOKeys struct {
+	Keys []*MFAFIDO `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *MFAFIDOKeys) Reset()         { *m = MFAFIDOKeys{} }
+func (m *MFAFIDOKeys) String() string { return proto.CompactTextString(m) }
+func (*MFAFIDOKeys) ProtoMessage()    {}
+func (*MFAFIDOKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{7}
+}
+func (m *MFAFIDOKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MFAFIDOKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MFAFIDOKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MFAFIDOKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MFAFIDOKeys.Merge(m, src)
+}
+func (m *MFAFIDOKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MFAFIDOKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MFAFIDOKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MFAFIDOKeys proto.InternalMessageInfo
+
+func (m *MFAFIDOKeys) GetKeys() []*MFAFIDO {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type FIDOAuthenticator struct {
 	AAGUID       []byte `protobuf:"bytes,1,opt,name=AAGUID,proto3" json:"AAGUID,omitempty"`
 	SignCount    uint32 `protobuf:"varint,2,opt,name=SignCount,proto3" json:"SignCount,omitempty"`
@@ -615,7 +703,7 @@ func (m *FIDOAuthenticator) Reset()         { *m = FIDOAuthenticator{} }
 func (m *FIDOAuthenticator) String() string { return proto.CompactTextString(m) }
 func (*FIDOAuthenticator) ProtoMessage()    {}
 func (*FIDOAuthenticator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{7}
+	return fileDescriptor_030765f334c86cea, []int{8}
 }
 func (m *FIDOAuthenticator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +761,7 @@ func (m *MFASMS) Reset()         { *m = MFASMS{} }
 func (m *MFASMS) String() string { return proto.CompactTextString(m) }
 func (*MFASMS) ProtoMessage()    {}
 func (*MFASMS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{8}
+	return fileDescriptor_030765f334c86cea, []int{9}
 }
 func (m *MFASMS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +806,7 @@ func (m *MFATOTP) Reset()         { *m = MFATOTP{} }
 func (m *MFATOTP) String() string { return proto.CompactTextString(m) }
 func (*MFATOTP) ProtoMessage()    {}
 func (*MFATOTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{9}
+	return fileDescriptor_030765f334c86cea, []int{10}
 }
 func (m *MFATOTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,6 +854,7 @@ type MFA struct {
 	//	*MFA_SMS
 	//	*MFA_TOTP
 	//	*MFA_FIDO
+	//	*MFA_FIDOKeys
 	MFA isMFA_MFA `protobuf_oneof:"MFA"`
 }
 
@@ -773,7 +862,7 @@ func (m *MFA) Reset()         { *m = MFA{} }
 func (m *MFA) String() string { return proto.CompactTextString(m) }
 func (*MFA) ProtoMessage()    {}
 func (*MFA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{10}
+	return fileDescriptor_030765f334c86cea, []int{11}
 }
 func (m *MFA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,10 +906,14 @@ type MFA_TOTP struct {
 type MFA_FIDO struct {
 	FIDO *MFAFIDO `protobuf:"bytes,3,opt,name=FIDO,proto3,oneof" json:"FIDO,omitempty"`
 }
+type MFA_FIDOKeys struct {
+	FIDOKeys *MFAFIDOKeys `protobuf:"bytes,4,opt,name=FIDOKeys,proto3,oneof" json:"FIDOKeys,omitempty"`
+}
 
-func (*MFA_SMS) isMFA_MFA()  {}
-func (*MFA_TOTP) isMFA_MFA() {}
-func (*MFA_FIDO) isMFA_MFA() {}
+func (*MFA_SMS) isMFA_MFA()      {}
+func (*MFA_TOTP) isMFA_MFA()     {}
+func (*MFA_FIDO) isMFA_MFA()     {}
+func (*MFA_FIDOKeys) isMFA_MFA() {}
 
 func (m *MFA) GetMFA() isMFA_MFA {
 	if m != nil {
@@ -850,12 +943,20 @@ func (m *MFA) GetFIDO() *MFAFIDO {
 	return nil
 }
 
+func (m *MFA) GetFIDOKeys() *MFAFIDOKeys {
+	if x, ok := m.GetMFA().(*MFA_FIDOKeys); ok {
+		return x.FIDOKeys
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MFA) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MFA_SMS)(nil),
 		(*MFA_TOTP)(nil),
 		(*MFA_FIDO)(nil),
+		(*MFA_FIDOKeys)(nil),
 	}
 }
 
@@ -868,7 +969,7 @@ func (m *MFARegistration) Reset()         { *m = MFARegistration{} }
 func (m *MFARegistration) String() string { return proto.CompactTextString(m) }
 func (*MFARegistration) ProtoMessage()    {}
 func (*MFARegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{11}
+	return fileDescriptor_030765f334c86cea, []int{12}
 }
 func (m *MFARegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,7 +1021,7 @@ func (m *ValidateRequest) Reset()         { *m = ValidateRequest{} }
 func (m *ValidateRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateRequest) ProtoMessage()    {}
 func (*ValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{12}
+	return fileDescriptor_030765f334c86cea, []int{13}
 }
 func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1073,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{13}
+	return fileDescriptor_030765f334c86cea, []int{14}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1126,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{14}
+	return fileDescriptor_030765f334c86cea, []int{15}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1184,7 @@ func (m *ForgotPasswordRequest) Reset()         { *m = ForgotPasswordRequest{} }
 func (m *ForgotPasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ForgotPasswordRequest) ProtoMessage()    {}
 func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{15}
+	return fileDescriptor_030765f334c86cea, []int{16}
 }
 func (m *ForgotPasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1129,7 +1230,7 @@ func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
 func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetPasswordRequest) ProtoMessage()    {}
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{16}
+	return fileDescriptor_030765f334c86cea, []int{17}
 }
 func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1179,21 +1280,25 @@ func (m *ResetPasswordRequest) GetPassword() string {
 	return ""
 }
 
-type Empty struct {
+type Organisation struct {
+	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role      OrgRole `protobuf:"varint,3,opt,name=role,proto3,enum=ataas.users.OrgRole" json:"role,omitempty"`
+	CreatedAt int64   `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (m *Empty) Reset()         { *m = Empty{} }
-func (m *Empty) String() string { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()    {}
-func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{17}
+func (m *Organisation) Reset()         { *m = Organisation{} }
+func (m *Organisation) String() string { return proto.CompactTextString(m) }
+func (*Organisation) ProtoMessage()    {}
+func (*Organisation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{18}
 }
-func (m *Empty) XXX_Unmarshal(b []byte) error {
+func (m *Organisation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Organisation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Organisation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1203,456 +1308,667 @@ func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Empty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Empty.Merge(m, src)
+func (m *Organisation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organisation.Merge(m, src)
 }
-func (m *Empty) XXX_Size() int {
+func (m *Organisation) XXX_Size() int {
 	return m.Size()
 }
-func (m *Empty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Empty.DiscardUnknown(m)
+func (m *Organisation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organisation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Empty proto.InternalMessageInfo
+var xxx_messageInfo_Organisation proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("ataas.users.User_Status", User_Status_name, User_Status_value)
-	proto.RegisterEnum("ataas.users.UserRequest_Status", UserRequest_Status_name, UserRequest_Status_value)
-	proto.RegisterType((*User)(nil), "ataas.users.User")
-	proto.RegisterMapType((map[string][]byte)(nil), "ataas.users.User.MetadataEntry")
-	proto.RegisterType((*UserRequest)(nil), "ataas.users.UserRequest")
-	proto.RegisterType((*UserList)(nil), "ataas.users.UserList")
-	proto.RegisterType((*PasswordUpdateRequest)(nil), "ataas.users.PasswordUpdateRequest")
-	proto.RegisterType((*UpdateRequest)(nil), "ataas.users.UpdateRequest")
-	proto.RegisterType((*AuthRequest)(nil), "ataas.users.AuthRequest")
-	proto.RegisterType((*MFAFIDO)(nil), "ataas.users.MFAFIDO")
-	proto.RegisterType((*FIDOAuthenticator)(nil), "ataas.users.FIDOAuthenticator")
-	proto.RegisterType((*MFASMS)(nil), "ataas.users.MFASMS")
-	proto.RegisterType((*MFATOTP)(nil), "ataas.users.MFATOTP")
-	proto.RegisterType((*MFA)(nil), "ataas.users.MFA")
-	proto.RegisterType((*MFARegistration)(nil), "ataas.users.MFARegistration")
-	proto.RegisterType((*ValidateRequest)(nil), "ataas.users.ValidateRequest")
-	proto.RegisterType((*CreateRequest)(nil), "ataas.users.CreateRequest")
-	proto.RegisterType((*ListRequest)(nil), "ataas.users.ListRequest")
-	proto.RegisterType((*ForgotPasswordRequest)(nil), "ataas.users.ForgotPasswordRequest")
-	proto.RegisterType((*ResetPasswordRequest)(nil), "ataas.users.ResetPasswordRequest")
-	proto.RegisterType((*Empty)(nil), "ataas.users.Empty")
+func (m *Organisation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("users.proto", fileDescriptor_030765f334c86cea) }
+func (m *Organisation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-var fileDescriptor_030765f334c86cea = []byte{
-	// 1309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x7a, 0xfd, 0x2f, 0x6f, 0xe3, 0xc4, 0x9d, 0xa6, 0xed, 0x62, 0xa5, 0xc6, 0x8c, 0x40,
-	0x35, 0x91, 0x6a, 0x43, 0x8a, 0x28, 0x4a, 0x54, 0xa1, 0x6d, 0x6c, 0x37, 0x91, 0xe2, 0xb4, 0x5a,
-	0x27, 0x45, 0xe4, 0x40, 0x35, 0x59, 0x4f, 0xdc, 0x55, 0xec, 0xdd, 0xed, 0xee, 0x6c, 0xaa, 0x08,
-	0x71, 0x80, 0x3b, 0x12, 0x12, 0x27, 0xbe, 0x09, 0x1f, 0x81, 0x13, 0xaa, 0xc4, 0x85, 0x23, 0x6a,
-	0xf8, 0x0c, 0x9c, 0xd1, 0xcc, 0x8e, 0xed, 0x5d, 0x7b, 0xb1, 0xe8, 0x25, 0xf1, 0xbc, 0xf7, 0xe6,
-	0xfd, 0xde, 0xfc, 0xde, 0xef, 0xcd, 0xd8, 0xa0, 0x85, 0x01, 0xf5, 0x83, 0xa6, 0xe7, 0xbb, 0xcc,
-	0x45, 0x1a, 0x61, 0x84, 0x04, 0x4d, 0x61, 0xaa, 0x6e, 0x0e, 0x5d, 0x77, 0x38, 0xa2, 0x2d, 0xe2,
-	0xd9, 0x2d, 0xe2, 0x38, 0x2e, 0x23, 0xcc, 0x76, 0x1d, 0x19, 0x5a, 0x85, 0xa1, 0x3b, 0x74, 0xa3,
-	0xcf, 0xf8, 0x1f, 0x15, 0x72, 0x27, 0x01, 0xf5, 0xd1, 0x1a, 0x64, 0xed, 0x81, 0xae, 0xd4, 0x95,
-	0xc6, 0x8a, 0x99, 0xb5, 0x07, 0xe8, 0x13, 0x28, 0x04, 0x8c, 0xb0, 0x30, 0xd0, 0xb3, 0x75, 0xa5,
-	0xb1, 0xb6, 0xad, 0x37, 0x63, 0x00, 0x4d, 0xbe, 0xa5, 0xd9, 0x17, 0x7e, 0x53, 0xc6, 0xa1, 0x4d,
-	0x58, 0x39, 0xb7, 0xfd, 0x80, 0x1d, 0x91, 0x31, 0xd5, 0x55, 0x91, 0x68, 0x66, 0x40, 0x55, 0x28,
-	0x8d, 0x88, 0x74, 0xe6, 0x84, 0x73, 0xba, 0x46, 0x1b, 0x90, 0xa7, 0x63, 0x62, 0x8f, 0xf4, 0xbc,
-	0x70, 0x44, 0x0b, 0x9e, 0xcf, 0xf2, 0x29, 0x61, 0x74, 0x60, 0x30, 0xbd, 0x50, 0x57, 0x1a, 0xaa,
-	0x39, 0x33, 0x70, 0x6f, 0xe8, 0x0d, 0xa4, 0xb7, 0x18, 0x79, 0xa7, 0x06, 0xee, 0x1d, 0xd0, 0x11,
-	0x8d, 0xbc, 0xa5, 0xc8, 0x3b, 0x35, 0xf0, 0x5a, 0x3c, 0x12, 0x04, 0xaf, 0x5d, 0x7f, 0xa0, 0xaf,
-	0x44, 0xb5, 0x4c, 0xd6, 0x68, 0x17, 0x4a, 0x63, 0xca, 0xc8, 0x80, 0x30, 0xa2, 0x43, 0x5d, 0x6d,
-	0x68, 0xdb, 0xef, 0x2f, 0x9e, 0xbc, 0x27, 0x23, 0x3a, 0x0e, 0xf3, 0xaf, 0xcc, 0xe9, 0x06, 0x84,
-	0x41, 0x1d, 0x9f, 0x13, 0x5d, 0xab, 0x2b, 0x0d, 0x6d, 0xbb, 0x92, 0xd8, 0xd7, 0xeb, 0x1a, 0x26,
-	0x77, 0x22, 0x1d, 0x8a, 0xc4, 0xb2, 0xdc, 0xd0, 0x61, 0xfa, 0xaa, 0xc0, 0x9e, 0x2c, 0xab, 0xbb,
-	0x50, 0x4e, 0x24, 0x46, 0x15, 0x50, 0x2f, 0xe8, 0x95, 0x6c, 0x0a, 0xff, 0xc8, 0x99, 0xba, 0x24,
-	0xa3, 0x90, 0x8a, 0xa6, 0xac, 0x9a, 0xd1, 0x62, 0x27, 0xfb, 0x85, 0x82, 0x9b, 0x50, 0x88, 0xfa,
-	0x81, 0x34, 0x28, 0x3e, 0xeb, 0x1c, 0xb5, 0x0f, 0x8e, 0x9e, 0x54, 0x32, 0x08, 0xa0, 0x60, 0xec,
-	0x1d, 0x1f, 0x3c, 0xef, 0x54, 0x14, 0xee, 0x68, 0x77, 0x0e, 0x3b, 0xc7, 0x9d, 0x76, 0x25, 0x8b,
-	0x7f, 0x57, 0x40, 0xe3, 0x67, 0x31, 0xe9, 0xab, 0x90, 0x06, 0x0c, 0x55, 0x66, 0xfd, 0xdf, 0xcf,
-	0x08, 0x05, 0xdc, 0x9e, 0x74, 0x25, 0x2b, 0x8d, 0xb2, 0x2f, 0xd5, 0xd9, 0x01, 0x72, 0xd2, 0x33,
-	0x31, 0xa0, 0x87, 0x53, 0xd5, 0xa8, 0x42, 0x35, 0x8b, 0xdc, 0x49, 0xbc, 0x39, 0xf1, 0xe0, 0x87,
-	0xd3, 0xf2, 0x67, 0x15, 0x67, 0xe2, 0x47, 0x49, 0x96, 0x8f, 0x8a, 0xa0, 0x1a, 0x47, 0x5f, 0x57,
-	0xac, 0xc7, 0x45, 0xc8, 0xbf, 0x0a, 0xa9, 0x7f, 0x85, 0x0f, 0xa0, 0xc4, 0xf3, 0x1f, 0xda, 0x01,
-	0x43, 0xf7, 0x20, 0x2f, 0x10, 0x75, 0x45, 0x74, 0xf0, 0xc6, 0x62, 0x15, 0x91, 0x9f, 0xf3, 0xc9,
-	0x5c, 0x46, 0xa2, 0x33, 0xaa, 0x66, 0xb4, 0xc0, 0x0e, 0xdc, 0x7a, 0x26, 0xf5, 0x70, 0x22, 0x24,
-	0x35, 0x21, 0x69, 0x7e, 0x48, 0xe2, 0x42, 0xca, 0xce, 0x09, 0xe9, 0x63, 0xa8, 0x58, 0xa1, 0xef,
-	0x53, 0x87, 0xbd, 0x98, 0xc6, 0x44, 0x53, 0xb1, 0x2e, 0xed, 0x13, 0x0c, 0xdc, 0x85, 0xf2, 0x72,
-	0x9c, 0x8f, 0x20, 0xc7, 0xeb, 0x15, 0x18, 0xa9, 0xc7, 0x11, 0x6e, 0xfc, 0x25, 0x68, 0x46, 0xc8,
-	0x5e, 0x4e, 0xb2, 0x4c, 0xc7, 0x4a, 0x89, 0x8f, 0xd5, 0x92, 0x9a, 0xf1, 0x2f, 0x0a, 0x14, 0x7b,
-	0x5d, 0xa3, 0x7b, 0xd0, 0x7e, 0x1a, 0xab, 0x61, 0x55, 0xd4, 0xb0, 0x06, 0x59, 0xef, 0x42, 0xea,
-	0x2e, 0xeb, 0x5d, 0xa0, 0x06, 0xac, 0x13, 0xc6, 0x68, 0x10, 0xdd, 0x2d, 0xc7, 0x57, 0xde, 0x64,
-	0xe8, 0xe7, 0xcd, 0xa8, 0x0d, 0x65, 0x12, 0xb2, 0x97, 0xd4, 0x61, 0xb6, 0x45, 0x98, 0xeb, 0x0b,
-	0xd9, 0x68, 0xdb, 0xb5, 0xc4, 0x31, 0x38, 0xa6, 0x11, 0x8f, 0x32, 0x93, 0x9b, 0xf0, 0x18, 0x6e,
-	0x2c, 0xc4, 0xa0, 0xdb, 0x50, 0x30, 0x8c, 0x27, 0x27, 0x07, 0x6d, 0x59, 0xa8, 0x5c, 0xf1, 0xf9,
-	0xef, 0xdb, 0x43, 0x67, 0x4f, 0xa8, 0x94, 0xd7, 0x5c, 0x36, 0x67, 0x06, 0x84, 0x61, 0x75, 0x6f,
-	0xe4, 0x3a, 0xf4, 0x2b, 0xe2, 0x3b, 0xb6, 0x33, 0x14, 0x75, 0x97, 0xcc, 0x84, 0x0d, 0xd7, 0xa1,
-	0xd0, 0xeb, 0x1a, 0xfd, 0x5e, 0x9f, 0x63, 0x8c, 0xdd, 0x33, 0x7b, 0x44, 0x25, 0x8f, 0x72, 0x85,
-	0x1f, 0x09, 0xae, 0x8e, 0x9f, 0x1e, 0x3f, 0x4b, 0x19, 0xd4, 0x3a, 0x68, 0x67, 0xc4, 0xba, 0x08,
-	0xbd, 0x3d, 0x77, 0x40, 0xf9, 0x1d, 0xaa, 0x36, 0x56, 0xcc, 0xb8, 0x09, 0xff, 0xa8, 0x80, 0xda,
-	0xeb, 0x1a, 0xe8, 0x1e, 0xa8, 0xfd, 0x5e, 0x5f, 0xec, 0xd5, 0xb6, 0x6f, 0xce, 0xdf, 0x19, 0xfd,
-	0x5e, 0x7f, 0x3f, 0x63, 0xf2, 0x08, 0xb4, 0x05, 0x39, 0x0e, 0x26, 0x45, 0xb0, 0x31, 0x1f, 0xc9,
-	0x7d, 0xfb, 0x19, 0x53, 0xc4, 0xf0, 0x58, 0x4e, 0x96, 0x38, 0x59, 0x4a, 0x2c, 0xf7, 0xf1, 0x58,
-	0xfe, 0xff, 0x71, 0x5e, 0xd4, 0x81, 0x3b, 0xb0, 0xce, 0xef, 0x28, 0x3a, 0xb4, 0x03, 0xe6, 0x8b,
-	0xe6, 0x2d, 0xc8, 0x50, 0x5e, 0x6f, 0xd9, 0x25, 0xd7, 0x1b, 0x7e, 0x04, 0xeb, 0xcf, 0xc9, 0xc8,
-	0x8e, 0xab, 0x39, 0x5d, 0x87, 0x62, 0xf4, 0x2e, 0xa8, 0x23, 0x45, 0x18, 0x2d, 0xf0, 0x31, 0x94,
-	0xf7, 0xc4, 0x1d, 0x3f, 0xd9, 0x3c, 0x91, 0xbe, 0xb2, 0x54, 0xfa, 0xbc, 0xe1, 0x3e, 0xb5, 0x88,
-	0xc7, 0xac, 0x97, 0x44, 0x66, 0x9c, 0x19, 0xf0, 0x29, 0x68, 0xfc, 0x5e, 0x88, 0x15, 0x34, 0xb2,
-	0xc7, 0x36, 0x13, 0x49, 0xf3, 0x66, 0xb4, 0x40, 0x77, 0x01, 0x3c, 0x32, 0xa4, 0x2f, 0x02, 0x46,
-	0x7c, 0x36, 0xc9, 0xc1, 0x2d, 0x7d, 0x6e, 0xe0, 0x32, 0x70, 0xcf, 0xcf, 0x03, 0xca, 0x04, 0xa9,
-	0xaa, 0x29, 0x57, 0xf8, 0x3e, 0xdc, 0xea, 0xba, 0xfe, 0xd0, 0x9d, 0x8e, 0xf3, 0xd2, 0x63, 0xe3,
-	0x6f, 0x60, 0xc3, 0xa4, 0x01, 0xfd, 0x7f, 0xd1, 0xe9, 0x24, 0x25, 0x46, 0x58, 0x9d, 0x1b, 0xe1,
-	0x22, 0xe4, 0x3b, 0x63, 0x8f, 0x5d, 0x6d, 0xff, 0x5a, 0x8a, 0x2e, 0xf8, 0x3e, 0xf5, 0x2f, 0x6d,
-	0x8b, 0xa2, 0x13, 0x28, 0x44, 0xcc, 0xa2, 0x6a, 0x82, 0xc4, 0x04, 0xdd, 0xd5, 0x45, 0x82, 0xf1,
-	0xe6, 0x0f, 0x7f, 0xfc, 0xfd, 0x73, 0xf6, 0x36, 0xbe, 0xd1, 0xba, 0xfc, 0xb4, 0xc5, 0x67, 0xb2,
-	0xe5, 0x0b, 0x91, 0x50, 0x7f, 0x47, 0xd9, 0x42, 0xf6, 0xac, 0xdf, 0x86, 0x7c, 0x04, 0x36, 0x13,
-	0x39, 0xe6, 0xd4, 0x50, 0x45, 0x09, 0xaf, 0xa8, 0x15, 0x7f, 0x28, 0x20, 0x6a, 0xf8, 0xbd, 0x29,
-	0xc4, 0xa5, 0xdc, 0xf5, 0x42, 0xbe, 0x2c, 0x1c, 0xea, 0x02, 0x0a, 0x6d, 0xf1, 0x86, 0x23, 0xfd,
-	0xbf, 0x9e, 0x95, 0xd4, 0xec, 0x0f, 0x44, 0xf6, 0xfb, 0x5b, 0x77, 0x44, 0xf6, 0x96, 0x70, 0xb5,
-	0xbe, 0xb5, 0x07, 0xdf, 0xb5, 0xa2, 0xaf, 0x04, 0xa7, 0x08, 0x97, 0xb9, 0x6b, 0x4c, 0xa5, 0x81,
-	0x83, 0x1d, 0x82, 0xfa, 0x84, 0xb2, 0x25, 0x48, 0x29, 0x4c, 0xe9, 0x02, 0x08, 0xa1, 0xca, 0x3c,
-	0x10, 0x3a, 0x84, 0x9c, 0x78, 0x98, 0x92, 0xe9, 0x62, 0x9a, 0xac, 0xde, 0x5a, 0x48, 0xc7, 0xbd,
-	0xf8, 0xa6, 0x48, 0x59, 0x46, 0x5a, 0x2c, 0x25, 0xfa, 0x5e, 0x01, 0xad, 0x3f, 0x93, 0x10, 0xc2,
-	0x89, 0xbd, 0xa9, 0x4f, 0x57, 0x2a, 0x31, 0x3b, 0x22, 0xf9, 0x67, 0x92, 0xf6, 0x38, 0x31, 0x13,
-	0x39, 0xed, 0x28, 0x5b, 0xa7, 0x1b, 0x78, 0x5d, 0xb2, 0x13, 0xb3, 0x22, 0x02, 0x85, 0x08, 0x60,
-	0x4e, 0x4e, 0x49, 0xd4, 0x14, 0x92, 0x1a, 0x02, 0x14, 0xe3, 0x05, 0x92, 0x38, 0x96, 0x86, 0x0b,
-	0x11, 0x16, 0x87, 0xd8, 0x85, 0x6c, 0x8f, 0xa2, 0x94, 0xc2, 0xd3, 0xd2, 0xae, 0x89, 0xb4, 0x25,
-	0x24, 0xf7, 0xa3, 0x21, 0xac, 0x25, 0xc7, 0x72, 0x8e, 0xa5, 0xd4, 0x99, 0x4d, 0x65, 0xe9, 0xae,
-	0xc8, 0x7c, 0x07, 0x23, 0x9e, 0xf9, 0x5c, 0x6c, 0x8b, 0x13, 0x31, 0x80, 0x72, 0x62, 0xa0, 0xd1,
-	0x07, 0x89, 0x1c, 0x69, 0xc3, 0x9e, 0x0a, 0x93, 0x18, 0x33, 0x9f, 0xef, 0x8a, 0xa3, 0x3c, 0x80,
-	0x5c, 0xd7, 0x76, 0x06, 0xef, 0xa4, 0x47, 0xf4, 0x39, 0xe4, 0x8d, 0x31, 0x75, 0x06, 0xef, 0xd8,
-	0xa2, 0xc7, 0xfb, 0xbf, 0xbd, 0xad, 0x29, 0x6f, 0xde, 0xd6, 0x94, 0xbf, 0xde, 0xd6, 0x94, 0x9f,
-	0xae, 0x6b, 0x99, 0x37, 0xd7, 0xb5, 0xcc, 0x9f, 0xd7, 0xb5, 0xcc, 0x69, 0xd3, 0x1b, 0x37, 0x99,
-	0x75, 0xfe, 0xba, 0x69, 0xb9, 0xe3, 0x26, 0x09, 0x5b, 0x81, 0x1b, 0xfa, 0x16, 0x6d, 0x89, 0x0c,
-	0xe2, 0xd7, 0x86, 0x77, 0x16, 0x75, 0x74, 0x57, 0xfc, 0x3d, 0x2b, 0x88, 0x5f, 0x19, 0x0f, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xce, 0x2e, 0x6b, 0xab, 0x0c, 0x00, 0x00,
+func (m *Organisation) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_VIEWER
 }
 
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Organisation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrgList struct {
+	Orgs []*Organisation `protobuf:"bytes,1,rep,name=orgs,proto3" json:"orgs,omitempty"`
 }
 
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Mfa != nil {
-		{
-			size, err := m.Mfa.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintUsers(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Metadata) > 0 {
-		for k := range m.Metadata {
-			v := m.Metadata[k]
-			baseI := i
-			if len(v) > 0 {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintUsers(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintUsers(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintUsers(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
+func (m *OrgList) Reset()         { *m = OrgList{} }
+func (m *OrgList) String() string { return proto.CompactTextString(m) }
+func (*OrgList) ProtoMessage()    {}
+func (*OrgList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{19}
+}
+func (m *OrgList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *OrgList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgList.Merge(m, src)
+}
+func (m *OrgList) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrgList proto.InternalMessageInfo
+
+func (m *OrgList) GetOrgs() []*Organisation {
+	if m != nil {
+		return m.Orgs
 	}
-	if m.DeletedAt != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.DeletedAt))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+type CreateOrgRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *CreateOrgRequest) Reset()         { *m = CreateOrgRequest{} }
+func (m *CreateOrgRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrgRequest) ProtoMessage()    {}
+func (*CreateOrgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{20}
+}
+func (m *CreateOrgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateOrgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateOrgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *CreateOrgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrgRequest.Merge(m, src)
+}
+func (m *CreateOrgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateOrgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrgRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrgRequest proto.InternalMessageInfo
+
+func (m *CreateOrgRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+type OrgRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *OrgRequest) Reset()         { *m = OrgRequest{} }
+func (m *OrgRequest) String() string { return proto.CompactTextString(m) }
+func (*OrgRequest) ProtoMessage()    {}
+func (*OrgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{21}
+}
+func (m *OrgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Status != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *OrgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgRequest.Merge(m, src)
+}
+func (m *OrgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgRequest.DiscardUnknown(m)
 }
 
-func (m *UserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrgRequest proto.InternalMessageInfo
+
+func (m *OrgRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrgMember struct {
+	UserId    string  `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Email     string  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName string  `protobuf:"bytes,3,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName  string  `protobuf:"bytes,4,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	Role      OrgRole `protobuf:"varint,5,opt,name=role,proto3,enum=ataas.users.OrgRole" json:"role,omitempty"`
+	CreatedAt int64   `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Query != nil {
-		{
-			size := m.Query.Size()
-			i -= size
-			if _, err := m.Query.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *OrgMember) Reset()         { *m = OrgMember{} }
+func (m *OrgMember) String() string { return proto.CompactTextString(m) }
+func (*OrgMember) ProtoMessage()    {}
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{22}
+}
+func (m *OrgMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UserRequest_Id) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrgMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgMember.Merge(m, src)
 }
-
-func (m *UserRequest_Id) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Id)
-	copy(dAtA[i:], m.Id)
-	i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *OrgMember) XXX_Size() int {
+	return m.Size()
 }
-func (m *UserRequest_Email) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrgMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgMember.DiscardUnknown(m)
 }
 
-func (m *UserRequest_Email) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Email)
-	copy(dAtA[i:], m.Email)
-	i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+var xxx_messageInfo_OrgMember proto.InternalMessageInfo
+
+func (m *OrgMember) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
 }
-func (m *UserRequest_Account) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *OrgMember) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-func (m *UserRequest_Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Account)
-	copy(dAtA[i:], m.Account)
-	i = encodeVarintUsers(dAtA, i, uint64(len(m.Account)))
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
+func (m *OrgMember) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
 }
-func (m *UserList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *OrgMember) GetLastName() string {
+	if m != nil {
+		return m.LastName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UserList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrgMember) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_VIEWER
 }
 
-func (m *UserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Total != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
+func (m *OrgMember) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUsers(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+type OrgMemberList struct {
+	Members []*OrgMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *OrgMemberList) Reset()         { *m = OrgMemberList{} }
+func (m *OrgMemberList) String() string { return proto.CompactTextString(m) }
+func (*OrgMemberList) ProtoMessage()    {}
+func (*OrgMemberList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{23}
+}
+func (m *OrgMemberList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgMemberList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgMemberList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrgMemberList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgMemberList.Merge(m, src)
+}
+func (m *OrgMemberList) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgMemberList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgMemberList.DiscardUnknown(m)
 }
 
-func (m *PasswordUpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrgMemberList proto.InternalMessageInfo
+
+func (m *OrgMemberList) GetMembers() []*OrgMember {
+	if m != nil {
+		return m.Members
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PasswordUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrgMemberRequest struct {
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string  `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Email  string  `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role   OrgRole `protobuf:"varint,4,opt,name=role,proto3,enum=ataas.users.OrgRole" json:"role,omitempty"`
 }
 
-func (m *PasswordUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentPassword) > 0 {
-		i -= len(m.CurrentPassword)
-		copy(dAtA[i:], m.CurrentPassword)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.CurrentPassword)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
+func (m *OrgMemberRequest) Reset()         { *m = OrgMemberRequest{} }
+func (m *OrgMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*OrgMemberRequest) ProtoMessage()    {}
+func (*OrgMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{24}
+}
+func (m *OrgMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OrgMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgMemberRequest.Merge(m, src)
+}
+func (m *OrgMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrgMemberRequest proto.InternalMessageInfo
+
+func (m *OrgMemberRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrgMemberRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrgMemberRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintUsers(dAtA, i, uint64(size))
+func (m *OrgMemberRequest) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_VIEWER
+}
+
+type MembershipRequest struct {
+	UserId  string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *MembershipRequest) Reset()         { *m = MembershipRequest{} }
+func (m *MembershipRequest) String() string { return proto.CompactTextString(m) }
+func (*MembershipRequest) ProtoMessage()    {}
+func (*MembershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{25}
+}
+func (m *MembershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MembershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MembershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MembershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipRequest.Merge(m, src)
+}
+func (m *MembershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MembershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipRequest proto.InternalMessageInfo
+
+func (m *MembershipRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
+func (m *MembershipRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type Membership struct {
+	Account string  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Role    OrgRole `protobuf:"varint,2,opt,name=role,proto3,enum=ataas.users.OrgRole" json:"role,omitempty"`
+}
+
+func (m *Membership) Reset()         { *m = Membership{} }
+func (m *Membership) String() string { return proto.CompactTextString(m) }
+func (*Membership) ProtoMessage()    {}
+func (*Membership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{26}
+}
+func (m *Membership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Membership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Membership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Membership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Membership.Merge(m, src)
+}
+func (m *Membership) XXX_Size() int {
+	return m.Size()
+}
+func (m *Membership) XXX_DiscardUnknown() {
+	xxx_messageInfo_Membership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Membership proto.InternalMessageInfo
+
+func (m *Membership) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *Membership) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_VIEWER
+}
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{27}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("ataas.users.OrgRole", OrgRole_name, OrgRole_value)
+	proto.RegisterEnum("ataas.users.User_Status", User_Status_name, User_Status_value)
+	proto.RegisterEnum("ataas.users.UserRequest_Status", UserRequest_Status_name, UserRequest_Status_value)
+	proto.RegisterType((*User)(nil), "ataas.users.User")
+	proto.RegisterMapType((map[string][]byte)(nil), "ataas.users.User.MetadataEntry")
+	proto.RegisterType((*UserRequest)(nil), "ataas.users.UserRequest")
+	proto.RegisterType((*UserList)(nil), "ataas.users.UserList")
+	proto.RegisterType((*PasswordUpdateRequest)(nil), "ataas.users.PasswordUpdateRequest")
+	proto.RegisterType((*UpdateRequest)(nil), "ataas.users.UpdateRequest")
+	proto.RegisterType((*AuthRequest)(nil), "ataas.users.AuthRequest")
+	proto.RegisterType((*MFAFIDO)(nil), "ataas.users.MFAFIDO")
+	proto.RegisterType((*MFAFIDOKeys)(nil), "ataas.users.MFAFIDOKeys")
+	proto.RegisterType((*FIDOAuthenticator)(nil), "ataas.users.FIDOAuthenticator")
+	proto.RegisterType((*MFASMS)(nil), "ataas.users.MFASMS")
+	proto.RegisterType((*MFATOTP)(nil), "ataas.users.MFATOTP")
+	proto.RegisterType((*MFA)(nil), "ataas.users.MFA")
+	proto.RegisterType((*MFARegistration)(nil), "ataas.users.MFARegistration")
+	proto.RegisterType((*ValidateRequest)(nil), "ataas.users.ValidateRequest")
+	proto.RegisterType((*CreateRequest)(nil), "ataas.users.CreateRequest")
+	proto.RegisterType((*ListRequest)(nil), "ataas.users.ListRequest")
+	proto.RegisterType((*ForgotPasswordRequest)(nil), "ataas.users.ForgotPasswordRequest")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "ataas.users.ResetPasswordRequest")
+	proto.RegisterType((*Organisation)(nil), "ataas.users.Organisation")
+	proto.RegisterType((*OrgList)(nil), "ataas.users.OrgList")
+	proto.RegisterType((*CreateOrgRequest)(nil), "ataas.users.CreateOrgRequest")
+	proto.RegisterType((*OrgRequest)(nil), "ataas.users.OrgRequest")
+	proto.RegisterType((*OrgMember)(nil), "ataas.users.OrgMember")
+	proto.RegisterType((*OrgMemberList)(nil), "ataas.users.OrgMemberList")
+	proto.RegisterType((*OrgMemberRequest)(nil), "ataas.users.OrgMemberRequest")
+	proto.RegisterType((*MembershipRequest)(nil), "ataas.users.MembershipRequest")
+	proto.RegisterType((*Membership)(nil), "ataas.users.Membership")
+	proto.RegisterType((*Empty)(nil), "ataas.users.Empty")
+}
+
+func init() { proto.RegisterFile("users.proto", fileDescriptor_030765f334c86cea) }
+
+var fileDescriptor_030765f334c86cea = []byte{
+	// 1761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdd, 0x6e, 0xdb, 0xc8,
+	0x15, 0x16, 0x49, 0xfd, 0xd8, 0x87, 0x96, 0xad, 0xcc, 0x26, 0x0e, 0x23, 0x38, 0xaa, 0x3a, 0x6d,
+	0x77, 0xb5, 0x46, 0x23, 0x6d, 0x9d, 0x62, 0xb3, 0x48, 0xb0, 0x28, 0x18, 0x5b, 0x8e, 0x8d, 0x46,
+	0x76, 0x40, 0x39, 0x09, 0x1a, 0xa0, 0x0d, 0xc6, 0xe2, 0x98, 0x21, 0x2c, 0x91, 0x5a, 0x72, 0xe4,
+	0x85, 0x77, 0xd1, 0x8b, 0xf6, 0x09, 0x0a, 0xf4, 0x39, 0xfa, 0x04, 0xbd, 0x2e, 0xd0, 0xab, 0x62,
+	0x81, 0xde, 0x14, 0xe8, 0x4d, 0x91, 0xf4, 0x19, 0x7a, 0x5d, 0xcc, 0x70, 0xf8, 0x2b, 0xda, 0xd9,
+	0xb4, 0x37, 0x09, 0xe7, 0x9c, 0x33, 0xe7, 0x3b, 0xf3, 0xcd, 0xf9, 0x19, 0x0b, 0xf4, 0x45, 0x48,
+	0x83, 0xb0, 0x3f, 0x0f, 0x7c, 0xe6, 0x23, 0x9d, 0x30, 0x42, 0xc2, 0xbe, 0x10, 0xb5, 0xb7, 0x1c,
+	0xdf, 0x77, 0xa6, 0x74, 0x40, 0xe6, 0xee, 0x80, 0x78, 0x9e, 0xcf, 0x08, 0x73, 0x7d, 0x4f, 0x9a,
+	0xb6, 0xc1, 0xf1, 0x1d, 0x3f, 0xfa, 0xc6, 0xff, 0xd1, 0xa0, 0xfa, 0x3c, 0xa4, 0x01, 0x5a, 0x07,
+	0xd5, 0xb5, 0x0d, 0xa5, 0xab, 0xf4, 0x56, 0x2d, 0xd5, 0xb5, 0xd1, 0x67, 0x50, 0x0f, 0x19, 0x61,
+	0x8b, 0xd0, 0x50, 0xbb, 0x4a, 0x6f, 0x7d, 0xc7, 0xe8, 0x67, 0x00, 0xfa, 0x7c, 0x4b, 0x7f, 0x2c,
+	0xf4, 0x96, 0xb4, 0x43, 0x5b, 0xb0, 0x7a, 0xe6, 0x06, 0x21, 0x3b, 0x22, 0x33, 0x6a, 0x68, 0xc2,
+	0x51, 0x2a, 0x40, 0x6d, 0x58, 0x99, 0x12, 0xa9, 0xac, 0x0a, 0x65, 0xb2, 0x46, 0x37, 0xa1, 0x46,
+	0x67, 0xc4, 0x9d, 0x1a, 0x35, 0xa1, 0x88, 0x16, 0xdc, 0xdf, 0x24, 0xa0, 0x84, 0x51, 0xdb, 0x64,
+	0x46, 0xbd, 0xab, 0xf4, 0x34, 0x2b, 0x15, 0x70, 0xed, 0x62, 0x6e, 0x4b, 0x6d, 0x23, 0xd2, 0x26,
+	0x02, 0xae, 0xb5, 0xe9, 0x94, 0x46, 0xda, 0x95, 0x48, 0x9b, 0x08, 0x78, 0x2c, 0x73, 0x12, 0x86,
+	0x5f, 0xfb, 0x81, 0x6d, 0xac, 0x46, 0xb1, 0xc4, 0x6b, 0xf4, 0x08, 0x56, 0x66, 0x94, 0x11, 0x9b,
+	0x30, 0x62, 0x40, 0x57, 0xeb, 0xe9, 0x3b, 0x3f, 0x58, 0x3e, 0xf9, 0x48, 0x5a, 0x0c, 0x3d, 0x16,
+	0x5c, 0x5a, 0xc9, 0x06, 0x84, 0x41, 0x9b, 0x9d, 0x11, 0x43, 0xef, 0x2a, 0x3d, 0x7d, 0xa7, 0x95,
+	0xdb, 0x37, 0xda, 0x37, 0x2d, 0xae, 0x44, 0x06, 0x34, 0xc8, 0x64, 0xe2, 0x2f, 0x3c, 0x66, 0xac,
+	0x09, 0xec, 0x78, 0xd9, 0x7e, 0x04, 0xcd, 0x9c, 0x63, 0xd4, 0x02, 0xed, 0x9c, 0x5e, 0xca, 0x4b,
+	0xe1, 0x9f, 0x9c, 0xa9, 0x0b, 0x32, 0x5d, 0x50, 0x71, 0x29, 0x6b, 0x56, 0xb4, 0x78, 0xa8, 0x7e,
+	0xa1, 0xe0, 0x3e, 0xd4, 0xa3, 0xfb, 0x40, 0x3a, 0x34, 0x9e, 0x0d, 0x8f, 0xf6, 0x0e, 0x8f, 0x9e,
+	0xb4, 0x2a, 0x08, 0xa0, 0x6e, 0xee, 0x9e, 0x1c, 0xbe, 0x18, 0xb6, 0x14, 0xae, 0xd8, 0x1b, 0x3e,
+	0x1d, 0x9e, 0x0c, 0xf7, 0x5a, 0x2a, 0xfe, 0x9b, 0x02, 0x3a, 0x3f, 0x8b, 0x45, 0xbf, 0x5a, 0xd0,
+	0x90, 0xa1, 0x56, 0x7a, 0xff, 0x07, 0x15, 0x91, 0x01, 0x9b, 0xf1, 0xad, 0xa8, 0x52, 0x18, 0x2d,
+	0x51, 0x3b, 0x3d, 0x40, 0x55, 0x6a, 0x62, 0x01, 0x7a, 0x90, 0x64, 0x8d, 0x26, 0xb2, 0x66, 0x99,
+	0x3b, 0x89, 0x57, 0x48, 0x1e, 0xfc, 0x20, 0x09, 0x3f, 0x8d, 0xb8, 0x92, 0x3d, 0x4a, 0x3e, 0x7c,
+	0xd4, 0x00, 0xcd, 0x3c, 0xfa, 0x55, 0x6b, 0xf2, 0xb8, 0x01, 0xb5, 0xaf, 0x16, 0x34, 0xb8, 0xc4,
+	0x87, 0xb0, 0xc2, 0xfd, 0x3f, 0x75, 0x43, 0x86, 0x3e, 0x81, 0x9a, 0x40, 0x34, 0x14, 0x71, 0x83,
+	0x37, 0x96, 0xa3, 0x88, 0xf4, 0x9c, 0x4f, 0xe6, 0x33, 0x12, 0x9d, 0x51, 0xb3, 0xa2, 0x05, 0xf6,
+	0xe0, 0xd6, 0x33, 0x99, 0x0f, 0xcf, 0x45, 0x4a, 0xc5, 0x24, 0x15, 0x8b, 0x24, 0x9b, 0x48, 0x6a,
+	0x21, 0x91, 0x3e, 0x85, 0xd6, 0x64, 0x11, 0x04, 0xd4, 0x63, 0xaf, 0x13, 0x9b, 0xa8, 0x2a, 0x36,
+	0xa4, 0x3c, 0xc6, 0xc0, 0x36, 0x34, 0xaf, 0xc7, 0xf9, 0x09, 0x54, 0x79, 0xbc, 0x02, 0xa3, 0xf4,
+	0x38, 0x42, 0xcd, 0xb3, 0x3e, 0xa4, 0x6c, 0x9c, 0x5e, 0xc0, 0x8a, 0x95, 0x0a, 0xf0, 0x2f, 0x40,
+	0x37, 0x17, 0xec, 0x4d, 0x8c, 0x91, 0x14, 0x9d, 0x92, 0x2d, 0xba, 0x6b, 0x4e, 0x84, 0xff, 0xa4,
+	0x40, 0x63, 0xb4, 0x6f, 0xee, 0x1f, 0xee, 0x1d, 0x67, 0x22, 0x5c, 0x13, 0x11, 0xae, 0x83, 0x3a,
+	0x3f, 0x97, 0x59, 0xa9, 0xce, 0xcf, 0x51, 0x0f, 0x36, 0x08, 0x63, 0x34, 0x8c, 0x3a, 0xcf, 0xc9,
+	0xe5, 0x3c, 0x6e, 0x09, 0x45, 0x31, 0xda, 0x83, 0x26, 0x59, 0xb0, 0x37, 0xd4, 0x63, 0xee, 0x84,
+	0x30, 0x3f, 0x10, 0x49, 0xa5, 0xef, 0x74, 0x72, 0x87, 0xe4, 0x98, 0x66, 0xd6, 0xca, 0xca, 0x6f,
+	0x42, 0x08, 0xaa, 0x1e, 0x6f, 0x2d, 0x51, 0x07, 0x11, 0xdf, 0xf8, 0x01, 0xe8, 0x32, 0xdc, 0x5f,
+	0xd2, 0xcb, 0x10, 0xf5, 0xa0, 0x7a, 0x4e, 0x2f, 0xe3, 0x9c, 0xb8, 0x59, 0xac, 0x4e, 0x6e, 0x67,
+	0x09, 0x0b, 0x3c, 0x83, 0x1b, 0x4b, 0x80, 0x68, 0x13, 0xea, 0xa6, 0xf9, 0xe4, 0xf9, 0xe1, 0x9e,
+	0x3c, 0xb5, 0x5c, 0x71, 0xd2, 0xc7, 0xae, 0xe3, 0xed, 0x8a, 0x82, 0xe0, 0x04, 0x34, 0xad, 0x54,
+	0x80, 0x30, 0xac, 0xed, 0x4e, 0x7d, 0x8f, 0xbe, 0x24, 0x81, 0xe7, 0x7a, 0x8e, 0xbc, 0x95, 0x9c,
+	0x0c, 0x77, 0xa1, 0x3e, 0xda, 0x37, 0xc7, 0xa3, 0x31, 0xc7, 0x98, 0xf9, 0xa7, 0xee, 0x94, 0xca,
+	0x4b, 0x91, 0x2b, 0xfc, 0xa5, 0x20, 0xfe, 0xe4, 0xf8, 0xe4, 0x59, 0x49, 0x4f, 0xe8, 0x82, 0x7e,
+	0x4a, 0x26, 0xe7, 0x8b, 0xf9, 0xae, 0x6f, 0x53, 0xde, 0xae, 0xb5, 0xde, 0xaa, 0x95, 0x15, 0xe1,
+	0xbf, 0x28, 0xa0, 0x8d, 0xf6, 0x4d, 0xf4, 0x09, 0x68, 0xe3, 0xd1, 0x58, 0xec, 0xd5, 0x77, 0x3e,
+	0x2a, 0x12, 0x30, 0x1e, 0x8d, 0x0f, 0x2a, 0x16, 0xb7, 0x40, 0xdb, 0x50, 0xe5, 0x60, 0x32, 0xdf,
+	0x96, 0xa8, 0xe2, 0xba, 0x83, 0x8a, 0x25, 0x6c, 0xb8, 0x2d, 0x27, 0xcb, 0xd0, 0xca, 0x6d, 0xb9,
+	0x8e, 0xdb, 0xf2, 0xff, 0xd1, 0xe7, 0xb0, 0x12, 0x5f, 0x87, 0xbc, 0x66, 0xa3, 0xcc, 0x9e, 0xeb,
+	0x0f, 0x2a, 0x56, 0x62, 0xfb, 0xb8, 0x26, 0xe2, 0xc7, 0x43, 0xd8, 0xe0, 0x6d, 0x94, 0x3a, 0x6e,
+	0xc8, 0x02, 0x91, 0x41, 0x4b, 0x95, 0x22, 0x3b, 0xb0, 0x7a, 0x4d, 0x07, 0xc6, 0x5f, 0xc2, 0xc6,
+	0x0b, 0x32, 0x75, 0xb3, 0x05, 0x57, 0x5e, 0x0c, 0xa2, 0x3b, 0x9c, 0x53, 0x4f, 0x56, 0x42, 0xb4,
+	0xc0, 0x27, 0xd0, 0xdc, 0x0d, 0x68, 0x66, 0x73, 0x5c, 0x9d, 0xca, 0x7b, 0xab, 0x33, 0xa0, 0x13,
+	0x32, 0x67, 0x93, 0x37, 0x44, 0x7a, 0x4c, 0x05, 0xf8, 0x15, 0xe8, 0xbc, 0x75, 0x65, 0x02, 0x9a,
+	0xba, 0x33, 0x97, 0x09, 0xa7, 0x35, 0x2b, 0x5a, 0xa0, 0xbb, 0x00, 0x73, 0xe2, 0xd0, 0xd7, 0x21,
+	0x23, 0x01, 0x8b, 0x7d, 0x70, 0xc9, 0x98, 0x0b, 0x78, 0xfa, 0xf8, 0x67, 0x67, 0x21, 0x65, 0xe2,
+	0x32, 0x34, 0x4b, 0xae, 0xf0, 0x3d, 0xb8, 0xb5, 0xef, 0x07, 0x8e, 0x9f, 0x74, 0x9c, 0x6b, 0x8f,
+	0x8d, 0x7f, 0x03, 0x37, 0x2d, 0x1a, 0xd2, 0xef, 0x67, 0x5d, 0x4e, 0x52, 0xae, 0x8f, 0x68, 0x85,
+	0x3e, 0xf2, 0x0d, 0xac, 0x1d, 0x07, 0x0e, 0xf1, 0xdc, 0xb0, 0xfc, 0x0e, 0xe3, 0x5a, 0x56, 0xd3,
+	0x5a, 0xe6, 0xc5, 0x1b, 0xf8, 0x53, 0x2a, 0xc7, 0x4a, 0x3e, 0xcb, 0x8e, 0x03, 0xc7, 0xf2, 0xa7,
+	0xd4, 0x12, 0x16, 0xf9, 0x67, 0x43, 0xb5, 0xf0, 0x6c, 0xc0, 0x5f, 0x40, 0xe3, 0x38, 0x70, 0xc4,
+	0x90, 0xb8, 0x07, 0x55, 0x3f, 0x70, 0xe2, 0x7e, 0x70, 0xa7, 0xe8, 0x32, 0x89, 0xcf, 0x12, 0x66,
+	0xf8, 0x63, 0x68, 0x45, 0xd7, 0xce, 0xe1, 0x24, 0x23, 0x71, 0xa4, 0x4a, 0xa6, 0xeb, 0x6c, 0x01,
+	0x64, 0x2c, 0x0a, 0x67, 0xc3, 0x7f, 0x56, 0x60, 0xf5, 0x38, 0x70, 0x46, 0x74, 0x76, 0x4a, 0x45,
+	0x4f, 0xe1, 0x78, 0x87, 0xb1, 0x85, 0x5c, 0xa5, 0x4c, 0xab, 0x85, 0x07, 0xd1, 0xff, 0xf8, 0xc0,
+	0x8a, 0xd9, 0xab, 0x7d, 0x18, 0x7b, 0xc5, 0x47, 0x17, 0x36, 0xa1, 0x99, 0x04, 0x2f, 0x38, 0xfc,
+	0x0c, 0x1a, 0x33, 0xb1, 0x8a, 0x69, 0xdc, 0x2c, 0xfa, 0x8e, 0x8c, 0xad, 0xd8, 0x0c, 0x7f, 0x03,
+	0xad, 0x54, 0x7a, 0xc5, 0xb8, 0x4b, 0x69, 0x51, 0xcb, 0x69, 0xd1, 0xb2, 0xb4, 0xc4, 0x87, 0xab,
+	0xbe, 0xef, 0x70, 0x78, 0x08, 0x37, 0x22, 0xe0, 0xf0, 0x8d, 0x3b, 0x8f, 0xc1, 0xaf, 0xba, 0x83,
+	0xcc, 0x3b, 0x4d, 0xcd, 0xbd, 0xd3, 0xf0, 0x33, 0x80, 0xd4, 0x4d, 0xd6, 0x4e, 0xc9, 0xd9, 0x25,
+	0x81, 0xa9, 0xef, 0x0d, 0xac, 0x01, 0xb5, 0xe1, 0x6c, 0xce, 0x2e, 0xb7, 0x7f, 0x0a, 0x0d, 0xa9,
+	0xe1, 0xef, 0xa0, 0x17, 0x87, 0xc3, 0x97, 0x43, 0x2b, 0x7a, 0xc5, 0x9d, 0x58, 0xe6, 0xde, 0xd0,
+	0x6a, 0x29, 0x68, 0x15, 0x6a, 0xc7, 0x2f, 0x8f, 0x86, 0x56, 0x4b, 0xdd, 0xf9, 0x67, 0x33, 0x7a,
+	0xc3, 0x8d, 0x69, 0x70, 0xe1, 0x4e, 0x28, 0x7a, 0x0e, 0xf5, 0x28, 0x45, 0x51, 0x3b, 0x07, 0x96,
+	0x6b, 0x57, 0xed, 0xe5, 0x06, 0x85, 0xb7, 0x7e, 0xff, 0xf7, 0x7f, 0xff, 0x51, 0xdd, 0xc4, 0x37,
+	0x06, 0x17, 0x3f, 0x1b, 0xf0, 0xc1, 0x3a, 0x08, 0x44, 0x93, 0xa5, 0xc1, 0x43, 0x65, 0x1b, 0xb9,
+	0x69, 0xbf, 0x34, 0xe5, 0xd1, 0xb6, 0x72, 0x3e, 0x0a, 0xdd, 0xb4, 0x8d, 0x72, 0x5a, 0x71, 0x32,
+	0xfc, 0x63, 0x01, 0xd1, 0xc1, 0x77, 0x12, 0x88, 0x0b, 0xb9, 0xeb, 0xb5, 0xe4, 0x8b, 0x43, 0x9d,
+	0x43, 0x7d, 0x4f, 0x3c, 0xd3, 0x91, 0x71, 0xd5, 0xcb, 0xb1, 0xd4, 0xfb, 0x7d, 0xe1, 0xfd, 0xde,
+	0xf6, 0x6d, 0xe1, 0x7d, 0x20, 0x54, 0x83, 0x6f, 0x5d, 0xfb, 0xb7, 0x83, 0xe8, 0xd5, 0xff, 0x0a,
+	0xe1, 0x26, 0x57, 0xcd, 0xa8, 0x14, 0x70, 0xb0, 0xa7, 0xa0, 0x3d, 0xa1, 0xec, 0x1a, 0xa4, 0x12,
+	0xa6, 0x0c, 0x01, 0x84, 0x50, 0xab, 0x08, 0x84, 0x9e, 0x42, 0x55, 0x94, 0x44, 0xde, 0x5d, 0xa6,
+	0xa7, 0xb7, 0x6f, 0x2d, 0xb9, 0xe3, 0x5a, 0xfc, 0x91, 0x70, 0xd9, 0x44, 0x7a, 0xc6, 0x25, 0xfa,
+	0x9d, 0x02, 0xfa, 0x38, 0x6d, 0xc1, 0x08, 0xe7, 0xf6, 0x96, 0xbe, 0x4e, 0x4b, 0x89, 0x79, 0x28,
+	0x9c, 0xff, 0x1c, 0xdf, 0xc9, 0x38, 0x8f, 0x88, 0x89, 0xdb, 0xf1, 0x43, 0x65, 0xfb, 0xd5, 0x4d,
+	0xbc, 0x21, 0xd9, 0xc9, 0x48, 0x11, 0x81, 0x7a, 0x04, 0x50, 0x48, 0xa7, 0x3c, 0x6a, 0x09, 0x49,
+	0x3d, 0x01, 0x8a, 0xf1, 0x12, 0x49, 0x1c, 0x4b, 0xc7, 0xf5, 0x08, 0x8b, 0x43, 0x3c, 0x02, 0x75,
+	0x44, 0x51, 0x49, 0xe0, 0x65, 0x6e, 0xd7, 0x85, 0xdb, 0x15, 0x24, 0xf7, 0x23, 0x07, 0xd6, 0xf3,
+	0x63, 0xad, 0xc0, 0x52, 0xe9, 0xcc, 0x2b, 0x65, 0xe9, 0xae, 0xf0, 0x7c, 0x1b, 0x23, 0xee, 0xf9,
+	0x4c, 0x6c, 0xcb, 0x12, 0x61, 0x43, 0x33, 0x37, 0x10, 0xd1, 0x0f, 0x73, 0x3e, 0xca, 0x86, 0x65,
+	0x29, 0x4c, 0xae, 0xcc, 0x02, 0xbe, 0x2b, 0x8b, 0xf2, 0x6b, 0x58, 0x4d, 0x06, 0x0c, 0xba, 0x5b,
+	0x52, 0xc0, 0xe9, 0x58, 0x69, 0x5f, 0x3d, 0xad, 0xf0, 0xa6, 0x00, 0x69, 0x61, 0x5d, 0xde, 0x28,
+	0x1f, 0x5e, 0xdc, 0xfd, 0x21, 0xac, 0xf0, 0x74, 0x3b, 0x0e, 0x9c, 0xb0, 0x94, 0xf0, 0xa5, 0xfe,
+	0xb4, 0x9c, 0x9c, 0xd2, 0x1b, 0xb2, 0x61, 0x5d, 0xba, 0x92, 0x7d, 0x10, 0xdd, 0x2e, 0x6e, 0x8e,
+	0x03, 0x6d, 0x97, 0xcf, 0x03, 0xe1, 0x5b, 0xb2, 0x8e, 0x6e, 0x71, 0xdf, 0xdc, 0x71, 0x94, 0x98,
+	0x72, 0x52, 0x20, 0x07, 0xd6, 0x4c, 0xdb, 0x4e, 0xb6, 0x14, 0x28, 0x29, 0x0e, 0x91, 0xf6, 0x15,
+	0x93, 0x07, 0x77, 0x05, 0x4a, 0x1b, 0x97, 0xa3, 0x70, 0x66, 0x42, 0xd8, 0x88, 0x52, 0xfa, 0xff,
+	0xc6, 0xfa, 0x54, 0x60, 0xfd, 0x08, 0x77, 0x4a, 0xb1, 0x06, 0xdf, 0x46, 0xa3, 0x85, 0x97, 0x01,
+	0x9a, 0xc2, 0x86, 0x45, 0x67, 0xfe, 0xc5, 0xf7, 0x07, 0x2d, 0xcb, 0xa8, 0x8f, 0x05, 0x60, 0x77,
+	0xfb, 0x3d, 0x80, 0xe8, 0x3e, 0x54, 0xf7, 0x5d, 0xcf, 0xfe, 0xa0, 0x5e, 0x87, 0x3e, 0x87, 0x9a,
+	0x39, 0xa3, 0x9e, 0xfd, 0x81, 0xe5, 0x8f, 0x86, 0xb9, 0xf9, 0x98, 0xff, 0x43, 0x6e, 0x69, 0xfe,
+	0xb6, 0x6f, 0x5f, 0xa1, 0x7f, 0x7c, 0xf0, 0xd7, 0xb7, 0x1d, 0xe5, 0xbb, 0xb7, 0x1d, 0xe5, 0x5f,
+	0x6f, 0x3b, 0xca, 0x1f, 0xde, 0x75, 0x2a, 0xdf, 0xbd, 0xeb, 0x54, 0xfe, 0xf1, 0xae, 0x53, 0x79,
+	0xd5, 0x9f, 0xcf, 0xfa, 0x6c, 0x72, 0xf6, 0x75, 0x7f, 0xe2, 0xcf, 0xfa, 0x64, 0x31, 0x08, 0xfd,
+	0x45, 0x30, 0xa1, 0x03, 0xe1, 0x47, 0xfc, 0xe6, 0x35, 0x3f, 0x8d, 0x9a, 0xce, 0x23, 0xf1, 0xef,
+	0x69, 0x5d, 0xfc, 0xd6, 0x75, 0xff, 0xbf, 0x03, 0x00, 0xde, 0xe2, 0x4b, 0xc2, 0x31, 0x13, 0x00,
+	0x00,
+}
+
+func (m *User) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1662,34 +1978,115 @@ func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Mfa != nil {
+		{
+			size, err := m.Mfa.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUsers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintUsers(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintUsers(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsers(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Password) > 0 {
 		i -= len(m.Password)
 		copy(dAtA[i:], m.Password)
 		i = encodeVarintUsers(dAtA, i, uint64(len(m.Password)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
+	}
+	if m.DeletedAt != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.DeletedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
 	}
 	if len(m.Email) > 0 {
 		i -= len(m.Email)
 		copy(dAtA[i:], m.Email)
 		i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
 		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFAFIDO) Marshal() (dAtA []byte, err error) {
+func (m *UserRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1699,39 +2096,148 @@ func (m *MFAFIDO) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MFAFIDO) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MFAFIDO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Authenticator != nil {
+	if m.Query != nil {
 		{
-			size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
+			size := m.Query.Size()
 			i -= size
-			i = encodeVarintUsers(dAtA, i, uint64(size))
+			if _, err := m.Query.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
-	if len(m.AttestationType) > 0 {
-		i -= len(m.AttestationType)
-		copy(dAtA[i:], m.AttestationType)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.AttestationType)))
+	return len(dAtA) - i, nil
+}
+
+func (m *UserRequest_Id) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRequest_Id) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Id)
+	copy(dAtA[i:], m.Id)
+	i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *UserRequest_Email) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRequest_Email) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Email)
+	copy(dAtA[i:], m.Email)
+	i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *UserRequest_Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRequest_Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Account)
+	copy(dAtA[i:], m.Account)
+	i = encodeVarintUsers(dAtA, i, uint64(len(m.Account)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *UserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PasswordUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PasswordUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PasswordUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentPassword) > 0 {
+		i -= len(m.CurrentPassword)
+		copy(dAtA[i:], m.CurrentPassword)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.CurrentPassword)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Pk) > 0 {
-		i -= len(m.Pk)
-		copy(dAtA[i:], m.Pk)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Pk)))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Password)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1745,7 +2251,7 @@ func (m *MFAFIDO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FIDOAuthenticator) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1755,19 +2261,19 @@ func (m *FIDOAuthenticator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FIDOAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FIDOAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CloneWarning {
+	if m.SetStatus {
 		i--
-		if m.CloneWarning {
+		if m.SetStatus {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1775,22 +2281,29 @@ func (m *FIDOAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.SignCount != 0 {
-		i = encodeVarintUsers(dAtA, i, uint64(m.SignCount))
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUsers(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.AAGUID) > 0 {
-		i -= len(m.AAGUID)
-		copy(dAtA[i:], m.AAGUID)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.AAGUID)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFASMS) Marshal() (dAtA []byte, err error) {
+func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1800,27 +2313,34 @@ func (m *MFASMS) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MFASMS) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MFASMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Mobile) > 0 {
-		i -= len(m.Mobile)
-		copy(dAtA[i:], m.Mobile)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Mobile)))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFATOTP) Marshal() (dAtA []byte, err error) {
+func (m *MFAFIDO) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1830,36 +2350,60 @@ func (m *MFATOTP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MFATOTP) MarshalTo(dAtA []byte) (int, error) {
+func (m *MFAFIDO) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MFATOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MFAFIDO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BackupCodes) > 0 {
-		for iNdEx := len(m.BackupCodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BackupCodes[iNdEx])
-			copy(dAtA[i:], m.BackupCodes[iNdEx])
-			i = encodeVarintUsers(dAtA, i, uint64(len(m.BackupCodes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Authenticator != nil {
+		{
+			size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUsers(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintUsers(dAtA, i, uint64(len(m.Key)))
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pk) > 0 {
+		i -= len(m.Pk)
+		copy(dAtA[i:], m.Pk)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Pk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFA) Marshal() (dAtA []byte, err error) {
+func (m *MFAFIDOKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1869,36 +2413,187 @@ func (m *MFA) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MFA) MarshalTo(dAtA []byte) (int, error) {
+func (m *MFAFIDOKeys) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MFA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MFAFIDOKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MFA != nil {
-		{
-			size := m.MFA.Size()
-			i -= size
-			if _, err := m.MFA.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsers(dAtA, i, uint64(size))
 			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MFA_SMS) MarshalTo(dAtA []byte) (int, error) {
+func (m *FIDOAuthenticator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MFA_SMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SMS != nil {
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FIDOAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FIDOAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloneWarning {
+		i--
+		if m.CloneWarning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignCount != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.SignCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AAGUID) > 0 {
+		i -= len(m.AAGUID)
+		copy(dAtA[i:], m.AAGUID)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.AAGUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MFASMS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MFASMS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFASMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mobile) > 0 {
+		i -= len(m.Mobile)
+		copy(dAtA[i:], m.Mobile)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Mobile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MFATOTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MFATOTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFATOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackupCodes) > 0 {
+		for iNdEx := len(m.BackupCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BackupCodes[iNdEx])
+			copy(dAtA[i:], m.BackupCodes[iNdEx])
+			i = encodeVarintUsers(dAtA, i, uint64(len(m.BackupCodes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MFA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MFA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MFA != nil {
+		{
+			size := m.MFA.Size()
+			i -= size
+			if _, err := m.MFA.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MFA_SMS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFA_SMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SMS != nil {
 		{
 			size, err := m.SMS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -1954,6 +2649,27 @@ func (m *MFA_FIDO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MFA_FIDOKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFA_FIDOKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FIDOKeys != nil {
+		{
+			size, err := m.FIDOKeys.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUsers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MFARegistration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2189,7 +2905,7 @@ func (m *ResetPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Empty) Marshal() (dAtA []byte, err error) {
+func (m *Organisation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2199,178 +2915,541 @@ func (m *Empty) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+func (m *Organisation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Organisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintUsers(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUsers(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrgList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OrgList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	if len(m.Orgs) > 0 {
+		for iNdEx := len(m.Orgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovUsers(uint64(m.Status))
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateOrgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CreateOrgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateOrgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *OrgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *OrgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovUsers(uint64(m.CreatedAt))
+	return len(dAtA) - i, nil
+}
+
+func (m *OrgMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovUsers(uint64(m.UpdatedAt))
+	return dAtA[:n], nil
+}
+
+func (m *OrgMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.DeletedAt != 0 {
-		n += 1 + sovUsers(uint64(m.DeletedAt))
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovUsers(uint64(len(v)))
-			}
-			mapEntrySize := 1 + len(k) + sovUsers(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovUsers(uint64(mapEntrySize))
-		}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Mfa != nil {
-		l = m.Mfa.Size()
-		n += 1 + l + sovUsers(uint64(l))
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrgMemberList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgMemberList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgMemberList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Query != nil {
-		n += m.Query.Size()
-	}
-	if m.Status != 0 {
-		n += 1 + sovUsers(uint64(m.Status))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserRequest_Id) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrgMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	n += 1 + l + sovUsers(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
-func (m *UserRequest_Email) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Email)
-	n += 1 + l + sovUsers(uint64(l))
-	return n
+
+func (m *OrgMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *UserRequest_Account) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OrgMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	n += 1 + l + sovUsers(uint64(l))
-	return n
-}
-func (m *UserList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovUsers(uint64(l))
-		}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Total != 0 {
-		n += 1 + sovUsers(uint64(m.Total))
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PasswordUpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MembershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MembershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MembershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CurrentPassword)
-	if l > 0 {
-		n += 1 + l + sovUsers(uint64(l))
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Membership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Membership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Membership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUsers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUsers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovUsers(uint64(m.Status))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovUsers(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovUsers(uint64(m.UpdatedAt))
+	}
+	if m.DeletedAt != 0 {
+		n += 1 + sovUsers(uint64(m.DeletedAt))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovUsers(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovUsers(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovUsers(uint64(mapEntrySize))
+		}
+	}
+	if m.Mfa != nil {
+		l = m.Mfa.Size()
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
+
+func (m *UserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		n += m.Query.Size()
+	}
+	if m.Status != 0 {
+		n += 1 + sovUsers(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *UserRequest_Id) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	n += 1 + l + sovUsers(uint64(l))
+	return n
+}
+func (m *UserRequest_Email) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	n += 1 + l + sovUsers(uint64(l))
+	return n
+}
+func (m *UserRequest_Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	n += 1 + l + sovUsers(uint64(l))
+	return n
+}
+func (m *UserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovUsers(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovUsers(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *PasswordUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.CurrentPassword)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2382,6 +3461,9 @@ func (m *UpdateRequest) Size() (n int) {
 		l = m.User.Size()
 		n += 1 + l + sovUsers(uint64(l))
 	}
+	if m.SetStatus {
+		n += 2
+	}
 	return n
 }
 
@@ -2424,6 +3506,25 @@ func (m *MFAFIDO) Size() (n int) {
 		l = m.Authenticator.Size()
 		n += 1 + l + sovUsers(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
+
+func (m *MFAFIDOKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovUsers(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2526,6 +3627,18 @@ func (m *MFA_FIDO) Size() (n int) {
 	}
 	return n
 }
+func (m *MFA_FIDOKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FIDOKeys != nil {
+		l = m.FIDOKeys.Size()
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
 func (m *MFARegistration) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2627,25 +3740,1358 @@ func (m *ResetPasswordRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUsers(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *Organisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovUsers(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *OrgList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orgs) > 0 {
+		for _, e := range m.Orgs {
+			l = e.Size()
+			n += 1 + l + sovUsers(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateOrgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
+
+func (m *OrgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
+
+func (m *OrgMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovUsers(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *OrgMemberList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovUsers(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrgMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MembershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	return n
+}
+
+func (m *Membership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovUsers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUsers(x uint64) (n int) {
+	return sovUsers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= User_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			m.DeletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUsers
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsers
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthUsers
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthUsers
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsers
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthUsers
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthUsers
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipUsers(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthUsers
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mfa", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mfa == nil {
+				m.Mfa = &MFA{}
+			}
+			if err := m.Mfa.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = &UserRequest_Id{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = &UserRequest_Email{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UserRequest_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = &UserRequest_Account{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PasswordUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PasswordUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PasswordUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetStatus = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Empty) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovUsers(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozUsers(x uint64) (n int) {
-	return sovUsers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2668,17 +5114,17 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFAFIDO: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFAFIDO: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -2688,29 +5134,31 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -2720,14 +5168,29 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= User_Status(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pk = append(m.Pk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pk == nil {
+				m.Pk = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2755,11 +5218,47 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authenticator == nil {
+				m.Authenticator = &FIDOAuthenticator{}
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2787,13 +5286,153 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MFAFIDOKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MFAFIDOKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MFAFIDOKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &MFAFIDO{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FIDOAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FIDOAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AAGUID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -2803,48 +5442,31 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.AAGUID = append(m.AAGUID[:0], dAtA[iNdEx:postIndex]...)
+			if m.AAGUID == nil {
+				m.AAGUID = []byte{}
 			}
-		case 7:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
 			}
-			m.UpdatedAt = 0
+			m.SignCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -2854,16 +5476,16 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				m.SignCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneWarning", wireType)
 			}
-			m.DeletedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -2873,48 +5495,70 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeletedAt |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.CloneWarning = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthUsers
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MFASMS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MFASMS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MFASMS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mobile", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -2924,125 +5568,82 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string][]byte)
-			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUsers
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUsers
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthUsers
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthUsers
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUsers
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthUsers
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthUsers
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipUsers(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthUsers
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mobile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MFATOTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MFATOTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MFATOTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mfa", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3052,31 +5653,27 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mfa == nil {
-				m.Mfa = &MFA{}
-			}
-			if err := m.Mfa.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupCodes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3104,7 +5701,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.BackupCodes = append(m.BackupCodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3130,7 +5727,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserRequest) Unmarshal(dAtA []byte) error {
+func (m *MFA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3153,17 +5750,17 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SMS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3173,29 +5770,32 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = &UserRequest_Id{string(dAtA[iNdEx:postIndex])}
+			v := &MFASMS{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.MFA = &MFA_SMS{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3205,29 +5805,32 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = &UserRequest_Email{string(dAtA[iNdEx:postIndex])}
+			v := &MFATOTP{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.MFA = &MFA_TOTP{v}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIDO", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3237,16 +5840,32 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= UserRequest_Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MFAFIDO{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.MFA = &MFA_FIDO{v}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FIDOKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3256,23 +5875,26 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = &UserRequest_Account{string(dAtA[iNdEx:postIndex])}
+			v := &MFAFIDOKeys{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.MFA = &MFA_FIDOKeys{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3298,7 +5920,7 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserList) Unmarshal(dAtA []byte) error {
+func (m *MFARegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3321,17 +5943,17 @@ func (m *UserList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFARegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFARegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3341,31 +5963,29 @@ func (m *UserList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mfa", wireType)
 			}
-			m.Total = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3375,11 +5995,28 @@ func (m *UserList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mfa == nil {
+				m.Mfa = &MFA{}
+			}
+			if err := m.Mfa.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
@@ -3404,7 +6041,7 @@ func (m *UserList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PasswordUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,47 +6064,15 @@ func (m *PasswordUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PasswordUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PasswordUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3495,11 +6100,11 @@ func (m *PasswordUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3527,7 +6132,7 @@ func (m *PasswordUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3553,7 +6158,7 @@ func (m *PasswordUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3576,17 +6181,17 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3596,29 +6201,33 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recaptcha", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3628,27 +6237,23 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recaptcha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3674,7 +6279,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthRequest) Unmarshal(dAtA []byte) error {
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3697,17 +6302,17 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3717,27 +6322,14 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageStart", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3765,8 +6357,27 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.PageStart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
@@ -3791,7 +6402,7 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
+func (m *ForgotPasswordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,17 +6425,17 @@ func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MFAFIDO: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForgotPasswordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MFAFIDO: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForgotPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3834,31 +6445,82 @@ func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetPasswordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetPasswordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3868,29 +6530,27 @@ func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pk = append(m.Pk[:0], dAtA[iNdEx:postIndex]...)
-			if m.Pk == nil {
-				m.Pk = []byte{}
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3918,13 +6578,13 @@ func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -3934,27 +6594,23 @@ func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Authenticator == nil {
-				m.Authenticator = &FIDOAuthenticator{}
-			}
-			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3980,7 +6636,7 @@ func (m *MFAFIDO) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
+func (m *Organisation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3999,21 +6655,53 @@ func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FIDOAuthenticator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FIDOAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Organisation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Organisation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AAGUID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4023,31 +6711,29 @@ func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AAGUID = append(m.AAGUID[:0], dAtA[iNdEx:postIndex]...)
-			if m.AAGUID == nil {
-				m.AAGUID = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.SignCount = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4057,16 +6743,16 @@ func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignCount |= uint32(b&0x7F) << shift
+				m.Role |= OrgRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloneWarning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4076,12 +6762,11 @@ func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CloneWarning = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
@@ -4106,7 +6791,7 @@ func (m *FIDOAuthenticator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MFASMS) Unmarshal(dAtA []byte) error {
+func (m *OrgList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4129,17 +6814,17 @@ func (m *MFASMS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MFASMS: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MFASMS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mobile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4149,23 +6834,25 @@ func (m *MFASMS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mobile = string(dAtA[iNdEx:postIndex])
+			m.Orgs = append(m.Orgs, &Organisation{})
+			if err := m.Orgs[len(m.Orgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4191,7 +6878,7 @@ func (m *MFASMS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MFATOTP) Unmarshal(dAtA []byte) error {
+func (m *CreateOrgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4214,47 +6901,15 @@ func (m *MFATOTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MFATOTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateOrgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MFATOTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateOrgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupCodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4282,7 +6937,7 @@ func (m *MFATOTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackupCodes = append(m.BackupCodes, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4308,7 +6963,7 @@ func (m *MFATOTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MFA) Unmarshal(dAtA []byte) error {
+func (m *OrgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4331,87 +6986,17 @@ func (m *MFA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MFA: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MFA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MFASMS{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.MFA = &MFA_SMS{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TOTP", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MFATOTP{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.MFA = &MFA_TOTP{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FIDO", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4421,26 +7006,23 @@ func (m *MFA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MFAFIDO{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.MFA = &MFA_FIDO{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4466,7 +7048,7 @@ func (m *MFA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MFARegistration) Unmarshal(dAtA []byte) error {
+func (m *OrgMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4489,15 +7071,15 @@ func (m *MFARegistration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MFARegistration: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MFARegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4525,13 +7107,13 @@ func (m *MFARegistration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mfa", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4541,84 +7123,27 @@ func (m *MFARegistration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUsers
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUsers
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Mfa == nil {
-				m.Mfa = &MFA{}
-			}
-			if err := m.Mfa.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUsers(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUsers
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4646,11 +7171,11 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.FirstName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4678,8 +7203,46 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.LastName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= OrgRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
@@ -4704,7 +7267,7 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+func (m *OrgMemberList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4727,15 +7290,15 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgMemberList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgMemberList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4762,45 +7325,11 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &OrgMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recaptcha", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recaptcha = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
@@ -4825,7 +7354,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
+func (m *OrgMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4848,17 +7377,17 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4868,14 +7397,27 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4903,13 +7445,45 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageStart = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.Offset = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -4919,7 +7493,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				m.Role |= OrgRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4948,7 +7522,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForgotPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *MembershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4971,15 +7545,15 @@ func (m *ForgotPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForgotPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MembershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForgotPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MembershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5007,7 +7581,39 @@ func (m *ForgotPasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5033,7 +7639,7 @@ func (m *ForgotPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *Membership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,15 +7662,15 @@ func (m *ResetPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Membership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Membership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5092,45 +7698,13 @@ func (m *ResetPasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUsers
@@ -5140,24 +7714,11 @@ func (m *ResetPasswordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= OrgRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUsers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
@@ -29,9 +29,16 @@ type UserServiceClient interface {
 	Me(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*User, error)
 	ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*Empty, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*Organisation, error)
+	ListOrgs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OrgList, error)
+	ListOrgMembers(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*OrgMemberList, error)
+	AddOrgMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error)
+	UpdateOrgMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error)
+	RemoveOrgMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*Empty, error)
 	//Internal
 	Find(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error)
 	Amend(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*User, error)
+	Membership(ctx context.Context, in *MembershipRequest, opts ...grpc.CallOption) (*Membership, error)
 }
 
 type userServiceClient struct {
@@ -132,6 +139,60 @@ func (c *userServiceClient) ResetPassword(ctx context.Context, in *ResetPassword
 	return out, nil
 }
 
+func (c *userServiceClient) CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*Organisation, error) {
+	out := new(Organisation)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/CreateOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListOrgs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OrgList, error) {
+	out := new(OrgList)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/ListOrgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListOrgMembers(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*OrgMemberList, error) {
+	out := new(OrgMemberList)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/ListOrgMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddOrgMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error) {
+	out := new(OrgMember)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/AddOrgMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateOrgMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error) {
+	out := new(OrgMember)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/UpdateOrgMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveOrgMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/RemoveOrgMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) Find(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, "/ataas.users.UserService/Find", in, out, opts...)
@@ -150,6 +211,15 @@ func (c *userServiceClient) Amend(ctx context.Context, in *UpdateRequest, opts .
 	return out, nil
 }
 
+func (c *userServiceClient) Membership(ctx context.Context, in *MembershipRequest, opts ...grpc.CallOption) (*Membership, error) {
+	out := new(Membership)
+	err := c.cc.Invoke(ctx, "/ataas.users.UserService/Membership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -165,9 +235,16 @@ type UserServiceServer interface {
 	Me(context.Context, *Empty) (*User, error)
 	ForgotPassword(context.Context, *ForgotPasswordRequest) (*Empty, error)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*Empty, error)
+	CreateOrg(context.Context, *CreateOrgRequest) (*Organisation, error)
+	ListOrgs(context.Context, *Empty) (*OrgList, error)
+	ListOrgMembers(context.Context, *OrgRequest) (*OrgMemberList, error)
+	AddOrgMember(context.Context, *OrgMemberRequest) (*OrgMember, error)
+	UpdateOrgMember(context.Context, *OrgMemberRequest) (*OrgMember, error)
+	RemoveOrgMember(context.Context, *OrgMemberRequest) (*Empty, error)
 	//Internal
 	Find(context.Context, *UserRequest) (*User, error)
 	Amend(context.Context, *UpdateRequest) (*User, error)
+	Membership(context.Context, *MembershipRequest) (*Membership, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -205,12 +282,33 @@ func (UnimplementedUserServiceServer) ForgotPassword(context.Context, *ForgotPas
 func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
+func (UnimplementedUserServiceServer) CreateOrg(context.Context, *CreateOrgRequest) (*Organisation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrg not implemented")
+}
+func (UnimplementedUserServiceServer) ListOrgs(context.Context, *Empty) (*OrgList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgs not implemented")
+}
+func (UnimplementedUserServiceServer) ListOrgMembers(context.Context, *OrgRequest) (*OrgMemberList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgMembers not implemented")
+}
+func (UnimplementedUserServiceServer) AddOrgMember(context.Context, *OrgMemberRequest) (*OrgMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrgMember not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateOrgMember(context.Context, *OrgMemberRequest) (*OrgMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrgMember not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveOrgMember(context.Context, *OrgMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
 func (UnimplementedUserServiceServer) Find(context.Context, *UserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
 }
 func (UnimplementedUserServiceServer) Amend(context.Context, *UpdateRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Amend not implemented")
 }
+func (UnimplementedUserServiceServer) Membership(context.Context, *MembershipRequest) (*Membership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Membership not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -404,6 +502,114 @@ func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/CreateOrg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateOrg(ctx, req.(*CreateOrgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOrgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOrgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/ListOrgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOrgs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOrgMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/ListOrgMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOrgMembers(ctx, req.(*OrgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/AddOrgMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddOrgMember(ctx, req.(*OrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/UpdateOrgMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateOrgMember(ctx, req.(*OrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/RemoveOrgMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveOrgMember(ctx, req.(*OrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Find_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserRequest)
 	if err := dec(in); err != nil {
@@ -440,6 +646,24 @@ func _UserService_Amend_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_Membership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Membership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ataas.users.UserService/Membership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Membership(ctx, req.(*MembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +711,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetPassword",
 			Handler:    _UserService_ResetPassword_Handler,
 		},
+		{
+			MethodName: "CreateOrg",
+			Handler:    _UserService_CreateOrg_Handler,
+		},
+		{
+			MethodName: "ListOrgs",
+			Handler:    _UserService_ListOrgs_Handler,
+		},
+		{
+			MethodName: "ListOrgMembers",
+			Handler:    _UserService_ListOrgMembers_Handler,
+		},
+		{
+			MethodName: "AddOrgMember",
+			Handler:    _UserService_AddOrgMember_Handler,
+		},
+		{
+			MethodName: "UpdateOrgMember",
+			Handler:    _UserService_UpdateOrgMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _UserService_RemoveOrgMember_Handler,
+		},
 		{
 			MethodName: "Find",
 			Handler:    _UserService_Find_Handler,
@@ -495,6 +743,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Amend",
 			Handler:    _UserService_Amend_Handler,
 		},
+		{
+			MethodName: "Membership",
+			Handler:    _UserService_Membership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
@@ -6,6 +6,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"pm.tcfw.com.au/source/ataas/api/pb/audit"
 	"pm.tcfw.com.au/source/ataas/api/pb/blocks"
 	"pm.tcfw.com.au/source/ataas/api/pb/excreds"
 	"pm.tcfw.com.au/source/ataas/api/pb/orders"
@@ -21,12 +22,13 @@ func newRouter(ctx context.Context) (*runtime.ServeMux, error) {
 		// runtime.WithMarshalerOption("application/json", &marshalers.JSONMarshaler{}),
 		runtime.WithForwardResponseOption(httpResponseModifier),
 		runtime.WithOutgoingHeaderMatcher(httpHeaderMatch),
+		runtime.WithIncomingHeaderMatcher(httpIncomingHeaderMatch),
 	)
 
 	conn, err := grpc.DialContext(
 		ctx,
 		viper.GetString("grpc.addr"),
-		rpcUtils.InternalClientOptions()...,
+		rpcUtils.GatewayClientOptions()...,
 	)
 	if err != nil {
 		return nil, err
@@ -60,6 +62,10 @@ func newRouter(ctx context.Context) (*runtime.ServeMux, error) {
 		return nil, err
 	}
 
+	if err := registerLocalAudit(ctx, r, conn); err != nil {
+		return nil, err
+	}
+
 	return r, nil
 }
 
@@ -90,3 +96,7 @@ func registerLocalStrategy(ctx context.Context, mux *runtime.ServeMux, conn *grp
 func registerLocalExcreds(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
 	return excreds.RegisterExCredsServiceHandler(ctx, mux, conn)
 }
+
+func registerLocalAudit(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return audit.RegisterAuditServiceHandler(ctx, mux, conn)
+}
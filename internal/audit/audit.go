@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	"pm.tcfw.com.au/source/ataas/db"
+	migrate "pm.tcfw.com.au/source/ataas/internal/audit/db"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+const (
+	tblName = "audit_events"
+
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+var (
+	allColumns = []string{
+		"id",
+		"ts",
+		"actor",
+		"client",
+		"account",
+		"ip",
+		"user_agent",
+		"action",
+		"target",
+		"success",
+		"detail",
+	}
+)
+
+type Server struct {
+	auditAPI.UnimplementedAuditServiceServer
+
+	log *logrus.Logger
+}
+
+func NewServer(ctx context.Context) (*Server, error) {
+	s := &Server{
+		log: logrus.New(),
+	}
+
+	err := s.Migrate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Server) Migrate(ctx context.Context) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	return migrate.Migrate(ctx, conn.Conn(), s.log)
+}
+
+//Record appends an event to the audit log
+func (s *Server) Record(ctx context.Context, req *auditAPI.RecordRequest) (*auditAPI.RecordResponse, error) {
+	ev := req.Event
+	if ev == nil || ev.Action == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing action")
+	}
+
+	ts := time.Now()
+	if ev.Timestamp != "" {
+		if t, err := time.Parse(time.RFC3339Nano, ev.Timestamp); err == nil {
+			ts = t
+		}
+	}
+
+	q := db.Build().Insert(tblName).Columns(allColumns...).Values(
+		uuid.New().String(),
+		ts,
+		ev.Actor,
+		ev.Client,
+		ev.Account,
+		ev.Ip,
+		ev.UserAgent,
+		ev.Action,
+		ev.Target,
+		ev.Success,
+		ev.Detail,
+	)
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return &auditAPI.RecordResponse{}, nil
+}
+
+//ListAuditEvents lists the most recent events performed by or against the current
+//user, such as failed logins to their account
+func (s *Server) ListAuditEvents(ctx context.Context, req *auditAPI.ListRequest) (*auditAPI.EventList, error) {
+	uid, err := passportUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	} else if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	q := db.Build().Select(allColumns...).From(tblName).
+		Where(sq.Or{sq.Eq{"actor": uid}, sq.Eq{"target": uid}}).
+		OrderBy("ts DESC").
+		Limit(uint64(limit))
+
+	if req.Action != "" {
+		q = q.Where(sq.Eq{"action": req.Action})
+	}
+
+	if req.Before != "" {
+		before, err := time.Parse(time.RFC3339Nano, req.Before)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid before timestamp")
+		}
+		q = q.Where(sq.Lt{"ts": before})
+	}
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	list := &auditAPI.EventList{Events: []*auditAPI.Event{}}
+
+	for res.Next() {
+		ev, err := scanEvent(res)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Events = append(list.Events, ev)
+	}
+
+	return list, nil
+}
+
+//ExportAuditEvents streams all events matching the filters, oldest first. Admin only
+func (s *Server) ExportAuditEvents(req *auditAPI.ExportRequest, stream auditAPI.AuditService_ExportAuditEventsServer) error {
+	q := db.Build().Select(allColumns...).From(tblName).OrderBy("ts")
+
+	if req.Actor != "" {
+		q = q.Where(sq.Eq{"actor": req.Actor})
+	}
+	if req.Account != "" {
+		q = q.Where(sq.Eq{"account": req.Account})
+	}
+	if req.Action != "" {
+		q = q.Where(sq.Eq{"action": req.Action})
+	}
+
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339Nano, req.Since)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid since timestamp")
+		}
+		q = q.Where(sq.GtOrEq{"ts": since})
+	}
+	if req.Until != "" {
+		until, err := time.Parse(time.RFC3339Nano, req.Until)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid until timestamp")
+		}
+		q = q.Where(sq.Lt{"ts": until})
+	}
+
+	res, done, err := db.SimpleQuery(stream.Context(), q)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	for res.Next() {
+		ev, err := scanEvent(res)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+
+	return res.Err()
+}
+
+type scannable interface {
+	Scan(...interface{}) error
+}
+
+//scanEvent scans a single row from the audit events table
+func scanEvent(row scannable) (*auditAPI.Event, error) {
+	ev := &auditAPI.Event{}
+	var ts time.Time
+
+	err := row.Scan(
+		&ev.Id,
+		&ts,
+		&ev.Actor,
+		&ev.Client,
+		&ev.Account,
+		&ev.Ip,
+		&ev.UserAgent,
+		&ev.Action,
+		&ev.Target,
+		&ev.Success,
+		&ev.Detail,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ev.Timestamp = ts.Format(time.RFC3339Nano)
+
+	return ev, nil
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_audit_events",
+		time.Date(2021, 7, 21, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS audit_events (
+					id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+					ts TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+					actor STRING NOT NULL DEFAULT '',
+					client STRING NOT NULL DEFAULT '',
+					account STRING NOT NULL DEFAULT '',
+					ip STRING NOT NULL DEFAULT '',
+					user_agent STRING NOT NULL DEFAULT '',
+					action STRING NOT NULL,
+					target STRING NOT NULL DEFAULT '',
+					success BOOL NOT NULL,
+					detail STRING NOT NULL DEFAULT '',
+					INDEX (actor, ts DESC),
+					INDEX (account, ts DESC),
+					INDEX (ts DESC)
+				)
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `DROP TABLE IF EXISTS audit_events`)
+			return err
+		},
+	))
+}
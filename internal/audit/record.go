@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+	rpcUtils "pm.tcfw.com.au/source/ataas/internal/utils/rpc"
+)
+
+//Actions recorded in the audit log
+const (
+	ActionLogin          = "auth.login"
+	ActionLoginFailed    = "auth.login_failed"
+	ActionMFAFailed      = "auth.mfa_failed"
+	ActionMFAChanged     = "auth.mfa_changed"
+	ActionTokenRevoked   = "token.revoke"
+	ActionTokensRevoked  = "token.revoke_all"
+	ActionRefreshReused  = "token.refresh_reused"
+	ActionClientCreated  = "api_client.create"
+	ActionClientRevoked  = "api_client.revoke"
+	ActionExCredsCreated = "excreds.create"
+	ActionExCredsDeleted = "excreds.delete"
+	ActionExCredsRotated = "excreds.rotate"
+	ActionManualAction   = "block.manual_action"
+	ActionManualDecision = "block.manual_decision"
+
+	recordTimeout = 5 * time.Second
+)
+
+var (
+	_auditSvc   auditAPI.AuditServiceClient
+	_auditSvcMu sync.Mutex
+
+	log = logrus.New()
+
+	//accountFromContext resolves the account the request acts on
+	accountFromContext = passportUtils.AccountFromContext
+)
+
+func auditSvc() (auditAPI.AuditServiceClient, error) {
+	_auditSvcMu.Lock()
+	defer _auditSvcMu.Unlock()
+
+	if _auditSvc == nil {
+		auditEndpoint, envExists := os.LookupEnv("AUDIT_HOST")
+		if !envExists {
+			auditEndpoint = viper.GetString("grpc.addr")
+		}
+
+		conn, err := grpc.Dial(auditEndpoint, rpcUtils.InternalClientOptions()...)
+		if err != nil {
+			return nil, err
+		}
+
+		_auditSvc = auditAPI.NewAuditServiceClient(conn)
+	}
+
+	return _auditSvc, nil
+}
+
+//Record appends an event to the audit log. The actor, client, account, IP and
+//user agent are taken from the request in ctx when not already set. Failures are
+//logged rather than returned so the action being audited isn't interrupted
+func Record(ctx context.Context, ev *auditAPI.Event) {
+	fromContext(ctx, ev)
+
+	svc, err := auditSvc()
+	if err != nil {
+		log.WithError(err).WithField("action", ev.Action).Error("failed to record audit event")
+		return
+	}
+
+	//The request may be cancelled once it has responded, which shouldn't lose the event
+	rctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+
+	if _, err := svc.Record(rctx, &auditAPI.RecordRequest{Event: ev}); err != nil {
+		log.WithError(err).WithField("action", ev.Action).Error("failed to record audit event")
+	}
+}
+
+//fromContext fills the details of the caller from the request
+func fromContext(ctx context.Context, ev *auditAPI.Event) {
+	if ev.Timestamp == "" {
+		ev.Timestamp = time.Now().Format(time.RFC3339Nano)
+	}
+
+	if ip := rpcUtils.RemoteIPFromContext(ctx); ip != nil && ev.Ip == "" {
+		ev.Ip = ip.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if ev.UserAgent == "" {
+		ua := md.Get("grpcgateway-user-agent")
+		if len(ua) == 0 {
+			ua = md.Get("user-agent")
+		}
+		if len(ua) > 0 {
+			ev.UserAgent = ua[0]
+		}
+	}
+
+	claims, err := passportUtils.TokenClaimsFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	if sub, ok := claims["sub"].(string); ok && ev.Actor == "" {
+		ev.Actor = sub
+	}
+	if cid, ok := claims["cid"].(string); ok && ev.Client == "" {
+		ev.Client = cid
+	}
+
+	if ev.Account == "" {
+		//the selected account is only trusted once the caller's membership is confirmed
+		if account, err := accountFromContext(ctx); err == nil {
+			ev.Account = account
+		} else if acn, ok := claims["acn"].(string); ok {
+			ev.Account = acn
+		}
+	}
+}
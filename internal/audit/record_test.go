@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+func testContext(t *testing.T, claims jwt.MapClaims, kv ...string) context.Context {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+	require.NoError(t, err)
+
+	md := metadata.Pairs(append([]string{"authorization", token}, kv...)...)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000}})
+}
+
+func stubAccount(t *testing.T, members map[string]bool) {
+	orig := accountFromContext
+	t.Cleanup(func() { accountFromContext = orig })
+
+	accountFromContext = func(ctx context.Context) (string, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if acn := md.Get(passportUtils.AccountHeader); len(acn) > 0 {
+			if !members[acn[0]] {
+				return "", errors.New("not a member of the account")
+			}
+			return acn[0], nil
+		}
+		return "", errors.New("no account selected")
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	stubAccount(t, map[string]bool{"org-1": true})
+
+	ctx := testContext(t,
+		jwt.MapClaims{"sub": "user-1", "acn": "personal"},
+		"grpcgateway-user-agent", "test-agent",
+		"x-forwarded-for", "203.0.113.5, 10.0.0.2",
+		passportUtils.AccountHeader, "org-1",
+	)
+
+	ev := &auditAPI.Event{Action: ActionLogin}
+	fromContext(ctx, ev)
+
+	assert.NotEmpty(t, ev.Timestamp)
+	assert.Equal(t, "203.0.113.5", ev.Ip)
+	assert.Equal(t, "test-agent", ev.UserAgent)
+	assert.Equal(t, "user-1", ev.Actor)
+	assert.Empty(t, ev.Client)
+	assert.Equal(t, "org-1", ev.Account)
+}
+
+func TestFromContextNotMember(t *testing.T) {
+	stubAccount(t, nil)
+
+	ctx := testContext(t,
+		jwt.MapClaims{"sub": "user-1", "acn": "personal"},
+		passportUtils.AccountHeader, "org-1",
+	)
+
+	ev := &auditAPI.Event{Action: ActionLogin}
+	fromContext(ctx, ev)
+
+	assert.Equal(t, "personal", ev.Account, "unconfirmed accounts fall back to the token account")
+}
+
+func TestFromContextClient(t *testing.T) {
+	stubAccount(t, nil)
+
+	ctx := testContext(t,
+		jwt.MapClaims{"sub": "user-1", "acn": "acn-1", "cid": "client-1"},
+		passportUtils.AccountHeader, "org-1",
+	)
+
+	ev := &auditAPI.Event{Action: ActionLogin, Actor: "someone"}
+	fromContext(ctx, ev)
+
+	assert.Equal(t, "10.0.0.1", ev.Ip)
+	assert.Equal(t, "someone", ev.Actor)
+	assert.Equal(t, "client-1", ev.Client)
+	assert.Equal(t, "acn-1", ev.Account, "clients can't select another account")
+}
+
+func TestFromContextAnonymous(t *testing.T) {
+	ev := &auditAPI.Event{Action: ActionLoginFailed, Target: "bob"}
+	fromContext(context.Background(), ev)
+
+	assert.NotEmpty(t, ev.Timestamp)
+	assert.Empty(t, ev.Ip)
+	assert.Empty(t, ev.Actor)
+	assert.Equal(t, "bob", ev.Target)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -18,6 +19,7 @@ import (
 	"pm.tcfw.com.au/source/ataas/internal/broadcast"
 	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
 	"pm.tcfw.com.au/source/ataas/internal/strategies"
+	ticksClient "pm.tcfw.com.au/source/ataas/internal/ticks/client"
 )
 
 const (
@@ -39,6 +41,8 @@ var (
 		"market",
 		"instrument",
 		"account",
+		"created_at",
+		"pending_order",
 	}
 )
 
@@ -125,29 +129,12 @@ func (s *Server) handleAction(data *strategies.ActionEvent) {
 	n := 0
 
 	for res.Next() {
-		block := &blocksAPI.Block{}
-		var blockCurrentUnits int
-		err := res.Scan(
-			&block.Id,
-			&block.StrategyId,
-			&block.State,
-			&block.BaseUnits,
-			&blockCurrentUnits,
-			&block.Purchase,
-			&block.WatchDuration,
-			&block.ShortSellAllowed,
-			&block.BackoutPercentage,
-			&block.Market,
-			&block.Instrument,
-			&block.Account,
-		)
+		block, err := scanBlock(res)
 		if err != nil {
 			s.log.Errorf("failed to scan block [action]: %s", err)
 			continue
 		}
 
-		block.CurrentUnits = float64(blockCurrentUnits) / 1000000
-
 		s.applyCh <- &apply{data.Action, block}
 		n++
 	}
@@ -172,12 +159,26 @@ func (s *Server) New(ctx context.Context, req *blocksAPI.Block) (*blocksAPI.Bloc
 	req.State = blocksAPI.BlockState_NOTHING
 	req.ShortSellAllowed = false
 	req.CurrentUnits = 0
+	req.PendingOrder = ""
+
+	createdAt := time.Now()
+	req.CreatedAt = createdAt.Format(time.RFC3339)
 
 	err = s.validateBlock(req)
 	if err != nil {
 		return nil, err
 	}
 
+	ticks, err := ticksSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ticksClient.RequireCollected(ctx, ticks, req.Market, req.Instrument)
+	if err != nil {
+		return nil, err
+	}
+
 	q := db.Build().Insert(tblName).Columns(allColumns...).Values(
 		req.Id,
 		req.StrategyId,
@@ -191,6 +192,8 @@ func (s *Server) New(ctx context.Context, req *blocksAPI.Block) (*blocksAPI.Bloc
 		req.Market,
 		req.Instrument,
 		req.Account,
+		createdAt,
+		req.PendingOrder,
 	)
 
 	if err := db.SimpleExec(ctx, q); err != nil {
@@ -247,29 +250,11 @@ func (s *Server) List(ctx context.Context, req *blocksAPI.ListRequest) (*blocksA
 	blocks := []*blocksAPI.Block{}
 
 	for res.Next() {
-		block := &blocksAPI.Block{}
-		var blockCurrentUnits int
-
-		err := res.Scan(
-			&block.Id,
-			&block.StrategyId,
-			&block.State,
-			&block.BaseUnits,
-			&blockCurrentUnits,
-			&block.Purchase,
-			&block.WatchDuration,
-			&block.ShortSellAllowed,
-			&block.BackoutPercentage,
-			&block.Market,
-			&block.Instrument,
-			&block.Account,
-		)
+		block, err := scanBlock(res)
 		if err != nil {
 			return nil, err
 		}
 
-		block.CurrentUnits = float64(blockCurrentUnits) / 1000000
-
 		blocks = append(blocks, block)
 	}
 
@@ -294,30 +279,12 @@ func (s *Server) Get(ctx context.Context, req *blocksAPI.GetRequest) (*blocksAPI
 		return nil, status.Error(codes.NotFound, "block not found")
 	}
 
-	block := &blocksAPI.Block{}
-	var blockCurrentUnits int
-
-	err = res.Scan(
-		&block.Id,
-		&block.StrategyId,
-		&block.State,
-		&block.BaseUnits,
-		&blockCurrentUnits,
-		&block.Purchase,
-		&block.WatchDuration,
-		&block.ShortSellAllowed,
-		&block.BackoutPercentage,
-		&block.Market,
-		&block.Instrument,
-		&block.Account,
-	)
+	block, err := scanBlock(res)
 	if err != nil {
 		s.log.Errorf("failed to scan block [get]: %s", err)
 		return nil, err
 	}
 
-	block.CurrentUnits = float64(blockCurrentUnits) / 1000000
-
 	return block, nil
 }
 
@@ -334,58 +301,15 @@ func (s *Server) Find(ctx context.Context, req *blocksAPI.GetRequest) (*blocksAP
 		return nil, status.Error(codes.NotFound, "block not found")
 	}
 
-	block := &blocksAPI.Block{}
-	var blockCurrentUnits int
-
-	err = res.Scan(
-		&block.Id,
-		&block.StrategyId,
-		&block.State,
-		&block.BaseUnits,
-		&blockCurrentUnits,
-		&block.Purchase,
-		&block.WatchDuration,
-		&block.ShortSellAllowed,
-		&block.BackoutPercentage,
-		&block.Market,
-		&block.Instrument,
-		&block.Account,
-	)
+	block, err := scanBlock(res)
 	if err != nil {
 		s.log.Errorf("failed to scan block [find]: %s", err)
 		return nil, err
 	}
 
-	block.CurrentUnits = float64(blockCurrentUnits) / 1000000
-
 	return block, nil
 }
 
-func (s *Server) ManualAction(ctx context.Context, req *blocksAPI.ManualRequest) (*blocksAPI.ManualResponse, error) {
-	block, err := s.Get(ctx, &blocksAPI.GetRequest{Id: req.Id})
-	if err != nil {
-		return nil, err
-	}
-
-	alreadyPurchased := block.State == blocksAPI.BlockState_PURCHASED && req.Action == orders.Action_BUY
-	alreadySold := block.State == blocksAPI.BlockState_SOLD && req.Action == orders.Action_SELL
-	if alreadyPurchased || alreadySold {
-		return nil, status.Errorf(codes.FailedPrecondition, "invalid future state %t %t", alreadyPurchased, alreadySold)
-	}
-
-	var order *orders.Order
-	if req.Action == orders.Action_BUY {
-		order, err = s.applyState(block, blocksAPI.BlockState_PURCHASED, 1)
-	} else {
-		order, err = s.applyState(block, blocksAPI.BlockState_SOLD, 1)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	return &blocksAPI.ManualResponse{Order: order}, nil
-}
-
 func (s *Server) Update(ctx context.Context, req *blocksAPI.UpdateRequest) (*blocksAPI.Block, error) {
 	block, err := s.Get(ctx, &blocksAPI.GetRequest{Id: req.Id})
 	if err != nil {
@@ -415,13 +339,18 @@ func (s *Server) Update(ctx context.Context, req *blocksAPI.UpdateRequest) (*blo
 }
 
 func (s *Server) Delete(ctx context.Context, req *blocksAPI.DeleteRequest) (*blocksAPI.DeleteResponse, error) {
+	uid, err := passportUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	block, err := s.Get(ctx, &blocksAPI.GetRequest{Id: req.Id})
 	if err != nil {
 		return nil, err
 	}
 
 	if block.State == blocksAPI.BlockState_PURCHASED {
-		_, err = s.applyState(block, blocksAPI.BlockState_ENDED, 1)
+		_, err = s.applyState(block, blocksAPI.BlockState_ENDED, 1, uid)
 		if err != nil {
 			return nil, fmt.Errorf("failed to sell before delete: %s", err)
 		}
@@ -441,3 +370,89 @@ func (s *Server) CalcState(ctx context.Context, req *blocksAPI.CalcRequest) (*bl
 
 	return &blocksAPI.CalcResponse{State: d, N: int32(n)}, nil
 }
+
+//ApplyOrder applies the fills of a pending order to its block once the order has
+//settled. Orders the block isn't waiting on are ignored so orders can be applied
+//more than once
+func (s *Server) ApplyOrder(ctx context.Context, order *orders.Order) (*blocksAPI.Block, error) {
+	if isOpen(order) {
+		return nil, status.Error(codes.FailedPrecondition, "order has not settled")
+	}
+
+	block, err := s.Find(ctx, &blocksAPI.GetRequest{Id: order.BlockID})
+	if err != nil {
+		return nil, err
+	}
+
+	if block.PendingOrder != order.Id {
+		return block, nil
+	}
+
+	prev, err := prevState(ctx, block.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.settleOrder(ctx, block, prev, block.State, order); err != nil {
+		s.log.WithError(err).WithField("block", block.Id).Warn("pending order settled without fills")
+	}
+
+	return s.Find(ctx, &blocksAPI.GetRequest{Id: block.Id})
+}
+
+//prevState provides the state a block returns to if its pending order isn't filled
+func prevState(ctx context.Context, id string) (blocksAPI.BlockState, error) {
+	q := db.Build().Select("prev_state").From(tblName).Where(sq.Eq{"id": id})
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return 0, status.Error(codes.NotFound, "block not found")
+	}
+
+	var prev blocksAPI.BlockState
+	if err := res.Scan(&prev); err != nil {
+		return 0, err
+	}
+
+	return prev, nil
+}
+
+type scannable interface {
+	Scan(...interface{}) error
+}
+
+//scanBlock scans a single row from the blocks table
+func scanBlock(row scannable) (*blocksAPI.Block, error) {
+	block := &blocksAPI.Block{}
+	var blockCurrentUnits int
+	var createdAt time.Time
+
+	err := row.Scan(
+		&block.Id,
+		&block.StrategyId,
+		&block.State,
+		&block.BaseUnits,
+		&blockCurrentUnits,
+		&block.Purchase,
+		&block.WatchDuration,
+		&block.ShortSellAllowed,
+		&block.BackoutPercentage,
+		&block.Market,
+		&block.Instrument,
+		&block.Account,
+		&createdAt,
+		&block.PendingOrder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	block.CurrentUnits = float64(blockCurrentUnits) / 1000000
+	block.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return block, nil
+}
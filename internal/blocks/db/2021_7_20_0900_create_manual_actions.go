@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_manual_actions",
+		time.Date(2021, 7, 20, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS manual_actions (
+					id UUID PRIMARY KEY,
+					block_id UUID NOT NULL,
+					account UUID NOT NULL,
+					action INT NOT NULL,
+					status INT NOT NULL DEFAULT 0,
+					requested_by UUID NOT NULL,
+					decided_by STRING NOT NULL DEFAULT '',
+					order_id STRING NOT NULL DEFAULT '',
+					error STRING NOT NULL DEFAULT '',
+					created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+					decided_at TIMESTAMPTZ,
+					INDEX (account, status),
+					INDEX (block_id)
+				)
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"add_blocks_pending_order",
+		time.Date(2021, 7, 24, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			//pending_order is the order moving the block to its state which hasn't
+			//settled yet, prev_state the state to return to if it isn't filled
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE blocks ADD COLUMN IF NOT EXISTS pending_order STRING NOT NULL DEFAULT '';
+				ALTER TABLE blocks ADD COLUMN IF NOT EXISTS prev_state INT NOT NULL DEFAULT 0;
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE blocks DROP COLUMN IF EXISTS prev_state;
+				ALTER TABLE blocks DROP COLUMN IF EXISTS pending_order;
+			`)
+			return err
+		},
+	))
+}
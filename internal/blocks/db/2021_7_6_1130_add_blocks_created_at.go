@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"add_blocks_created_at",
+		time.Date(2021, 7, 6, 11, 30, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE blocks ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -0,0 +1,395 @@
+package blocks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	blocksAPI "pm.tcfw.com.au/source/ataas/api/pb/blocks"
+	"pm.tcfw.com.au/source/ataas/api/pb/notify"
+	"pm.tcfw.com.au/source/ataas/api/pb/orders"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+const (
+	manualTblName = "manual_actions"
+
+	maxManualActions = 100
+)
+
+var (
+	manualColumns = []string{
+		"id",
+		"block_id",
+		"action",
+		"status",
+		"requested_by",
+		"decided_by",
+		"order_id",
+		"error",
+		"created_at",
+		"decided_at",
+	}
+)
+
+//ManualAction buys or sells a block outside of its strategy. Traders' actions are
+//executed immediately, viewers' actions wait for a trader to approve them
+func (s *Server) ManualAction(ctx context.Context, req *blocksAPI.ManualRequest) (*blocksAPI.ManualResponse, error) {
+	acn, role, err := passportUtils.MembershipFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := passportUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := s.Get(ctx, &blocksAPI.GetRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validManualAction(block, req.Action); err != nil {
+		return nil, err
+	}
+
+	action := &blocksAPI.ManualAction{
+		Id:          uuid.New().String(),
+		BlockID:     block.Id,
+		Action:      req.Action,
+		Status:      blocksAPI.ManualActionStatus_PENDING,
+		RequestedBy: uid,
+		CreatedAt:   time.Now().Format(time.RFC3339),
+	}
+
+	//Traders decide their own actions
+	if role >= usersAPI.OrgRole_TRADER {
+		action.Status = blocksAPI.ManualActionStatus_EXECUTING
+		action.DecidedBy = uid
+	}
+
+	q := db.Build().Insert(manualTblName).
+		Columns("id", "block_id", "account", "action", "status", "requested_by", "decided_by").
+		Values(action.Id, action.BlockID, acn, action.Action, action.Status, action.RequestedBy, action.DecidedBy)
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if action.Status == blocksAPI.ManualActionStatus_PENDING {
+		auditManualAction(ctx, audit.ActionManualAction, acn, action)
+		notifyPending(ctx, block, action)
+		return &blocksAPI.ManualResponse{Request: action}, nil
+	}
+
+	order, err := s.executeManualAction(ctx, block, action)
+	auditManualAction(ctx, audit.ActionManualAction, acn, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blocksAPI.ManualResponse{Order: order, Request: action}, nil
+}
+
+//ListManualActions lists the most recent manual actions in the account, optionally
+//for a single block or only those awaiting approval
+func (s *Server) ListManualActions(ctx context.Context, req *blocksAPI.ListManualActionsRequest) (*blocksAPI.ManualActionList, error) {
+	acn, err := passportUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Select(manualColumns...).From(manualTblName).
+		Where(sq.Eq{"account": acn}).
+		OrderBy("created_at DESC").
+		Limit(maxManualActions)
+
+	if req.BlockID != "" {
+		q = q.Where(sq.Eq{"block_id": req.BlockID})
+	}
+	if req.PendingOnly {
+		q = q.Where(sq.Eq{"status": blocksAPI.ManualActionStatus_PENDING})
+	}
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	list := &blocksAPI.ManualActionList{Actions: []*blocksAPI.ManualAction{}}
+
+	for res.Next() {
+		action, err := scanManualAction(res)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Actions = append(list.Actions, action)
+	}
+
+	return list, nil
+}
+
+//DecideManualAction approves or rejects a pending manual action. Approved actions
+//are executed straight away
+func (s *Server) DecideManualAction(ctx context.Context, req *blocksAPI.DecideManualActionRequest) (*blocksAPI.ManualAction, error) {
+	acn, err := passportUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := passportUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := s.getManualAction(ctx, acn, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if action.Status != blocksAPI.ManualActionStatus_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "action already decided")
+	}
+
+	decision := blocksAPI.ManualActionStatus_REJECTED
+	if req.Approve {
+		decision = blocksAPI.ManualActionStatus_EXECUTING
+	}
+
+	//Claim the decision so concurrent approvals can't execute the action twice
+	claimed, err := claimManualAction(ctx, action.Id, uid, decision)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, status.Error(codes.FailedPrecondition, "action already decided")
+	}
+
+	action.Status = decision
+	action.DecidedBy = uid
+
+	if !req.Approve {
+		auditManualAction(ctx, audit.ActionManualDecision, acn, action)
+
+		return s.getManualAction(ctx, acn, action.Id)
+	}
+
+	block, err := s.Find(ctx, &blocksAPI.GetRequest{Id: action.BlockID})
+	if err != nil {
+		//Leave the action to be decided again
+		if rerr := releaseManualAction(ctx, action.Id); rerr != nil {
+			s.log.Errorf("failed to release manual action %s: %s", action.Id, rerr)
+		}
+		return nil, err
+	}
+
+	if err := validManualAction(block, action.Action); err != nil {
+		action.Status = blocksAPI.ManualActionStatus_FAILED
+		action.Error = err.Error()
+		if uerr := updateManualAction(ctx, action); uerr != nil {
+			s.log.Errorf("failed to record manual action %s: %s", action.Id, uerr)
+		}
+
+		auditManualAction(ctx, audit.ActionManualDecision, acn, action)
+
+		return nil, err
+	}
+
+	_, err = s.executeManualAction(ctx, block, action)
+	auditManualAction(ctx, audit.ActionManualDecision, acn, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getManualAction(ctx, acn, action.Id)
+}
+
+//executeManualAction places the order for the action, attributing it to the member
+//who requested it, and records the outcome
+func (s *Server) executeManualAction(ctx context.Context, block *blocksAPI.Block, action *blocksAPI.ManualAction) (*orders.Order, error) {
+	state := blocksAPI.BlockState_SOLD
+	if action.Action == orders.Action_BUY {
+		state = blocksAPI.BlockState_PURCHASED
+	}
+
+	order, err := s.applyState(block, state, 1, action.RequestedBy)
+
+	action.Status = blocksAPI.ManualActionStatus_EXECUTED
+	if err != nil {
+		action.Status = blocksAPI.ManualActionStatus_FAILED
+		action.Error = err.Error()
+	} else if order != nil {
+		action.OrderID = order.Id
+	}
+
+	action.DecidedAt = time.Now().Format(time.RFC3339)
+
+	if uerr := updateManualAction(ctx, action); uerr != nil {
+		s.log.Errorf("failed to record manual action %s: %s", action.Id, uerr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+//validManualAction checks the action would change the state of the block
+func validManualAction(block *blocksAPI.Block, action orders.Action) error {
+	alreadyPurchased := block.State == blocksAPI.BlockState_PURCHASED && action == orders.Action_BUY
+	alreadySold := block.State == blocksAPI.BlockState_SOLD && action == orders.Action_SELL
+	if alreadyPurchased || alreadySold {
+		return status.Errorf(codes.FailedPrecondition, "invalid future state %t %t", alreadyPurchased, alreadySold)
+	}
+
+	return nil
+}
+
+//getManualAction fetches a single manual action in the account
+func (s *Server) getManualAction(ctx context.Context, acn string, id string) (*blocksAPI.ManualAction, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.NotFound, "action not found")
+	}
+
+	q := db.Build().Select(manualColumns...).From(manualTblName).
+		Where(sq.Eq{"id": id, "account": acn}).
+		Limit(1)
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return nil, status.Error(codes.NotFound, "action not found")
+	}
+
+	return scanManualAction(res)
+}
+
+//claimManualAction moves a pending action to the decided status, returning false
+//if the action has already been decided
+func claimManualAction(ctx context.Context, id string, uid string, decision blocksAPI.ManualActionStatus) (bool, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := db.Build().Update(manualTblName).
+		Set("status", decision).
+		Set("decided_by", uid).
+		Set("decided_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id, "status": blocksAPI.ManualActionStatus_PENDING})
+
+	tag, err := db.Exec(ctx, tx, q)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+//releaseManualAction returns a claimed action which couldn't be executed to pending
+func releaseManualAction(ctx context.Context, id string) error {
+	q := db.Build().Update(manualTblName).SetMap(sq.Eq{
+		"status":     blocksAPI.ManualActionStatus_PENDING,
+		"decided_by": "",
+		"decided_at": nil,
+	}).Where(sq.Eq{"id": id, "status": blocksAPI.ManualActionStatus_EXECUTING})
+
+	return db.SimpleExec(ctx, q)
+}
+
+//updateManualAction stores the outcome of an action
+func updateManualAction(ctx context.Context, action *blocksAPI.ManualAction) error {
+	q := db.Build().Update(manualTblName).SetMap(sq.Eq{
+		"status":     action.Status,
+		"decided_by": action.DecidedBy,
+		"order_id":   action.OrderID,
+		"error":      action.Error,
+		"decided_at": sq.Expr("NOW()"),
+	}).Where(sq.Eq{"id": action.Id})
+
+	return db.SimpleExec(ctx, q)
+}
+
+//auditManualAction records the request or decision of a manual action
+func auditManualAction(ctx context.Context, auditAction string, acn string, action *blocksAPI.ManualAction) {
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  auditAction,
+		Account: acn,
+		Target:  action.BlockID,
+		Success: action.Status != blocksAPI.ManualActionStatus_FAILED,
+		Detail:  fmt.Sprintf("%s %s %s", action.Id, action.Action, action.Status),
+	})
+}
+
+//notifyPending lets the account know an action is waiting for approval
+func notifyPending(ctx context.Context, block *blocksAPI.Block, action *blocksAPI.ManualAction) {
+	nSvc, err := notifySvc()
+	if err != nil {
+		return
+	}
+
+	nSvc.Send(ctx, &notify.SendRequest{
+		Uid:   block.Account,
+		Type:  notify.SendRequest_BLOCK,
+		Title: fmt.Sprintf("Approval required - %s %s", block.Instrument, action.Action),
+		Body:  fmt.Sprintf("A manual %s of %s is waiting for approval", action.Action, block.Instrument),
+	})
+}
+
+//scanManualAction scans a single row from the manual actions table
+func scanManualAction(row scannable) (*blocksAPI.ManualAction, error) {
+	action := &blocksAPI.ManualAction{}
+	var createdAt time.Time
+	var decidedAt sql.NullTime
+
+	err := row.Scan(
+		&action.Id,
+		&action.BlockID,
+		&action.Action,
+		&action.Status,
+		&action.RequestedBy,
+		&action.DecidedBy,
+		&action.OrderID,
+		&action.Error,
+		&createdAt,
+		&decidedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	action.CreatedAt = createdAt.Format(time.RFC3339)
+	if decidedAt.Valid {
+		action.DecidedAt = decidedAt.Time.Format(time.RFC3339)
+	}
+
+	return action, nil
+}
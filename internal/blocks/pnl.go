@@ -0,0 +1,384 @@
+package blocks
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	blocksAPI "pm.tcfw.com.au/source/ataas/api/pb/blocks"
+	"pm.tcfw.com.au/source/ataas/api/pb/orders"
+	ticksAPI "pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+const (
+	defaultPnLInterval = "1h"
+	defaultPnLDepth    = 168
+
+	//fallbackPriceInterval and fallbackPriceDepth bound how far back stored candles
+	//are searched for a price when there are no recent trades
+	fallbackPriceInterval = "24h"
+	fallbackPriceDepth    = 30
+)
+
+var (
+	errNoMarketData = errors.New("no market data available")
+)
+
+//PnL reports the realised and unrealised profit of a single block
+func (s *Server) PnL(ctx context.Context, req *blocksAPI.GetRequest) (*blocksAPI.PnL, error) {
+	block, err := s.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.blockPnL(ctx, block)
+}
+
+//AccountPnL reports the profit of each block and a rollup across all blocks in the account
+func (s *Server) AccountPnL(ctx context.Context, req *blocksAPI.AccountPnLRequest) (*blocksAPI.AccountPnLResponse, error) {
+	list, err := s.List(ctx, &blocksAPI.ListRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blocksAPI.AccountPnLResponse{
+		Total:  &blocksAPI.PnL{},
+		Blocks: []*blocksAPI.PnL{},
+	}
+
+	var since time.Time
+
+	for _, block := range list.Blocks {
+		pnl, err := s.blockPnL(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Blocks = append(resp.Blocks, pnl)
+
+		resp.Total.Realised += pnl.Realised
+		resp.Total.Unrealised += pnl.Unrealised
+		resp.Total.Fees += pnl.Fees
+		resp.Total.Invested += pnl.Invested
+		if pnl.PriceUnavailable {
+			resp.Total.PriceUnavailable = true
+		}
+
+		created, err := time.Parse(time.RFC3339, block.CreatedAt)
+		if err == nil && (since.IsZero() || created.Before(since)) {
+			since = created
+		}
+	}
+
+	if resp.Total.Invested != 0 {
+		resp.Total.Roi = (resp.Total.Realised + resp.Total.Unrealised) / resp.Total.Invested
+	}
+
+	if !since.IsZero() {
+		resp.Total.Since = since.Format(time.RFC3339)
+	}
+
+	return resp, nil
+}
+
+//PnLSeries provides realised and unrealised profit at each candle interval for a single block,
+//or all blocks in the account if no block is specified
+func (s *Server) PnLSeries(ctx context.Context, req *blocksAPI.PnLSeriesRequest) (*blocksAPI.PnLSeriesResponse, error) {
+	if req.Interval == "" {
+		req.Interval = defaultPnLInterval
+	}
+	if req.Depth <= 0 {
+		req.Depth = defaultPnLDepth
+	}
+
+	if _, err := time.ParseDuration(req.Interval); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid interval")
+	}
+
+	var blocks []*blocksAPI.Block
+
+	if req.Id != "" {
+		block, err := s.Get(ctx, &blocksAPI.GetRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	} else {
+		list, err := s.List(ctx, &blocksAPI.ListRequest{})
+		if err != nil {
+			return nil, err
+		}
+		blocks = list.Blocks
+	}
+
+	points := map[int64]*blocksAPI.PnLPoint{}
+
+	for _, block := range blocks {
+		series, err := s.blockPnLSeries(ctx, block, req.Interval, req.Depth)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range series {
+			point, ok := points[p.Timestamp]
+			if !ok {
+				point = &blocksAPI.PnLPoint{Timestamp: p.Timestamp}
+				points[p.Timestamp] = point
+			}
+			point.Realised += p.Realised
+			point.Unrealised += p.Unrealised
+		}
+	}
+
+	resp := &blocksAPI.PnLSeriesResponse{Data: make([]*blocksAPI.PnLPoint, 0, len(points))}
+	for _, p := range points {
+		resp.Data = append(resp.Data, p)
+	}
+
+	sort.Slice(resp.Data, func(i, j int) bool { return resp.Data[i].Timestamp < resp.Data[j].Timestamp })
+
+	return resp, nil
+}
+
+//blockPnL calculates the profit of a block at the latest market price
+func (s *Server) blockPnL(ctx context.Context, block *blocksAPI.Block) (*blocksAPI.PnL, error) {
+	blockOrders, err := s.blockOrders(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	//Without a price only the realised profit can be reported
+	price, err := latestPrice(ctx, block.Market, block.Instrument)
+	if err != nil {
+		s.log.Warnf("no price for block %s: %s", block.Id, err)
+		price = 0
+	}
+
+	tracker := newPnLTracker(block.Instrument)
+	for _, order := range blockOrders {
+		tracker.apply(order)
+	}
+
+	pnl := tracker.snapshot(price)
+	pnl.PriceUnavailable = price <= 0
+	pnl.BlockID = block.Id
+	pnl.Since = block.CreatedAt
+
+	return pnl, nil
+}
+
+//blockPnLSeries replays the block orders against historic candles
+func (s *Server) blockPnLSeries(ctx context.Context, block *blocksAPI.Block, interval string, depth int32) ([]*blocksAPI.PnLPoint, error) {
+	blockOrders, err := s.blockOrders(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	ticks, err := ticksSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	candles, err := ticks.Candles(ctx, &ticksAPI.CandlesRequest{
+		Market:     exchanges.PriceMarket(block.Market),
+		Instrument: block.Instrument,
+		Interval:   interval,
+		Depth:      depth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := newPnLTracker(block.Instrument)
+	points := make([]*blocksAPI.PnLPoint, 0, len(candles.Data))
+
+	i := 0
+	for _, candle := range candles.Data {
+		for i < len(blockOrders) {
+			orderTs, err := time.Parse(time.RFC3339, blockOrders[i].Timestamp)
+			if err != nil || orderTs.Unix() > candle.Timestamp {
+				break
+			}
+			tracker.apply(blockOrders[i])
+			i++
+		}
+
+		pnl := tracker.snapshot(candle.Close)
+		points = append(points, &blocksAPI.PnLPoint{
+			Timestamp:  candle.Timestamp,
+			Realised:   pnl.Realised,
+			Unrealised: pnl.Unrealised,
+		})
+	}
+
+	return points, nil
+}
+
+//blockOrders fetches all orders placed for the block in time order
+func (s *Server) blockOrders(ctx context.Context, block *blocksAPI.Block) ([]*orders.Order, error) {
+	ordersSvc, err := ordersSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ordersSvc.Get(ctx, &orders.GetRequest{BlockID: block.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Orders, nil
+}
+
+//latestPrice gets the last traded price of an instrument, falling back to the close
+//of the latest stored candle for instruments which haven't traded recently
+func latestPrice(ctx context.Context, market, instrument string) (float32, error) {
+	ticks, err := ticksSvc()
+	if err != nil {
+		return 0, err
+	}
+
+	trades, err := ticks.Trades(ctx, &ticksAPI.GetRequest{
+		Market:     exchanges.PriceMarket(market),
+		Instrument: instrument,
+		Depth:      100,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(trades.Data) > 0 {
+		return trades.Data[len(trades.Data)-1].Amount, nil
+	}
+
+	candles, err := ticks.Candles(ctx, &ticksAPI.CandlesRequest{
+		Market:     exchanges.PriceMarket(market),
+		Instrument: instrument,
+		Interval:   fallbackPriceInterval,
+		Depth:      fallbackPriceDepth,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for i := len(candles.Data) - 1; i >= 0; i-- {
+		if candles.Data[i].Close > 0 {
+			return candles.Data[i].Close, nil
+		}
+	}
+
+	return 0, errNoMarketData
+}
+
+//pnlTracker keeps a running average cost position from a sequence of orders
+type pnlTracker struct {
+	instrument string
+
+	position  float64
+	costBasis float64
+	realised  float64
+	fees      float64
+	invested  float64
+}
+
+func newPnLTracker(instrument string) *pnlTracker {
+	return &pnlTracker{instrument: instrument}
+}
+
+//apply adds a filled order to the running position
+func (t *pnlTracker) apply(order *orders.Order) {
+	//only what has been filled affects the position, whatever the order status
+	if order.FilledUnits <= 0 {
+		return
+	}
+
+	units := order.FilledUnits
+	fees := quoteFees(t.instrument, order)
+	t.fees += fees
+
+	if order.Action == orders.Action_BUY {
+		cost := units * float64(order.AvgPrice)
+		if !isBaseAsset(t.instrument, order.FeeAsset) {
+			//fees in the base asset are already taken from the units received
+			cost += fees
+		}
+
+		t.position += units
+		t.costBasis += cost
+
+		if t.costBasis > t.invested {
+			t.invested = t.costBasis
+		}
+		return
+	}
+
+	if t.position <= 0 {
+		return
+	}
+
+	if units > t.position {
+		units = t.position
+	}
+
+	avgCost := t.costBasis / t.position
+	proceeds := units*float64(order.AvgPrice) - fees
+
+	t.realised += proceeds - avgCost*units
+	t.costBasis -= avgCost * units
+	t.position -= units
+}
+
+//snapshot calculates the profit of the current position at the given price
+func (t *pnlTracker) snapshot(price float32) *blocksAPI.PnL {
+	pnl := &blocksAPI.PnL{
+		Realised:     t.realised,
+		Fees:         t.fees,
+		Invested:     t.invested,
+		Position:     t.position,
+		CurrentPrice: price,
+	}
+
+	if t.position > 0 && price > 0 {
+		pnl.Unrealised = t.position*float64(price) - t.costBasis
+	}
+
+	if t.invested != 0 {
+		pnl.Roi = (pnl.Realised + pnl.Unrealised) / t.invested
+	}
+
+	return pnl
+}
+
+//orderProceeds calculates the quote value received from a sell order after
+//commission charged in either the base or quote asset
+func orderProceeds(b *blocksAPI.Block, order *orders.Order) float64 {
+	return order.FilledUnits*float64(order.AvgPrice) - quoteFees(b.Instrument, order)
+}
+
+//quoteFees converts the order commission to the quote asset of the instrument.
+//Commission charged in other assets (e.g. BNB) is not counted
+func quoteFees(instrument string, order *orders.Order) float64 {
+	if order.FeeAsset == "" {
+		return 0
+	}
+
+	_, quote := exchanges.SplitInstrument(instrument)
+
+	switch {
+	case isBaseAsset(instrument, order.FeeAsset):
+		return order.Fees * float64(order.AvgPrice)
+	case strings.EqualFold(quote, order.FeeAsset):
+		return order.Fees
+	}
+
+	return 0
+}
+
+func isBaseAsset(instrument, asset string) bool {
+	base, _ := exchanges.SplitInstrument(instrument)
+	return asset != "" && strings.EqualFold(base, asset)
+}
@@ -0,0 +1,41 @@
+package blocks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pm.tcfw.com.au/source/ataas/api/pb/orders"
+)
+
+func TestPnLTracker(t *testing.T) {
+	tracker := newPnLTracker("BTCAUD")
+
+	tracker.apply(&orders.Order{Action: orders.Action_BUY, Price: 100, Units: 1, AvgPrice: 100, FilledUnits: 1, Status: orders.OrderStatus_FILLED})
+	tracker.apply(&orders.Order{Action: orders.Action_BUY, Price: 200, Units: 1, AvgPrice: 200, FilledUnits: 1, Status: orders.OrderStatus_FILLED})
+	tracker.apply(&orders.Order{Action: orders.Action_SELL, Price: 300, Units: 1, AvgPrice: 300, FilledUnits: 1, Fees: 1, FeeAsset: "AUD", Status: orders.OrderStatus_FILLED})
+	tracker.apply(&orders.Order{Action: orders.Action_SELL, Price: 10, Units: 1, Status: orders.OrderStatus_REJECTED})
+
+	pnl := tracker.snapshot(250)
+
+	assert.InDelta(t, 149, pnl.Realised, 0.0001)
+	assert.InDelta(t, 100, pnl.Unrealised, 0.0001)
+	assert.InDelta(t, 1, pnl.Fees, 0.0001)
+	assert.InDelta(t, 300, pnl.Invested, 0.0001)
+	assert.InDelta(t, 1, pnl.Position, 0.0001)
+	assert.InDelta(t, 249.0/300, pnl.Roi, 0.0001)
+}
+
+func TestPnLTrackerPartialFills(t *testing.T) {
+	tracker := newPnLTracker("BTCAUD")
+
+	tracker.apply(&orders.Order{Action: orders.Action_BUY, Price: 100, Units: 2, AvgPrice: 110, FilledUnits: 1, Status: orders.OrderStatus_PARTIALLY_FILLED})
+	tracker.apply(&orders.Order{Action: orders.Action_BUY, Price: 100, Units: 5, Status: orders.OrderStatus_OPEN})
+	tracker.apply(&orders.Order{Action: orders.Action_SELL, Price: 200, Units: 1, AvgPrice: 210, FilledUnits: 0.5, Status: orders.OrderStatus_CANCELLED})
+
+	pnl := tracker.snapshot(120)
+
+	assert.InDelta(t, 50, pnl.Realised, 0.0001)
+	assert.InDelta(t, 5, pnl.Unrealised, 0.0001)
+	assert.InDelta(t, 110, pnl.Invested, 0.0001)
+	assert.InDelta(t, 0.5, pnl.Position, 0.0001)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -15,7 +16,8 @@ import (
 )
 
 var (
-	ErrSameState = errors.New("same state")
+	ErrSameState    = errors.New("same state")
+	ErrOrderPending = errors.New("order pending")
 )
 
 func (s *Server) work(id int) {
@@ -38,8 +40,8 @@ func (s *Server) handleApply(wid int, ap *apply) error {
 
 	desiredState, n := s.calcState(ap.block, ap.action)
 
-	_, err := s.applyState(ap.block, desiredState, n)
-	if err == ErrSameState {
+	_, err := s.applyState(ap.block, desiredState, n, "")
+	if err == ErrSameState || err == ErrOrderPending {
 		return nil
 	}
 
@@ -78,12 +80,19 @@ func (s *Server) calcState(block *blocks.Block, action strategy.Action) (blocks.
 	return desiredState, n
 }
 
-func (s *Server) applyState(b *blocks.Block, ns blocks.BlockState, n int) (*orders.Order, error) {
+//applyState places the orders to move the block to the new state. Orders are
+//attributed to the actor, or to the strategy when empty. The fills of the order
+//are applied to the block once it settles
+func (s *Server) applyState(b *blocks.Block, ns blocks.BlockState, n int, actor string) (*orders.Order, error) {
 	if b.State == ns {
 		//no change
 		return nil, ErrSameState
 	}
 
+	if b.PendingOrder != "" {
+		return nil, ErrOrderPending
+	}
+
 	s.log.Warnf("Applying state to block %s: %s x%d", b.Id, ns, n)
 
 	ordersSvc, err := ordersSvc()
@@ -94,98 +103,129 @@ func (s *Server) applyState(b *blocks.Block, ns blocks.BlockState, n int) (*orde
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
 	defer cancel()
 
-	nUnits := b.CurrentUnits
 	// unitDiff := b.BaseUnits * float64(n)
 	// if b.CurrentUnits < unitDiff {
 	unitDiff := b.CurrentUnits
 	// }
 
-	var price float32 = -1
-	var order *orders.Order
+	req := &orders.CreateRequest{
+		BlockID:   b.Id,
+		Units:     unitDiff,
+		Price:     -1, //market
+		CreatedBy: actor,
+	}
 
 	switch ns {
 	case blocks.BlockState_PURCHASED:
 		//buy
+		req.Action = orders.Action_BUY
 		if b.Purchase > 0 {
-			price = b.Purchase
+			req.Price = b.Purchase
 		}
-
-		resp, err := ordersSvc.Create(ctx, &orders.CreateRequest{
-			BlockID: b.Id,
-			Action:  orders.Action_BUY,
-			Units:   unitDiff,
-			Price:   price,
-		})
-		if err != nil {
-			notifyFail(ctx, b, ns, err)
-			return nil, err
-		}
-		order = resp.Order
-		nUnits += order.Units
-
 	case blocks.BlockState_SOLD:
-		if b.Purchase > 0 {
-			unitDiff = b.CurrentUnits
+		//sell
+		req.Action = orders.Action_SELL
+	case blocks.BlockState_ENDED:
+		if b.State != blocks.BlockState_PURCHASED {
+			//nothing to sell
+			return nil, s.settleOrder(ctx, b, b.State, ns, nil)
 		}
+		req.Action = orders.Action_SELL
+		req.CreatedBy = ""
+	default:
+		return nil, fmt.Errorf("unknown desired state")
+	}
 
-		//sell
-		resp, err := ordersSvc.Create(ctx, &orders.CreateRequest{
-			BlockID: b.Id,
-			Action:  orders.Action_SELL,
-			Units:   unitDiff,
-			Price:   -1, //market
-		})
-		if err != nil {
-			notifyFail(ctx, b, ns, err)
+	resp, err := ordersSvc.Create(ctx, req)
+	if err != nil {
+		notifyFail(ctx, b, ns, err)
+		return nil, err
+	}
+	order := resp.Order
+
+	if isOpen(order) {
+		//Hold the new state until the order settles so no other orders are placed
+		q := db.Build().Update(tblName).SetMap(sq.Eq{
+			"state":         ns,
+			"prev_state":    b.State,
+			"pending_order": order.Id,
+		}).Where(sq.Eq{"id": b.Id, "pending_order": ""}).Limit(1)
+
+		if err := db.SimpleExec(ctx, q); err != nil {
 			return nil, err
 		}
-		order = resp.Order
-		nUnits -= order.Units
+
+		notifyOrder(ctx, b, ns, order)
+
+		return order, nil
+	}
+
+	if err := s.settleOrder(ctx, b, b.State, ns, order); err != nil {
+		return nil, err
+	}
+
+	notifyOrder(ctx, b, ns, order)
+
+	return order, nil
+}
+
+//settleOrder applies the fills of a settled order to the block, moving it from
+//prev to the new state. The block returns to prev if the order wasn't filled at all
+func (s *Server) settleOrder(ctx context.Context, b *blocks.Block, prev, ns blocks.BlockState, order *orders.Order) error {
+	state := ns
+	nUnits := b.CurrentUnits
+
+	switch {
+	case order == nil:
+		//state change without an order
+	case order.FilledUnits <= 0:
+		state = prev
+	case order.Action == orders.Action_BUY:
+		nUnits += order.FilledUnits
+	case order.Action == orders.Action_SELL:
+		nUnits -= order.FilledUnits
 		if nUnits < 0 && !b.ShortSellAllowed {
 			nUnits = 0
 		}
-		if b.Purchase > 0 {
-			b.Purchase = float32(resp.Order.Units * float64(resp.Order.Price))
-		} else {
-			//This is mainly to account for fees
-			b.BaseUnits = resp.Order.Units
-		}
-
-	case blocks.BlockState_ENDED:
-		if b.State == blocks.BlockState_PURCHASED {
-			resp, err := ordersSvc.Create(ctx, &orders.CreateRequest{
-				BlockID: b.Id,
-				Action:  orders.Action_SELL,
-				Units:   unitDiff,
-				Price:   -1, //market
-			})
-			if err != nil {
-				notifyFail(ctx, b, ns, err)
-				return nil, err
+		if ns == blocks.BlockState_SOLD {
+			if b.Purchase > 0 {
+				b.Purchase = float32(orderProceeds(b, order))
+			} else {
+				//This is mainly to account for fees
+				b.BaseUnits = order.FilledUnits
 			}
-			order = resp.Order
-
-			nUnits = 0
 		}
-	default:
-		return nil, fmt.Errorf("unknown desired state")
 	}
 
-	//Store state
+	//Store state, only once per pending order
 	q := db.Build().Update(tblName).SetMap(sq.Eq{
-		"state":         ns,
+		"state":         state,
 		"current_units": int(nUnits * 1000000),
 		"purchase":      b.Purchase,
 		"base_units":    b.BaseUnits,
-	}).Where(sq.Eq{"id": b.Id}).Limit(1)
+		"pending_order": "",
+	}).Where(sq.Eq{"id": b.Id, "pending_order": b.PendingOrder}).Limit(1)
 
 	if err := db.SimpleExec(ctx, q); err != nil {
-		return nil, err
+		return err
 	}
 
-	notifyOrder(ctx, b, ns, order)
+	if state != ns {
+		err := fmt.Errorf("order %s %s without any fills", order.Id, strings.ToLower(order.Status.String()))
+		notifyFail(ctx, b, ns, err)
+		return err
+	}
 
-	return order, nil
+	b.State = state
+	b.CurrentUnits = nUnits
+	b.PendingOrder = ""
+
+	return nil
+}
+
+//isOpen checks if an order may still be filled
+func isOpen(order *orders.Order) bool {
+	return order.Status == orders.OrderStatus_OPEN || order.Status == orders.OrderStatus_PARTIALLY_FILLED
 }
 
 func notifyFail(ctx context.Context, block *blocks.Block, state blocks.BlockState, errStr error) {
@@ -211,6 +251,6 @@ func notifyOrder(ctx context.Context, block *blocks.Block, state blocks.BlockSta
 		Uid:   block.Account,
 		Type:  notify.SendRequest_BLOCK,
 		Title: fmt.Sprintf("New Order - %s %s", block.Instrument, state),
-		Body:  fmt.Sprintf("%v %v", order.Price, order.Units),
+		Body:  fmt.Sprintf("%v %v", order.AvgPrice, order.FilledUnits),
 	})
 }
@@ -0,0 +1,117 @@
+package exchanges
+
+import (
+	"errors"
+	"math"
+
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+var (
+	//ErrNotSupported the exchange does not provide the requested feature
+	ErrNotSupported = errors.New("not supported by exchange")
+)
+
+//Adapter the full contract of an exchange integration
+type Adapter interface {
+	Exchange
+	MarketData
+	Metadata
+	Balances
+	Fees
+}
+
+//AllInstruments collects every instrument the adapter supports by default
+const AllInstruments = "*"
+
+//MarketData streams public market data
+type MarketData interface {
+	SubscribeTradesAll() (<-chan *ticks.Trade, error)
+
+	//SetTradeInstruments sets the instruments streamed by SubscribeTradesAll,
+	//updating any active subscriptions. AllInstruments may be used to stream all
+	SetTradeInstruments(instruments []string) error
+}
+
+//DepthData streams order book depth of the collected instruments. Adapters
+//maintain the books from the exchange snapshots and incremental updates
+type DepthData interface {
+	SubscribeDepthAll(levels int) (<-chan *BookSnapshot, error)
+}
+
+//SymbolStatusTrading symbol is open for trading
+const SymbolStatusTrading = "TRADING"
+
+//Symbol trading rules of an instrument
+type Symbol struct {
+	Instrument       string
+	Base             string
+	Quote            string
+	Status           string
+	PriceDecimals    int
+	QuantityDecimals int
+
+	//TickSize minimum price increment, 0 if only limited by PriceDecimals
+	TickSize float64
+
+	//StepSize minimum quantity increment, 0 if only limited by QuantityDecimals
+	StepSize float64
+
+	//MinQuantity minimum order quantity
+	MinQuantity float64
+
+	//MinNotional minimum order value in the quote asset
+	MinNotional float64
+}
+
+//Trading if the symbol is currently open for trading
+func (s *Symbol) Trading() bool {
+	return s.Status == SymbolStatusTrading
+}
+
+//RoundPrice truncates the price down to a valid price increment
+func (s *Symbol) RoundPrice(price float64) float64 {
+	return roundStep(price, s.TickSize, s.PriceDecimals)
+}
+
+//RoundQuantity truncates the quantity down to a valid lot step
+func (s *Symbol) RoundQuantity(quantity float64) float64 {
+	return roundStep(quantity, s.StepSize, s.QuantityDecimals)
+}
+
+func roundStep(v, step float64, decimals int) float64 {
+	if step > 0 {
+		//epsilon avoids float error pushing exact multiples down a step
+		v = math.Floor(v/step+1e-9) * step
+	}
+
+	p := math.Pow10(decimals)
+	return math.Floor(v*p+1e-9) / p
+}
+
+//StepDecimals the number of decimal places of a step size, e.g. 0.0010 has 3
+func StepDecimals(step float64) int {
+	for d := 0; d < 16; d++ {
+		p := math.Pow10(d)
+		if math.Abs(step*p-math.Round(step*p)) < 1e-9 {
+			return d
+		}
+	}
+
+	return 16
+}
+
+//Metadata provides the instruments available on the exchange
+type Metadata interface {
+	Symbols() ([]*Symbol, error)
+}
+
+//Balances provides account balances
+type Balances interface {
+	Balance(asset string) (float64, error)
+}
+
+//Fees provides account commission rates
+type Fees interface {
+	Fees(instrument string) (maker float64, taker float64, err error)
+}
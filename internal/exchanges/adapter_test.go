@@ -0,0 +1,31 @@
+package exchanges
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymbolRounding(t *testing.T) {
+	s := &Symbol{
+		PriceDecimals:    2,
+		QuantityDecimals: 3,
+		TickSize:         0.05,
+		StepSize:         0.001,
+	}
+
+	assert.Equal(t, 10.15, s.RoundPrice(10.17))
+	assert.Equal(t, 10.2, s.RoundPrice(10.2))
+	assert.Equal(t, 0.123, s.RoundQuantity(0.12399))
+	assert.Equal(t, 0.3, s.RoundQuantity(0.3))
+
+	noStep := &Symbol{QuantityDecimals: 5}
+	assert.Equal(t, 0.12345, noStep.RoundQuantity(0.123456789))
+}
+
+func TestStepDecimals(t *testing.T) {
+	assert.Equal(t, 0, StepDecimals(1))
+	assert.Equal(t, 3, StepDecimals(0.001))
+	assert.Equal(t, 8, StepDecimals(0.00000001))
+	assert.Equal(t, 2, StepDecimals(0.05))
+}
@@ -1,15 +1,13 @@
 package binance
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 type balance struct {
@@ -19,57 +17,54 @@ type balance struct {
 }
 
 type accountInfo struct {
-	Balances []balance `json:"balances"`
+	CanTrade    bool      `json:"canTrade"`
+	CanWithdraw bool      `json:"canWithdraw"`
+	Permissions []string  `json:"permissions"`
+	Balances    []balance `json:"balances"`
+}
+
+//Balance free balance of an asset in the account
+func (c *Client) Balance(asset string) (float64, error) {
+	return c.balance(asset)
 }
 
 func (c *Client) balance(fe string) (float64, error) {
 
 	fe = strings.ToUpper(fe) //just in case
 
-	tz, err := time.LoadLocation("Australia/Sydney")
+	bResp, err := c.account()
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
 
+	for _, febal := range bResp.Balances {
+		if febal.Asset == fe {
+			free, _ := strconv.ParseFloat(febal.Free, 64)
+			return free, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (c *Client) account() (*accountInfo, error) {
 	vals := url.Values{
-		"timestamp": {strconv.FormatInt(time.Now().In(tz).UnixNano()/1000000, 10)},
+		"timestamp": {strconv.FormatInt(time.Now().UnixNano()/1000000, 10)},
 	}
 
 	pl := c.sign(vals, []byte(c.secret))
 
 	req, err := http.NewRequest(http.MethodGet, c.httpEndpoint+"/api/v3/account?"+pl, nil)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	req.Header.Add("X-MBX-APIKEY", c.key)
 
-	rResp, err := c.c.Do(req)
-	if err != nil {
-		return 0, err
-	}
-
-	buf := bytes.NewBuffer(nil)
-
-	r := io.TeeReader(rResp.Body, buf)
-
 	bResp := &accountInfo{}
-	err = json.NewDecoder(io.LimitReader(r, 10<<20)).Decode(bResp)
-
-	if rResp.StatusCode != 200 {
-		return 0, fmt.Errorf("unexpected http resp %s: %s", rResp.Status, buf)
-	}
-
-	if err != nil {
-		return 0, err
+	if err := transport.DoJSON(c.c, req, bResp, &ErrResp{}); err != nil {
+		return nil, err
 	}
 
-	for _, febal := range bResp.Balances {
-		if febal.Asset == fe {
-			free, _ := strconv.ParseFloat(febal.Free, 64)
-			return free, nil
-		}
-	}
-
-	return 0, nil
+	return bResp, nil
 }
@@ -2,12 +2,20 @@ package binance
 
 import (
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 const (
 	defaultRestEndpoint = "https://api.binance.com"
+
+	//TestnetRestEndpoint spot test network
+	TestnetRestEndpoint = "https://testnet.binance.vision"
 )
 
 type Client struct {
@@ -17,26 +25,147 @@ type Client struct {
 	key          string
 	secret       string
 	httpEndpoint string
+
+	tradeQuotes      []string
+	tradeInstruments []string
+	tradeMu          sync.Mutex
+	tradesRefresh    sync.Once
+	depthLevels      int
 }
 
+var _ exchanges.Adapter = (*Client)(nil)
+
 func NewClient(key, secret string) *Client {
 	return NewClientWithEndpoint(key, secret, defaultRestEndpoint)
 }
 
 func NewClientWithEndpoint(key, secret, endpoint string) *Client {
+	return NewClientWithEndpoints(key, secret, endpoint, defaultWSEndpoint)
+}
+
+//NewClientWithEndpoints creates a client using alternate REST and websocket endpoints
+func NewClientWithEndpoints(key, secret, endpoint, wsEndpoint string) *Client {
 	return &Client{
-		c:            newHttpClent(),
-		ws:           newWsManager(),
-		key:          key,
-		secret:       secret,
-		httpEndpoint: endpoint,
+		c:                transport.NewHTTPClient(),
+		ws:               newWsManager(wsEndpoint),
+		key:              key,
+		secret:           secret,
+		httpEndpoint:     endpoint,
+		tradeQuotes:      []string{"AUD"},
+		tradeInstruments: []string{exchanges.AllInstruments},
 	}
 }
 
-func newHttpClent() *http.Client {
-	return &http.Client{}
+//SetTradeQuotes sets the quote assets of the symbols collected when collecting all instruments
+func (c *Client) SetTradeQuotes(quotes ...string) {
+	c.tradeMu.Lock()
+	defer c.tradeMu.Unlock()
+
+	c.tradeQuotes = quotes
 }
 
+//SetTradeInstruments sets the symbols streamed by SubscribeTradesAll and
+//SubscribeDepthAll. AllInstruments streams all trading symbols in the trade quote assets
+func (c *Client) SetTradeInstruments(instruments []string) error {
+	c.tradeMu.Lock()
+	c.tradeInstruments = instruments
+	c.tradeMu.Unlock()
+
+	return c.updateStreams(false)
+}
+
+//SubscribeTradesAll streams trades of the trade instruments. The symbols are
+//refreshed from exchange info periodically
 func (c *Client) SubscribeTradesAll() (<-chan *ticks.Trade, error) {
-	return c.ws.SubscribeTradesAll()
+	if err := c.updateStreams(false); err != nil {
+		return nil, err
+	}
+
+	ch, err := c.ws.SubscribeTradesAll()
+	if err != nil {
+		return nil, err
+	}
+
+	c.tradesRefresh.Do(func() {
+		go c.refreshTradeStreams()
+	})
+
+	return ch, nil
+}
+
+func (c *Client) refreshTradeStreams() {
+	t := time.NewTicker(symbolsRefreshInterval)
+	defer t.Stop()
+
+	for range t.C {
+		if err := c.updateStreams(true); err != nil {
+			c.ws.log.WithError(err).Warn("failed to update binance streams")
+		}
+	}
+}
+
+//updateStreams sets the trade streams, and depth streams if depth is being collected, of
+//the trade instruments
+func (c *Client) updateStreams(refresh bool) error {
+	streams, err := c.instrumentStreams("@trade", refresh)
+	if err != nil {
+		return err
+	}
+
+	if err := c.ws.setStreams(tradesConn, streams); err != nil {
+		return err
+	}
+
+	c.tradeMu.Lock()
+	depth := c.depthLevels > 0
+	c.tradeMu.Unlock()
+
+	if !depth {
+		return nil
+	}
+
+	streams, err = c.instrumentStreams(depthStream, false)
+	if err != nil {
+		return err
+	}
+
+	return c.ws.setStreams(depthConn, streams)
+}
+
+//instrumentStreams stream names of the trading symbols of the trade instruments
+func (c *Client) instrumentStreams(stream string, refresh bool) ([]string, error) {
+	symbols, err := c.loadSymbols(refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	c.tradeMu.Lock()
+	defer c.tradeMu.Unlock()
+
+	all := false
+	instruments := make(map[string]bool, len(c.tradeInstruments))
+	for _, in := range c.tradeInstruments {
+		if in == exchanges.AllInstruments {
+			all = true
+		}
+		instruments[strings.ToUpper(in)] = true
+	}
+
+	quotes := make(map[string]bool, len(c.tradeQuotes))
+	for _, q := range c.tradeQuotes {
+		quotes[strings.ToUpper(q)] = true
+	}
+
+	streams := []string{}
+	for _, s := range symbols {
+		if !s.Trading() {
+			continue
+		}
+
+		if instruments[s.Instrument] || (all && quotes[s.Quote]) {
+			streams = append(streams, strings.ToLower(s.Instrument)+stream)
+		}
+	}
+
+	return streams, nil
 }
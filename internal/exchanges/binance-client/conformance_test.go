@@ -0,0 +1,210 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/exchangetest"
+)
+
+func TestConformance(t *testing.T) {
+	exchangetest.Run(t, "BTCAUD", newFakeBinance)
+}
+
+//newFakeBinance starts a fake binance REST and websocket API backed by the market
+func newFakeBinance(t *testing.T, m *exchangetest.Market) exchanges.Adapter {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-MBX-APIKEY") != "key" {
+			fakeBinanceErr(w, -2014, "API-key format invalid.")
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			fakeBinanceErr(w, -1100, err.Error())
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			var order *exchangetest.Order
+			var err error
+
+			if r.Form.Get("side") == "BUY" {
+				quote, _ := strconv.ParseFloat(r.Form.Get("quoteOrderQty"), 64)
+				order, err = m.Buy(quote)
+			} else {
+				units, _ := strconv.ParseFloat(r.Form.Get("quantity"), 64)
+				order, err = m.Sell(units)
+			}
+			if err != nil {
+				fakeBinanceErr(w, -2010, err.Error())
+				return
+			}
+
+			json.NewEncoder(w).Encode(fakeBinanceOrder(order, true))
+		case http.MethodGet:
+			order, ok := m.Order(r.Form.Get("orderId"))
+			if !ok {
+				fakeBinanceErr(w, -2013, "Order does not exist.")
+				return
+			}
+
+			json.NewEncoder(w).Encode(fakeBinanceOrder(order, false))
+		case http.MethodDelete:
+			fakeBinanceErr(w, -2011, "Unknown order sent.")
+		}
+	})
+	mux.HandleFunc("/api/v3/myTrades", func(w http.ResponseWriter, r *http.Request) {
+		order, ok := m.Order(r.URL.Query().Get("orderId"))
+		if !ok {
+			json.NewEncoder(w).Encode([]Fill{})
+			return
+		}
+
+		json.NewEncoder(w).Encode(fakeBinanceOrder(order, true).Fills)
+	})
+	mux.HandleFunc("/api/v3/exchangeInfo", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&ExchangeInfo{
+			Symbols: []*SymbolInfo{{
+				Symbol:              m.Instrument,
+				Status:              "TRADING",
+				BaseAsset:           m.Base,
+				BaseAssetPrecision:  8,
+				QuoteAsset:          m.Quote,
+				QuoteAssetPrecision: 8,
+				Filters: []SymbolFilter{
+					{FilterType: "PRICE_FILTER", TickSize: "0.01000000"},
+					{FilterType: "LOT_SIZE", StepSize: "0.00001000", MinQty: "0.00001000"},
+					{FilterType: "MIN_NOTIONAL", MinNotional: "10.00000000"},
+				},
+			}},
+		})
+	})
+	mux.HandleFunc("/api/v3/account", func(w http.ResponseWriter, r *http.Request) {
+		info := &accountInfo{}
+		for asset, bal := range m.Balances() {
+			info.Balances = append(info.Balances, balance{
+				Asset:  asset,
+				Free:   strconv.FormatFloat(bal, 'f', 8, 64),
+				Locked: "0.00000000",
+			})
+		}
+
+		json.NewEncoder(w).Encode(info)
+	})
+	mux.HandleFunc("/sapi/v1/asset/tradeFee", func(w http.ResponseWriter, r *http.Request) {
+		maker, taker := m.Fees()
+
+		json.NewEncoder(w).Encode([]Fee{{
+			Symbol: r.URL.Query().Get("symbol"),
+			Marker: strconv.FormatFloat(maker, 'f', -1, 64),
+			Taker:  strconv.FormatFloat(taker, 'f', -1, 64),
+		}})
+	})
+	mux.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		trades, unsub := m.Subscribe()
+		defer unsub()
+
+		var mu sync.Mutex
+		streams := map[string]bool{}
+
+		go func() {
+			defer unsub()
+
+			for {
+				sub := &WSSubRequest{}
+				if err := conn.ReadJSON(sub); err != nil {
+					return
+				}
+
+				mu.Lock()
+				for _, stream := range sub.Params {
+					streams[stream] = sub.Method == "SUBSCRIBE"
+				}
+				conn.WriteJSON(map[string]interface{}{"result": nil, "id": sub.Id})
+				mu.Unlock()
+			}
+		}()
+
+		for trade := range trades {
+			mu.Lock()
+			if !streams[strings.ToLower(trade.Instrument)+"@trade"] {
+				mu.Unlock()
+				continue
+			}
+
+			err := conn.WriteJSON(&WSSub{
+				Stream: strings.ToLower(trade.Instrument) + "@trade",
+				Data: &WSTradeResponse{
+					EventType: "trade",
+					Symbol:    trade.Instrument,
+					TradeID:   trade.ID,
+					Price:     strconv.FormatFloat(trade.Price, 'f', 8, 64),
+					Quantity:  strconv.FormatFloat(trade.Units, 'f', 8, 64),
+					TradeTime: trade.Timestamp,
+					BuyMaker:  trade.Buy,
+				},
+			})
+			mu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewClientWithEndpoints("key", "secret", srv.URL, "ws"+strings.TrimPrefix(srv.URL, "http")+"/stream")
+}
+
+func fakeBinanceOrder(o *exchangetest.Order, full bool) *OrderResp {
+	side := "SELL"
+	if o.Buy {
+		side = "BUY"
+	}
+
+	id, _ := strconv.ParseInt(o.ID, 10, 64)
+
+	resp := &OrderResp{
+		Symbol:              o.Instrument,
+		OrderId:             id,
+		OrderListId:         -1,
+		ExecutedQty:         strconv.FormatFloat(o.Units, 'f', 8, 64),
+		CummulativeQuoteQty: strconv.FormatFloat(o.Value(), 'f', 8, 64),
+		Status:              "FILLED",
+		Type:                string(OrderTypeMarket),
+		Side:                side,
+	}
+
+	if full {
+		resp.Fills = []Fill{{
+			Price:           strconv.FormatFloat(o.Price, 'f', 8, 64),
+			Qty:             resp.ExecutedQty,
+			Commission:      strconv.FormatFloat(o.Fee, 'f', 8, 64),
+			CommissionAsset: o.FeeAsset,
+		}}
+	}
+
+	return resp
+}
+
+func fakeBinanceErr(w http.ResponseWriter, code int, msg string) {
+	w.WriteHeader(http.StatusBadRequest)
+	fmt.Fprintf(w, `{"code":%d,"msg":%q}`, code, msg)
+}
@@ -0,0 +1,180 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
+)
+
+const (
+	depthStream = "@depth@100ms"
+
+	//depthSnapshotLimit levels requested in REST snapshots to seed each book
+	depthSnapshotLimit = 100
+)
+
+var _ exchanges.DepthData = (*Client)(nil)
+
+type DepthSnapshot struct {
+	LastUpdateID int64       `json:"lastUpdateId"`
+	Bids         [][2]string `json:"bids"`
+	Asks         [][2]string `json:"asks"`
+}
+
+//depthSnapshot fetches the current order book of a symbol
+func (c *Client) depthSnapshot(symbol string) (*DepthSnapshot, error) {
+	vals := url.Values{
+		"symbol": {symbol},
+		"limit":  {strconv.Itoa(depthSnapshotLimit)},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.httpEndpoint+"/api/v3/depth?"+vals.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &DepthSnapshot{}
+	if err := transport.DoJSON(c.c, req, snap, &ErrResp{}); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+//SubscribeDepthAll streams the top levels of the order books of the trade instruments.
+//Books are seeded from a REST snapshot and maintained from the diff depth streams
+func (c *Client) SubscribeDepthAll(levels int) (<-chan *exchanges.BookSnapshot, error) {
+	if levels <= 0 {
+		return nil, fmt.Errorf("levels must be positive")
+	}
+
+	c.tradeMu.Lock()
+	c.depthLevels = levels
+	c.tradeMu.Unlock()
+
+	if err := c.updateStreams(false); err != nil {
+		return nil, err
+	}
+
+	events, err := c.ws.subscribeDepth()
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *exchanges.BookSnapshot, 100)
+
+	go c.maintainBooks(events, ch, levels)
+
+	return ch, nil
+}
+
+//maintainBooks applies depth events to each symbols book, publishing a snapshot after
+//each change. Snapshots are dropped if the consumer falls behind
+func (c *Client) maintainBooks(events <-chan *WSDepthEvent, ch chan<- *exchanges.BookSnapshot, levels int) {
+	books := map[string]*depthBook{}
+
+	for ev := range events {
+		book, ok := books[ev.Symbol]
+		if !ok {
+			book = newDepthBook()
+			books[ev.Symbol] = book
+		}
+
+		changed, err := book.apply(ev, func() (*DepthSnapshot, error) {
+			return c.depthSnapshot(ev.Symbol)
+		})
+		if err != nil {
+			c.ws.log.WithError(err).Warnf("failed to sync %s depth", ev.Symbol)
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		bids, asks := book.book.Snapshot(levels)
+
+		select {
+		case ch <- &exchanges.BookSnapshot{
+			Market:     "binance.com",
+			Instrument: ev.Symbol,
+			Bids:       bids,
+			Asks:       asks,
+			Timestamp:  ev.EventTime,
+		}:
+		default:
+		}
+	}
+}
+
+//depthBook a local order book kept in sync with the diff depth stream
+type depthBook struct {
+	book         *exchanges.OrderBook
+	lastUpdateID int64
+	synced       bool
+	first        bool
+}
+
+func newDepthBook() *depthBook {
+	return &depthBook{book: exchanges.NewOrderBook()}
+}
+
+func (b *depthBook) reset(snap *DepthSnapshot) {
+	b.book.Reset()
+	setLevels(b.book, true, snap.Bids)
+	setLevels(b.book, false, snap.Asks)
+
+	b.lastUpdateID = snap.LastUpdateID
+	b.synced = true
+	b.first = true
+}
+
+//apply applies a depth event to the book, fetching a new snapshot if the book is not
+//in sync. Events already included in the snapshot are dropped. If an update is missed,
+//the book is marked out of sync and is reseeded on the next event
+func (b *depthBook) apply(ev *WSDepthEvent, fetch func() (*DepthSnapshot, error)) (bool, error) {
+	if !b.synced {
+		snap, err := fetch()
+		if err != nil {
+			return false, err
+		}
+		b.reset(snap)
+	}
+
+	if ev.FinalUpdateID <= b.lastUpdateID {
+		return false, nil
+	}
+
+	inSeq := ev.FirstUpdateID == b.lastUpdateID+1
+	if b.first {
+		inSeq = ev.FirstUpdateID <= b.lastUpdateID+1
+	}
+
+	if !inSeq {
+		b.synced = false
+		return false, fmt.Errorf("missed updates between %d and %d", b.lastUpdateID, ev.FirstUpdateID)
+	}
+
+	setLevels(b.book, true, ev.Bids)
+	setLevels(b.book, false, ev.Asks)
+
+	b.lastUpdateID = ev.FinalUpdateID
+	b.first = false
+
+	return true, nil
+}
+
+func setLevels(book *exchanges.OrderBook, bid bool, levels [][2]string) {
+	for _, l := range levels {
+		price, err := strconv.ParseFloat(l[0], 64)
+		if err != nil {
+			continue
+		}
+		quantity, _ := strconv.ParseFloat(l[1], 64)
+
+		book.Set(bid, price, quantity)
+	}
+}
@@ -0,0 +1,55 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+func TestDepthBookSync(t *testing.T) {
+	fetches := 0
+	fetch := func() (*DepthSnapshot, error) {
+		fetches++
+		return &DepthSnapshot{
+			LastUpdateID: 100,
+			Bids:         [][2]string{{"10.0", "1"}, {"9.0", "2"}},
+			Asks:         [][2]string{{"11.0", "1"}},
+		}, nil
+	}
+
+	b := newDepthBook()
+
+	//already in snapshot
+	changed, err := b.apply(&WSDepthEvent{FirstUpdateID: 90, FinalUpdateID: 100}, fetch)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, 1, fetches)
+
+	//first event straddles the snapshot
+	changed, err = b.apply(&WSDepthEvent{FirstUpdateID: 95, FinalUpdateID: 105, Bids: [][2]string{{"10.0", "0"}}}, fetch)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	bids, asks := b.book.Snapshot(5)
+	assert.Equal(t, []exchanges.PriceLevel{{Price: 9, Quantity: 2}}, bids)
+	assert.Equal(t, []exchanges.PriceLevel{{Price: 11, Quantity: 1}}, asks)
+
+	//continuous
+	changed, err = b.apply(&WSDepthEvent{FirstUpdateID: 106, FinalUpdateID: 107, Asks: [][2]string{{"10.5", "3"}}}, fetch)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	_, asks = b.book.Snapshot(1)
+	assert.Equal(t, []exchanges.PriceLevel{{Price: 10.5, Quantity: 3}}, asks)
+
+	//gap forces a resync on the next event
+	_, err = b.apply(&WSDepthEvent{FirstUpdateID: 110, FinalUpdateID: 111}, fetch)
+	assert.Error(t, err)
+
+	changed, err = b.apply(&WSDepthEvent{FirstUpdateID: 99, FinalUpdateID: 101}, fetch)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, 2, fetches)
+}
@@ -1,14 +1,13 @@
 package binance
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 type Fee struct {
@@ -17,6 +16,11 @@ type Fee struct {
 	Taker  string `json:"takerCommission"`
 }
 
+//Fees maker and taker commission rates for a symbol
+func (c *Client) Fees(symbol string) (float64, float64, error) {
+	return c.fees(symbol)
+}
+
 func (c *Client) fees(symbol string) (float64, float64, error) {
 	vals := url.Values{
 		"symbol":    []string{symbol},
@@ -32,23 +36,8 @@ func (c *Client) fees(symbol string) (float64, float64, error) {
 
 	req.Header.Add("X-MBX-APIKEY", c.key)
 
-	rResp, err := c.c.Do(req)
-	if err != nil {
-		return 0, 0, err
-	}
-
-	buf := bytes.NewBuffer(nil)
-
-	r := io.TeeReader(rResp.Body, buf)
-
 	bResp := []Fee{}
-	err = json.NewDecoder(io.LimitReader(r, 10<<20)).Decode(&bResp)
-
-	if rResp.StatusCode != 200 {
-		return 0, 0, fmt.Errorf("unexpected http resp %s: %s", rResp.Status, buf)
-	}
-
-	if err != nil {
+	if err := transport.DoJSON(c.c, req, &bResp, &ErrResp{}); err != nil {
 		return 0, 0, err
 	}
 
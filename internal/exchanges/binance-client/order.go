@@ -2,9 +2,7 @@ package binance
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
 	"math"
 	"net/http"
 	"net/url"
@@ -15,6 +13,7 @@ import (
 	"github.com/gogo/status"
 	"google.golang.org/grpc/codes"
 	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 const (
@@ -22,12 +21,20 @@ const (
 )
 
 type OrderResponse struct {
-	price string
-	units string
+	price    string
+	units    string
+	orderID  string
+	status   exchanges.OrderStatus
+	fees     string
+	feeAsset string
 }
 
-func (or *OrderResponse) Price() string { return or.price }
-func (or *OrderResponse) Units() string { return or.units }
+func (or *OrderResponse) Price() string                 { return or.price }
+func (or *OrderResponse) Units() string                 { return or.units }
+func (or *OrderResponse) OrderID() string               { return or.orderID }
+func (or *OrderResponse) Status() exchanges.OrderStatus { return or.status }
+func (or *OrderResponse) Fees() string                  { return or.fees }
+func (or *OrderResponse) FeeAsset() string              { return or.feeAsset }
 
 type OrderType string
 
@@ -50,6 +57,168 @@ func (c *Client) Sell(instrument string, price float32, units float64) (exchange
 
 }
 
+//OrderStatus queries the current state of an order
+func (c *Client) OrderStatus(instrument string, orderID string) (exchanges.OrderResponse, error) {
+	vals := url.Values{
+		"symbol":    {instrument},
+		"orderId":   {orderID},
+		"timestamp": {strconv.FormatInt(time.Now().UnixNano()/1000000, 10)},
+	}
+
+	bResp, err := c.orderReq(http.MethodGet, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orderRespToResponse(bResp)
+}
+
+//CancelOrder cancels an active order
+func (c *Client) CancelOrder(instrument string, orderID string) (exchanges.OrderResponse, error) {
+	vals := url.Values{
+		"symbol":    {instrument},
+		"orderId":   {orderID},
+		"timestamp": {strconv.FormatInt(time.Now().UnixNano()/1000000, 10)},
+	}
+
+	bResp, err := c.orderReq(http.MethodDelete, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orderRespToResponse(bResp)
+}
+
+//orderReq makes a signed request to the order endpoint passing the values in the query string
+func (c *Client) orderReq(method string, vals url.Values) (*OrderResp, error) {
+	pl := c.sign(vals, []byte(c.secret))
+
+	req, err := http.NewRequest(method, c.httpEndpoint+"/api/v3/order?"+pl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("X-MBX-APIKEY", c.key)
+
+	bResp := &OrderResp{}
+	if err := transport.DoJSON(c.c, req, bResp, &ErrResp{}); err != nil {
+		return nil, err
+	}
+
+	return bResp, nil
+}
+
+//orderRespToResponse converts the raw order state to an exchange order response.
+//Order queries don't include fills, so they are fetched to account for commission
+func (c *Client) orderRespToResponse(bResp *OrderResp) (exchanges.OrderResponse, error) {
+	executed, _ := strconv.ParseFloat(bResp.ExecutedQty, 64)
+
+	if len(bResp.Fills) == 0 && executed != 0 {
+		fills, err := c.myTrades(bResp.Symbol, bResp.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		bResp.Fills = fills
+	}
+
+	return c.orderResult(bResp.Symbol, bResp.Side == "BUY", bResp)
+}
+
+//myTrades fetches the fills of an order
+func (c *Client) myTrades(symbol string, orderID int64) ([]Fill, error) {
+	vals := url.Values{
+		"symbol":    {symbol},
+		"orderId":   {strconv.FormatInt(orderID, 10)},
+		"timestamp": {strconv.FormatInt(time.Now().UnixNano()/1000000, 10)},
+	}
+
+	pl := c.sign(vals, []byte(c.secret))
+
+	req, err := http.NewRequest(http.MethodGet, c.httpEndpoint+"/api/v3/myTrades?"+pl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("X-MBX-APIKEY", c.key)
+
+	fills := []Fill{}
+	if err := transport.DoJSON(c.c, req, &fills, &ErrResp{}); err != nil {
+		return nil, err
+	}
+
+	return fills, nil
+}
+
+//orderResult calculates the net units received, average price and fees of an order
+func (c *Client) orderResult(symbol string, side bool, bResp *OrderResp) (*OrderResponse, error) {
+	priceScale, quantityScale := 8, 8
+	if sym, err := c.symbol(symbol); err == nil {
+		priceScale, quantityScale = sym.PriceDecimals, sym.QuantityDecimals
+	}
+
+	respQuantity, err := strconv.ParseFloat(bResp.ExecutedQty, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteQty, _ := strconv.ParseFloat(bResp.CummulativeQuoteQty, 64)
+
+	fees, feeAsset := bResp.commission()
+
+	if feeAsset == "" && respQuantity != 0 {
+		//No fills reported, fall back to estimating from the account fee rate
+		_, feeTaker, err := c.fees(symbol)
+		if err == nil {
+			if side {
+				fees = respQuantity * feeTaker
+				feeAsset, _ = exchanges.SplitInstrument(symbol)
+			} else {
+				fees = quoteQty * feeTaker
+				_, feeAsset = exchanges.SplitInstrument(symbol)
+			}
+		}
+	}
+
+	if side { //buy
+		//Commission charged in the purchased asset reduces the units received
+		if feeAsset != "" && strings.HasPrefix(strings.ToUpper(symbol), feeAsset) {
+			respQuantity -= fees
+		}
+
+		respQuantity = truncatePrecision(respQuantity, quantityScale)
+	}
+
+	orderPrice := 0.0
+	if respQuantity != 0 {
+		orderPrice = quoteQty / respQuantity
+	}
+
+	return &OrderResponse{
+		price:    strconv.FormatFloat(orderPrice, 'f', priceScale, 64),
+		units:    strconv.FormatFloat(respQuantity, 'f', quantityScale, 64),
+		orderID:  strconv.FormatInt(bResp.OrderId, 10),
+		status:   parseOrderStatus(bResp.Status),
+		fees:     strconv.FormatFloat(fees, 'f', -1, 64),
+		feeAsset: feeAsset,
+	}, nil
+}
+
+//parseOrderStatus maps binance order states to exchange order states
+func parseOrderStatus(s string) exchanges.OrderStatus {
+	switch s {
+	case "PARTIALLY_FILLED":
+		return exchanges.OrderStatusPartiallyFilled
+	case "FILLED":
+		return exchanges.OrderStatusFilled
+	case "CANCELED", "PENDING_CANCEL", "EXPIRED":
+		return exchanges.OrderStatusCancelled
+	case "REJECTED":
+		return exchanges.OrderStatusRejected
+	default:
+		return exchanges.OrderStatusOpen
+	}
+}
+
 type OrderResp struct {
 	Symbol              string `json:"symbol"`              // "BTCUSDT",
 	OrderId             int64  `json:"orderId"`             // 28,
@@ -64,6 +233,34 @@ type OrderResp struct {
 	TimeInForce         string `json:"timeInForce"`         // "GTC",
 	Type                string `json:"type"`                // "MARKET",
 	Side                string `json:"side"`                // "SELL"
+	Fills               []Fill `json:"fills"`               // only on FULL responses
+}
+
+type Fill struct {
+	Price           string `json:"price"`           // "4000.00000000",
+	Qty             string `json:"qty"`             // "1.00000000",
+	Commission      string `json:"commission"`      // "4.00000000",
+	CommissionAsset string `json:"commissionAsset"` // "USDT"
+}
+
+//commission totals the commission paid across all fills. If fills were charged
+//in multiple assets, only the asset of the first fill is totalled
+func (or *OrderResp) commission() (float64, string) {
+	var total float64
+	var asset string
+
+	for _, fill := range or.Fills {
+		if asset == "" {
+			asset = fill.CommissionAsset
+		}
+		if fill.CommissionAsset != asset {
+			continue
+		}
+		c, _ := strconv.ParseFloat(fill.Commission, 64)
+		total += c
+	}
+
+	return total, asset
 }
 
 type ErrResp struct {
@@ -84,12 +281,16 @@ func (c *Client) createOrder(symbol string, side bool, orderType OrderType, pric
 		"side":             {"SELL"},
 		"type":             {string(orderType)},
 		"timestamp":        {strconv.FormatInt(time.Now().UnixNano()/1000000, 10)},
-		"newOrderRespType": {"RESULT"},
+		"newOrderRespType": {"FULL"},
+	}
+
+	sym, err := c.symbol(symbol)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
-	respQStepScale, ok := stepScale[strings.ToLower(symbol)]
-	if !ok {
-		respQStepScale = 2
+	if !sym.Trading() {
+		return nil, status.Errorf(codes.FailedPrecondition, "symbol %s is not trading (%s)", symbol, sym.Status)
 	}
 
 	if side { //buy
@@ -98,26 +299,31 @@ func (c *Client) createOrder(symbol string, side bool, orderType OrderType, pric
 			if price < -1 {
 				return nil, status.Error(codes.FailedPrecondition, "price must be set")
 			}
-			buyQuantity := float64(price * (1 + txFee))
-			vals["quoteOrderQty"] = []string{strconv.FormatFloat(truncatePrecision(buyQuantity, respQStepScale), 'f', -1, 32)}
+			buyQuantity := truncatePrecision(float64(price)*(1+txFee), sym.PriceDecimals)
+			if buyQuantity < sym.MinNotional {
+				return nil, status.Errorf(codes.FailedPrecondition, "order value below minimum notional %v", sym.MinNotional)
+			}
+			vals["quoteOrderQty"] = []string{strconv.FormatFloat(buyQuantity, 'f', sym.PriceDecimals, 64)}
 			vals["side"] = []string{"BUY"}
 		}
 	} else { //sell
+		quantity = sym.RoundQuantity(quantity)
+
 		switch orderType {
 		case OrderTypeMarket:
-			if quantity <= 0 {
+			if quantity <= 0 || quantity < sym.MinQuantity {
 				return nil, status.Error(codes.FailedPrecondition, "quantity must be set")
 			}
-			vals["quantity"] = []string{strconv.FormatFloat(float64(quantity), 'f', -1, 32)}
+			vals["quantity"] = []string{strconv.FormatFloat(quantity, 'f', sym.QuantityDecimals, 64)}
 		case OrderTypeLimit:
-			if quantity <= 0 {
+			if quantity <= 0 || quantity < sym.MinQuantity {
 				return nil, status.Error(codes.FailedPrecondition, "quantity must be set")
 			}
 			if price <= 0 {
 				return nil, status.Error(codes.FailedPrecondition, "quantity must be set")
 			}
-			vals["quantity"] = []string{strconv.FormatFloat(float64(quantity), 'f', -1, 32)}
-			vals["price"] = []string{strconv.FormatFloat(float64(price), 'f', -1, 32)}
+			vals["quantity"] = []string{strconv.FormatFloat(quantity, 'f', sym.QuantityDecimals, 64)}
+			vals["price"] = []string{strconv.FormatFloat(sym.RoundPrice(float64(price)), 'f', sym.PriceDecimals, 64)}
 			vals["timeInForce"] = []string{"IOC"}
 		}
 	}
@@ -131,62 +337,16 @@ func (c *Client) createOrder(symbol string, side bool, orderType OrderType, pric
 
 	req.Header.Add("X-MBX-APIKEY", c.key)
 
-	rResp, err := c.c.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	buf := bytes.NewBuffer(nil)
-
-	r := io.TeeReader(rResp.Body, buf)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
 	bResp := &OrderResp{}
-	err = json.NewDecoder(io.LimitReader(r, 10<<20)).Decode(bResp)
-	if err != nil {
-		return nil, err
-	}
-
-	fmt.Printf("RESP: %+v :: %s\n", bResp, buf.String())
-
-	if bResp.Symbol == "" {
-		//Assume error or empty resp
-		err = &ErrResp{}
-		json.Unmarshal(buf.Bytes(), err)
-		return nil, err
-	}
-
-	respQuantity, err := strconv.ParseFloat(bResp.ExecutedQty, 64)
-	if err != nil {
+	if err := transport.DoJSON(c.c, req, bResp, &ErrResp{}); err != nil {
 		return nil, err
 	}
 
-	if side { //buy
-		feeMaker, feeTaker, err := c.fees(symbol)
-		if err == nil && feeTaker != 0 {
-			respQuantity = respQuantity * (1 - feeTaker)
-		}
-
-		fmt.Printf("FEE M:%+v T:%+v\n", feeMaker, feeTaker)
-
-		//If we have less balance than what we just orderd, assume fees...
-		bal, err := c.balance(symbol[:3])
-		if err != nil && bal > 0 && bal < respQuantity {
-			respQuantity = bal
-		}
-
-		respQuantity = truncatePrecision(respQuantity, respQStepScale)
-	}
-
-	quoteQty, _ := strconv.ParseFloat(bResp.CummulativeQuoteQty, 64)
-
-	orderPrice := quoteQty / respQuantity
-
-	res := &OrderResponse{
-		price: strconv.FormatFloat(orderPrice, 'f', respQStepScale, 64),
-		units: strconv.FormatFloat(respQuantity, 'f', respQStepScale, 64),
-	}
+	fmt.Printf("RESP: %+v\n", bResp)
 
-	return res, nil
+	return c.orderResult(symbol, side, bResp)
 }
 
 func truncatePrecision(f float64, pres int) float64 {
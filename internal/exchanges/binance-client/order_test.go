@@ -0,0 +1,44 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderCommission(t *testing.T) {
+	resp := &OrderResp{
+		Fills: []Fill{
+			{Price: "4000", Qty: "1", Commission: "0.001", CommissionAsset: "BTC"},
+			{Price: "4001", Qty: "1", Commission: "0.002", CommissionAsset: "BTC"},
+		},
+	}
+
+	fees, asset := resp.commission()
+	assert.InDelta(t, 0.003, fees, 0.0000001)
+	assert.Equal(t, "BTC", asset)
+}
+
+func TestSymbolInfo(t *testing.T) {
+	si := &SymbolInfo{
+		Symbol:              "BTCAUD",
+		Status:              "TRADING",
+		BaseAsset:           "BTC",
+		BaseAssetPrecision:  8,
+		QuoteAsset:          "AUD",
+		QuoteAssetPrecision: 8,
+		Filters: []SymbolFilter{
+			{FilterType: "PRICE_FILTER", TickSize: "0.01000000"},
+			{FilterType: "LOT_SIZE", StepSize: "0.00001000", MinQty: "0.00001000"},
+			{FilterType: "NOTIONAL", MinNotional: "10.00000000"},
+		},
+	}
+
+	s := si.toSymbol()
+	assert.True(t, s.Trading())
+	assert.Equal(t, 2, s.PriceDecimals)
+	assert.Equal(t, 5, s.QuantityDecimals)
+	assert.Equal(t, 0.00001, s.MinQuantity)
+	assert.Equal(t, 10.0, s.MinNotional)
+	assert.Equal(t, 0.12345, s.RoundQuantity(0.123456))
+}
@@ -0,0 +1,82 @@
+package binance
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
+)
+
+//APIPermissions what the API key is allowed to do
+type APIPermissions struct {
+	Read      bool
+	SpotTrade bool
+	Withdraw  bool
+}
+
+type apiRestrictions struct {
+	EnableReading              bool `json:"enableReading"`
+	EnableSpotAndMarginTrading bool `json:"enableSpotAndMarginTrading"`
+	EnableWithdrawals          bool `json:"enableWithdrawals"`
+}
+
+//Permissions checks the key and secret with a signed account call and detects what
+//the key is allowed to do. Where the key restrictions aren't available (e.g. on
+//testnet) the account's own trade and withdraw abilities are assumed
+func (c *Client) Permissions() (*APIPermissions, error) {
+	acc, err := c.account()
+	if err != nil {
+		return nil, err
+	}
+
+	perms := &APIPermissions{
+		Read:      true,
+		SpotTrade: acc.CanTrade && hasPermission(acc.Permissions, "SPOT"),
+		Withdraw:  acc.CanWithdraw,
+	}
+
+	restr, err := c.apiRestrictions()
+	if err != nil {
+		return perms, nil
+	}
+
+	perms.Read = restr.EnableReading
+	perms.SpotTrade = perms.SpotTrade && restr.EnableSpotAndMarginTrading
+	perms.Withdraw = restr.EnableWithdrawals
+
+	return perms, nil
+}
+
+func (c *Client) apiRestrictions() (*apiRestrictions, error) {
+	vals := url.Values{
+		"timestamp": {strconv.FormatInt(time.Now().UnixNano()/1000000, 10)},
+	}
+
+	pl := c.sign(vals, []byte(c.secret))
+
+	req, err := http.NewRequest(http.MethodGet, c.httpEndpoint+"/sapi/v1/account/apiRestrictions?"+pl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("X-MBX-APIKEY", c.key)
+
+	bResp := &apiRestrictions{}
+	if err := transport.DoJSON(c.c, req, bResp, &ErrResp{}); err != nil {
+		return nil, err
+	}
+
+	return bResp, nil
+}
+
+func hasPermission(perms []string, perm string) bool {
+	for _, p := range perms {
+		if p == perm {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeAccount(t *testing.T, restrictions string) *Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/account", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-MBX-APIKEY") != "key" {
+			fakeBinanceErr(w, -2015, "Invalid API-key, IP, or permissions for action.")
+			return
+		}
+
+		w.Write([]byte(`{"canTrade":true,"canWithdraw":true,"permissions":["SPOT"],"balances":[]}`))
+	})
+	if restrictions != "" {
+		mux.HandleFunc("/sapi/v1/account/apiRestrictions", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(restrictions))
+		})
+	}
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewClientWithEndpoint("key", "secret", srv.URL)
+}
+
+func TestPermissions(t *testing.T) {
+	c := newFakeAccount(t, `{"enableReading":true,"enableSpotAndMarginTrading":true,"enableWithdrawals":false}`)
+
+	perms, err := c.Permissions()
+	require.NoError(t, err)
+	assert.Equal(t, &APIPermissions{Read: true, SpotTrade: true, Withdraw: false}, perms)
+}
+
+func TestPermissionsNoRestrictions(t *testing.T) {
+	c := newFakeAccount(t, "")
+
+	perms, err := c.Permissions()
+	require.NoError(t, err)
+	assert.Equal(t, &APIPermissions{Read: true, SpotTrade: true, Withdraw: true}, perms)
+}
+
+func TestPermissionsInvalidKey(t *testing.T) {
+	c := newFakeAccount(t, "")
+	c.key = "bad"
+
+	_, err := c.Permissions()
+
+	errResp := &ErrResp{}
+	require.True(t, errors.As(err, &errResp))
+	assert.Equal(t, -2015, errResp.Code)
+}
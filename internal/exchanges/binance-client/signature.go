@@ -1,20 +1,16 @@
 package binance
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"net/url"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 func (c *Client) sign(v url.Values, sec []byte) string {
 	qStr := v.Encode()
 
-	h := hmac.New(sha256.New, sec)
-	h.Write([]byte(qStr))
-
-	sig := hex.EncodeToString(h.Sum(nil))
+	sig := transport.SignHMACSHA256(sec, []byte(qStr))
 
 	return fmt.Sprintf("%s&signature=%s", qStr, sig)
 }
@@ -1,29 +1,169 @@
 package binance
 
-var (
-	subSymbols = []string{
-		"adaaud@trade",
-		"bnbaud@trade",
-		"btcaud@trade",
-		"dogeaud@trade",
-		"ethaud@trade",
-		"linkaud@trade",
-		"sxpaud@trade",
-		"trxaud@trade",
-		"xrpaud@trade",
-	}
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
+)
+
+const (
+	symbolsRefreshInterval = 1 * time.Hour
 )
 
 var (
-	stepScale = map[string]int{
-		"adaaud":  1,
-		"bnbaud":  3,
-		"btcaud":  5,
-		"dogeaud": 0,
-		"ethaud":  4,
-		"linkaud": 2,
-		"sxpaud":  1,
-		"trxaud":  0,
-		"xrpaud":  0,
-	}
+	//symbolCaches exchange info is large and shared by all clients of an endpoint
+	symbolCaches   = map[string]*symbolCache{}
+	symbolCachesMu sync.Mutex
 )
+
+type ExchangeInfo struct {
+	Symbols []*SymbolInfo `json:"symbols"`
+}
+
+type SymbolInfo struct {
+	Symbol              string         `json:"symbol"`              // "ETHBTC"
+	Status              string         `json:"status"`              // "TRADING"
+	BaseAsset           string         `json:"baseAsset"`           // "ETH"
+	BaseAssetPrecision  int            `json:"baseAssetPrecision"`  // 8
+	QuoteAsset          string         `json:"quoteAsset"`          // "BTC"
+	QuoteAssetPrecision int            `json:"quoteAssetPrecision"` // 8
+	Filters             []SymbolFilter `json:"filters"`
+}
+
+type SymbolFilter struct {
+	FilterType  string `json:"filterType"`            // "PRICE_FILTER", "LOT_SIZE", "MIN_NOTIONAL"
+	TickSize    string `json:"tickSize,omitempty"`    // "0.00000100"
+	StepSize    string `json:"stepSize,omitempty"`    // "0.00100000"
+	MinQty      string `json:"minQty,omitempty"`      // "0.00100000"
+	MinNotional string `json:"minNotional,omitempty"` // "0.00100000"
+}
+
+//toSymbol converts exchange info to the symbol trading rules
+func (si *SymbolInfo) toSymbol() *exchanges.Symbol {
+	s := &exchanges.Symbol{
+		Instrument:       si.Symbol,
+		Base:             si.BaseAsset,
+		Quote:            si.QuoteAsset,
+		Status:           si.Status,
+		PriceDecimals:    si.QuoteAssetPrecision,
+		QuantityDecimals: si.BaseAssetPrecision,
+	}
+
+	for _, f := range si.Filters {
+		switch f.FilterType {
+		case "PRICE_FILTER":
+			s.TickSize, _ = strconv.ParseFloat(f.TickSize, 64)
+			if s.TickSize > 0 {
+				s.PriceDecimals = exchanges.StepDecimals(s.TickSize)
+			}
+		case "LOT_SIZE":
+			s.StepSize, _ = strconv.ParseFloat(f.StepSize, 64)
+			s.MinQuantity, _ = strconv.ParseFloat(f.MinQty, 64)
+			if s.StepSize > 0 {
+				s.QuantityDecimals = exchanges.StepDecimals(s.StepSize)
+			}
+		case "MIN_NOTIONAL", "NOTIONAL":
+			s.MinNotional, _ = strconv.ParseFloat(f.MinNotional, 64)
+		}
+	}
+
+	return s
+}
+
+type symbolCache struct {
+	mu      sync.Mutex
+	symbols map[string]*exchanges.Symbol
+	updated time.Time
+}
+
+func endpointSymbolCache(endpoint string) *symbolCache {
+	symbolCachesMu.Lock()
+	defer symbolCachesMu.Unlock()
+
+	sc, ok := symbolCaches[endpoint]
+	if !ok {
+		sc = &symbolCache{}
+		symbolCaches[endpoint] = sc
+	}
+
+	return sc
+}
+
+//ExchangeInfo fetches the current trading rules of all symbols
+func (c *Client) ExchangeInfo() (*ExchangeInfo, error) {
+	req, err := http.NewRequest(http.MethodGet, c.httpEndpoint+"/api/v3/exchangeInfo", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ExchangeInfo{}
+	if err := transport.DoJSON(c.c, req, info, &ErrResp{}); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+//loadSymbols provides the cached symbols, refreshing from exchange info if
+//the cache has expired or force is set
+func (c *Client) loadSymbols(force bool) (map[string]*exchanges.Symbol, error) {
+	sc := endpointSymbolCache(c.httpEndpoint)
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if force || sc.symbols == nil || time.Since(sc.updated) > symbolsRefreshInterval {
+		info, err := c.ExchangeInfo()
+		if err != nil {
+			if sc.symbols != nil {
+				//keep serving stale rules rather than failing orders
+				return sc.symbols, nil
+			}
+			return nil, err
+		}
+
+		sc.symbols = make(map[string]*exchanges.Symbol, len(info.Symbols))
+		for _, si := range info.Symbols {
+			sc.symbols[si.Symbol] = si.toSymbol()
+		}
+		sc.updated = time.Now()
+	}
+
+	return sc.symbols, nil
+}
+
+//symbol provides the trading rules of a single symbol
+func (c *Client) symbol(name string) (*exchanges.Symbol, error) {
+	symbols, err := c.loadSymbols(false)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := symbols[strings.ToUpper(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown symbol %s", name)
+	}
+
+	return s, nil
+}
+
+//Symbols lists the trading rules of all symbols
+func (c *Client) Symbols() ([]*exchanges.Symbol, error) {
+	symbols, err := c.loadSymbols(false)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*exchanges.Symbol, 0, len(symbols))
+	for _, s := range symbols {
+		list = append(list, s)
+	}
+
+	return list, nil
+}
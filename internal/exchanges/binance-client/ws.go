@@ -1,19 +1,16 @@
 package binance
 
 import (
-	"bytes"
 	"encoding/json"
-	"fmt"
-	"io"
 	"math/rand"
+	"sort"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 const (
@@ -49,209 +46,215 @@ type WSTradeResponse struct {
 	Ignore    bool   `json:"M"`
 }
 
+type WSDepthSub struct {
+	Stream string        `json:"stream"`
+	Data   *WSDepthEvent `json:"data"`
+}
+
+type WSDepthEvent struct {
+	EventType     string      `json:"e"` // Event type - "depthUpdate"
+	EventTime     int64       `json:"E"` // Event time - 123456789
+	Symbol        string      `json:"s"` // Symbol - "BNBBTC"
+	FirstUpdateID int64       `json:"U"` // First update ID in event - 157
+	FinalUpdateID int64       `json:"u"` // Final update ID in event - 160
+	Bids          [][2]string `json:"b"` // Bids to be updated - [["0.0024", "10"]]
+	Asks          [][2]string `json:"a"` // Asks to be updated - [["0.0026", "100"]]
+}
+
+const (
+	tradesConn = "trades"
+	depthConn  = "depth"
+)
+
 type wsManager struct {
+	endpoint           string
 	tradeSubscriptions map[string]chan *ticks.Trade
+	depthSubscription  chan *WSDepthEvent
+	streams            map[string]map[string]bool
 	mu                 sync.Mutex
-	conns              map[string]*wsClientConn
+	dialMu             sync.Mutex
+	conns              map[string]*transport.WSConn
+	log                *logrus.Logger
 }
 
-func newWsManager() *wsManager {
+func newWsManager(endpoint string) *wsManager {
 	return &wsManager{
+		endpoint:           endpoint,
 		tradeSubscriptions: map[string]chan *ticks.Trade{},
-		conns:              map[string]*wsClientConn{},
+		streams:            map[string]map[string]bool{},
+		conns:              map[string]*transport.WSConn{},
+		log:                logrus.New(),
 	}
 }
 
 func (m *wsManager) SubscribeTradesAll() (<-chan *ticks.Trade, error) {
+	m.mu.Lock()
 	ch, exists := m.tradeSubscriptions["*"]
 	if !exists {
 		ch = make(chan *ticks.Trade, 100)
-
-		m.mu.Lock()
 		m.tradeSubscriptions["*"] = ch
-		m.mu.Unlock()
-	}
-
-	tc, err := m.tradesConn()
-	if err != nil {
-		return nil, err
 	}
+	m.mu.Unlock()
 
-	err = m.sendTradesAll(tc)
-	if err != nil {
+	if _, err := m.conn(tradesConn, m.handleTradeMsg); err != nil {
 		return nil, err
 	}
 
 	return ch, nil
 }
 
-func (m *wsManager) sendTradesAll(tc *wsClientConn) error {
-	req := &WSSubRequest{
-		Method: "SUBSCRIBE",
-		Params: subSymbols,
-	}
-	req.genID()
-
-	if err := tc.write(req); err != nil {
-		return err
+//subscribeDepth streams the raw depth update events of the depth streams
+func (m *wsManager) subscribeDepth() (<-chan *WSDepthEvent, error) {
+	m.mu.Lock()
+	ch := m.depthSubscription
+	if ch == nil {
+		ch = make(chan *WSDepthEvent, 1000)
+		m.depthSubscription = ch
 	}
+	m.mu.Unlock()
 
-	return nil
-}
-
-func (m *wsManager) tradesConn() (*wsClientConn, error) {
-	tc, ok := m.conns["trades"]
-	if ok {
-		return tc, nil
+	if _, err := m.conn(depthConn, m.handleDepthMsg); err != nil {
+		return nil, err
 	}
 
-	return m.newConn("trades", false)
+	return ch, nil
 }
 
-func (m *wsManager) newConn(label string, locked bool) (*wsClientConn, error) {
+//setStreams updates the streams of the labelled connection, subscribing and
+//unsubscribing any changes on an active connection
+func (m *wsManager) setStreams(label string, streams []string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	conn := &wsClientConn{
-		manager: m,
-		locked:  locked,
-		label:   label,
+	current := m.streams[label]
+
+	want := make(map[string]bool, len(streams))
+	add := []string{}
+	for _, stream := range streams {
+		want[stream] = true
+		if !current[stream] {
+			add = append(add, stream)
+		}
+	}
+
+	remove := []string{}
+	for stream := range current {
+		if !want[stream] {
+			remove = append(remove, stream)
+		}
 	}
 
-	m.conns[label] = conn
+	m.streams[label] = want
 
-	if err := conn.connect(); err != nil {
-		return nil, err
+	tc, ok := m.conns[label]
+	if !ok {
+		return nil
 	}
 
-	go conn.readPump()
+	if err := m.sendStreams(tc, "SUBSCRIBE", add); err != nil {
+		return err
+	}
 
-	return conn, nil
+	return m.sendStreams(tc, "UNSUBSCRIBE", remove)
 }
 
-type wsClientConn struct {
-	manager *wsManager
-
-	conn       *websocket.Conn
-	connDialer *websocket.Dialer
-	locked     bool
-	label      string
+//sendAll subscribes to all streams of the labelled connection
+func (m *wsManager) sendAll(label string) func(tc *transport.WSConn) error {
+	return func(tc *transport.WSConn) error {
+		m.mu.Lock()
+		streams := make([]string, 0, len(m.streams[label]))
+		for stream := range m.streams[label] {
+			streams = append(streams, stream)
+		}
+		m.mu.Unlock()
 
-	mu sync.Mutex
+		return m.sendStreams(tc, "SUBSCRIBE", streams)
+	}
 }
 
-func (c *wsClientConn) connect() error {
-	c.connDialer = &websocket.Dialer{}
-	conn, resp, err := c.connDialer.Dial(defaultWSEndpoint, nil)
-	if err != nil {
-		logrus.New().Errorf("BINANCE.com WS resp: %+v\n", resp)
-		return err
+func (m *wsManager) sendStreams(tc *transport.WSConn, method string, streams []string) error {
+	if len(streams) == 0 {
+		return nil
 	}
 
-	c.conn = conn
-	c.manager.conns[c.label] = c
+	sort.Strings(streams)
 
-	c.conn.SetReadLimit(10 << 20)
-
-	time.Sleep(1 * time.Second) //Suggested sleep time before making new requests
+	req := &WSSubRequest{
+		Method: method,
+		Params: streams,
+	}
+	req.genID()
 
-	return nil
+	return tc.WriteJSON(req)
 }
 
-func (c *wsClientConn) write(cmd interface{}) error {
-	if c.locked {
-		return fmt.Errorf("locked")
-	}
+func (m *wsManager) conn(label string, onMessage func(*transport.WSConn, []byte)) (*transport.WSConn, error) {
+	//dial without holding mu as the subscriptions are sent on connect
+	m.dialMu.Lock()
+	defer m.dialMu.Unlock()
 
-	return c.conn.WriteJSON(cmd)
-}
+	m.mu.Lock()
+	tc, ok := m.conns[label]
+	m.mu.Unlock()
+
+	if ok {
+		return tc, nil
+	}
 
-func (c *wsClientConn) readPump() {
-	buf := bytes.NewBuffer(nil)
+	tc, err := transport.DialWS(transport.WSConfig{
+		Endpoint:     m.endpoint,
+		ConnectDelay: 1 * time.Second, //Suggested sleep time before making new requests
+		OnConnect:    m.sendAll(label),
+		OnMessage:    onMessage,
+		Log:          m.log.WithField("exchange", "binance.com"),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for {
-		buf.Reset()
-		c.conn.SetReadDeadline(time.Now().Add(40 * time.Second))
+	m.mu.Lock()
+	m.conns[label] = tc
+	m.mu.Unlock()
 
-		c.mu.Lock()
-		_, r, err := c.conn.NextReader()
-		if err != nil {
-			fmt.Println("failed to read:", err)
-			c.mu.Unlock()
-			break
-		}
+	return tc, nil
+}
 
-		tr := io.TeeReader(r, buf)
-
-		switch c.label {
-		case "trades":
-			resp := &WSSub{}
-			err = json.NewDecoder(tr).Decode(resp)
-			if err == io.EOF {
-				// One value is expected in the message.
-				err = io.ErrUnexpectedEOF
-			}
-			c.mu.Unlock()
-
-			if err != nil {
-				fmt.Println("failed to decode:", err, buf.String())
-				continue
-			}
-
-			if resp.Data != nil {
-				c.handleTrade(resp.Data)
-			}
-		default:
-			fmt.Println("unknown message type in label", c.label)
-			fmt.Println("[debug] WS-in:", buf.String())
-		}
+func (m *wsManager) handleTradeMsg(tc *transport.WSConn, msg []byte) {
+	resp := &WSSub{}
+	if err := json.Unmarshal(msg, resp); err != nil {
+		m.log.Warnf("failed to decode: %s %s", err, msg)
+		return
 	}
 
-	c.reconnect()
+	if resp.Data != nil && resp.Data.EventType == "trade" {
+		m.handleTrade(resp.Data)
+	}
 }
 
-func (c *wsClientConn) reconnect() {
-	time.Sleep(5 * time.Second)
-	for {
-		err := c.connect()
-		if err == nil {
-			break
-		}
-		fmt.Println("failed to reconnect binance.com", err)
-		time.Sleep(5 * time.Second)
+func (m *wsManager) handleDepthMsg(tc *transport.WSConn, msg []byte) {
+	resp := &WSDepthSub{}
+	if err := json.Unmarshal(msg, resp); err != nil {
+		m.log.Warnf("failed to decode: %s %s", err, msg)
+		return
 	}
 
-	fmt.Println("reconnected binance.com")
-
-	var err error
-
-	if c.label == "trades" {
-		//resubscribe
-		for sub := range c.manager.tradeSubscriptions {
-			for {
-				if sub == "*" {
-					err = c.manager.sendTradesAll(c)
-				} else {
-					break
-				}
-				if err == nil {
-					break
-				}
-				if strings.Contains(err.Error(), "connection reset") {
-					c.reconnect()
-					return
-				}
-				fmt.Println("[error]", err)
-			}
-		}
-		fmt.Println("resubscribed trades")
+	if resp.Data == nil || resp.Data.EventType != "depthUpdate" {
+		return
 	}
 
-	go c.readPump()
+	m.mu.Lock()
+	ch := m.depthSubscription
+	m.mu.Unlock()
+
+	if ch != nil {
+		ch <- resp.Data
+	}
 }
+func (m *wsManager) handleTrade(t *WSTradeResponse) {
+	m.mu.Lock()
+	allch, found := m.tradeSubscriptions["*"]
+	m.mu.Unlock()
 
-func (c *wsClientConn) handleTrade(t *WSTradeResponse) {
-	allch, found := c.manager.tradeSubscriptions["*"]
 	if found {
 		dir := ticks.TradeDirection_SELL
 		if t.BuyMaker {
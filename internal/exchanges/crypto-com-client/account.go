@@ -0,0 +1,79 @@
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+type AccountSummary struct {
+	Balance   float64 `json:"balance"`   //Total balance
+	Available float64 `json:"available"` //Available balance (e.g. not in orders, or locked, etc.)
+	Order     float64 `json:"order"`     //Balance locked in orders
+	Stake     float64 `json:"stake"`     //Balance locked for staking (typically only used for CRO)
+	Currency  string  `json:"currency"`  //e.g. CRO
+}
+
+type AccountSummaryResponse struct {
+	Accounts []*AccountSummary `json:"accounts"`
+}
+
+//Balance provides the available balance of a single asset
+func (c *Client) Balance(asset string) (float64, error) {
+	params := map[string]interface{}{
+		"currency": asset,
+	}
+
+	resp, err := c.doReq(getAccountSummary, params)
+	if err != nil {
+		return 0, err
+	}
+
+	summary := &AccountSummaryResponse{}
+	if err := json.Unmarshal(resp.Result, summary); err != nil {
+		return 0, err
+	}
+
+	for _, acc := range summary.Accounts {
+		if acc.Currency == asset {
+			return acc.Available, nil
+		}
+	}
+
+	return 0, nil
+}
+
+//Fees crypto.com does not provide account fee rates via the API
+func (c *Client) Fees(instrument string) (float64, float64, error) {
+	return 0, 0, exchanges.ErrNotSupported
+}
+
+//Symbols lists the metadata of all tradable instruments
+func (c *Client) Symbols() ([]*exchanges.Symbol, error) {
+	list, err := c.GetInstruments()
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]*exchanges.Symbol, 0, len(list))
+	for _, in := range list {
+		minQuantity, _ := strconv.ParseFloat(in.MinQuantity, 64)
+
+		//all listed instruments are tradable
+		symbols = append(symbols, &exchanges.Symbol{
+			Instrument:       in.InstrumentName,
+			Base:             in.BaseCurrency,
+			Quote:            in.QuoteCurrency,
+			Status:           exchanges.SymbolStatusTrading,
+			PriceDecimals:    in.PriceDecimals,
+			QuantityDecimals: in.QuantityDecimals,
+			TickSize:         math.Pow10(-in.PriceDecimals),
+			StepSize:         math.Pow10(-in.QuantityDecimals),
+			MinQuantity:      minQuantity,
+		})
+	}
+
+	return symbols, nil
+}
@@ -5,10 +5,14 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
 	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 type Client struct {
@@ -18,21 +22,40 @@ type Client struct {
 	key          string
 	secret       string
 	httpEndpoint string
+
+	instruments        map[string]*Instrument
+	instrumentsUpdated time.Time
+	instrumentsMu      sync.Mutex
+
+	tradeInstruments []string
+	depthLevels      int
+	tradeMu          sync.Mutex
 }
 
-var _ exchanges.Exchange = (*Client)(nil)
+var _ exchanges.Adapter = (*Client)(nil)
 
 func NewClient(key, secret string) *Client {
 	return NewClientWithEndpoint(key, secret, restEndpoint)
 }
 
 func NewClientWithEndpoint(key, secret, endpoint string) *Client {
+	return NewClientWithEndpoints(key, secret, endpoint, wsMarketEndpoint)
+}
+
+//NewClientWithEndpoints creates a client using alternate REST and websocket endpoints
+func NewClientWithEndpoints(key, secret, endpoint, wsEndpoint string) *Client {
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
 	return &Client{
-		c:            newHttpClent(),
-		ws:           newWsManager(),
+		c:            transport.NewHTTPClient(),
+		ws:           newWsManager(wsEndpoint),
 		key:          key,
 		secret:       secret,
 		httpEndpoint: endpoint,
+
+		tradeInstruments: []string{exchanges.AllInstruments},
 	}
 }
 
@@ -58,6 +81,34 @@ func (c *Client) SubscribeTradesAll() (<-chan *ticks.Trade, error) {
 	return c.ws.SubscribeTradesAll()
 }
 
+//SetTradeInstruments sets the instruments streamed by SubscribeTradesAll and SubscribeDepthAll
+func (c *Client) SetTradeInstruments(instruments []string) error {
+	c.tradeMu.Lock()
+	c.tradeInstruments = instruments
+	depthLevels := c.depthLevels
+	c.tradeMu.Unlock()
+
+	channels := make([]string, 0, len(instruments))
+
+	for _, in := range instruments {
+		if in == exchanges.AllInstruments {
+			channels = []string{"trade"}
+			break
+		}
+		channels = append(channels, "trade."+in)
+	}
+
+	if err := c.ws.setChannels(tradesConn, channels); err != nil {
+		return err
+	}
+
+	if depthLevels == 0 {
+		return nil
+	}
+
+	return c.ws.setChannels(depthConn, bookChannels(instruments, depthLevels))
+}
+
 func (c *Client) SubscribeTicker(instrument string, getHistory bool) (<-chan *TickerSubscriptionEvent, error) {
 	return c.ws.SubscribeTicker(instrument, getHistory)
 }
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/exchangetest"
+)
+
+func TestConformance(t *testing.T) {
+	exchangetest.Run(t, "BTC_USDT", newFakeCryptoCom)
+}
+
+type fakeRequest struct {
+	Id     uint64                 `json:"id"`
+	Method string                 `json:"method"`
+	ApiKey string                 `json:"api_key"`
+	Params map[string]interface{} `json:"params"`
+}
+
+//newFakeCryptoCom starts a fake crypto.com REST and websocket API backed by the market
+func newFakeCryptoCom(t *testing.T, m *exchangetest.Market) exchanges.Adapter {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/public/get-instruments", func(w http.ResponseWriter, r *http.Request) {
+		fakeCryptoComResult(w, 0, getInstruments, &InstrumentsResponse{
+			Instruments: []*Instrument{{
+				InstrumentName:   m.Instrument,
+				BaseCurrency:     m.Base,
+				QuoteCurrency:    m.Quote,
+				PriceDecimals:    2,
+				QuantityDecimals: 6,
+			}},
+		})
+	})
+	mux.HandleFunc("/v2/private/", func(w http.ResponseWriter, r *http.Request) {
+		req := &fakeRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			fakeCryptoComResult(w, req.Id, apiMethod(req.Method), nil, SYS_ERROR)
+			return
+		}
+
+		if req.ApiKey != "key" || !strings.HasSuffix(r.URL.Path, req.Method) {
+			fakeCryptoComResult(w, req.Id, apiMethod(req.Method), nil, UNAUTHORIZED)
+			return
+		}
+
+		switch apiMethod(req.Method) {
+		case createOrder:
+			var order *exchangetest.Order
+			var err error
+
+			if req.Params["side"] == "BUY" {
+				notional, _ := req.Params["notional"].(float64)
+				order, err = m.Buy(notional)
+			} else {
+				quantity, _ := req.Params["quantity"].(float64)
+				order, err = m.Sell(quantity)
+			}
+			if err != nil {
+				fakeCryptoComResult(w, req.Id, createOrder, nil, NEGATIVE_BALANCE)
+				return
+			}
+
+			fakeCryptoComResult(w, req.Id, createOrder, &OrderConfirmation{OrderID: order.ID})
+		case getOrderDetails:
+			id, _ := req.Params["order_id"].(string)
+			order, ok := m.Order(id)
+			if !ok {
+				fakeCryptoComResult(w, req.Id, getOrderDetails, nil, BAD_REQUEST)
+				return
+			}
+
+			fakeCryptoComResult(w, req.Id, getOrderDetails, fakeCryptoComOrder(order))
+		case cancelOrder:
+			fakeCryptoComResult(w, req.Id, cancelOrder, nil, BAD_REQUEST)
+		case getAccountSummary:
+			summary := &AccountSummaryResponse{}
+			for asset, bal := range m.Balances() {
+				if asset == req.Params["currency"] {
+					summary.Accounts = append(summary.Accounts, &AccountSummary{
+						Balance:   bal,
+						Available: bal,
+						Currency:  asset,
+					})
+				}
+			}
+
+			fakeCryptoComResult(w, req.Id, getAccountSummary, summary)
+		default:
+			fakeCryptoComResult(w, req.Id, apiMethod(req.Method), nil, METHOD_NOT_FOUND)
+		}
+	})
+	mux.HandleFunc("/v2/market", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		trades, unsub := m.Subscribe()
+		defer unsub()
+
+		var mu sync.Mutex
+		channels := map[string]bool{}
+
+		go func() {
+			defer unsub()
+
+			for {
+				sub := &fakeRequest{}
+				if err := conn.ReadJSON(sub); err != nil {
+					return
+				}
+
+				subChannels, _ := sub.Params["channels"].([]interface{})
+
+				mu.Lock()
+				for _, channel := range subChannels {
+					channels[channel.(string)] = sub.Method == "subscribe"
+				}
+				conn.WriteJSON(&CryptoComResponse{Id: sub.Id, Method: sub.Method})
+				mu.Unlock()
+			}
+		}()
+
+		for trade := range trades {
+			mu.Lock()
+			subscribed := channels["trade"] || channels["trade."+trade.Instrument]
+			mu.Unlock()
+
+			if !subscribed {
+				continue
+			}
+
+			side := "SELL"
+			if trade.Buy {
+				side = "BUY"
+			}
+
+			event := &TradeSubscriptionEvent{
+				SubscriptionEvent: SubscriptionEvent{
+					InstrumentName: trade.Instrument,
+					Channel:        "trade",
+					Subscription:   "trade." + trade.Instrument,
+				},
+				Data: []*TradeEvent{{
+					ID:        trade.ID,
+					Price:     float32(trade.Price),
+					Quantity:  float32(trade.Units),
+					Side:      side,
+					Timestamp: trade.Timestamp,
+				}},
+			}
+
+			result, _ := json.Marshal(event)
+
+			mu.Lock()
+			err := conn.WriteJSON(&CryptoComResponse{Method: "subscribe", Result: result})
+			mu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewClientWithEndpoints("key", "secret", srv.URL+"/v2", "ws"+strings.TrimPrefix(srv.URL, "http")+"/v2/market")
+}
+
+func fakeCryptoComOrder(o *exchangetest.Order) *OrderDetails {
+	side := "SELL"
+	if o.Buy {
+		side = "BUY"
+	}
+
+	return &OrderDetails{
+		Info: &OrderDetailsInfo{
+			Status:             "FILLED",
+			Side:               side,
+			OrderID:            o.ID,
+			Type:               string(OrderTypeMarket),
+			InstrumentName:     o.Instrument,
+			CumulativeQuantity: float32(o.Units),
+			CumulativeValue:    float32(o.Value()),
+			AvgPrice:           float32(o.Price),
+			FeeCurrency:        o.FeeAsset,
+		},
+		TradeList: []*OrderDetailsTrade{{
+			Side:           side,
+			InstrumentName: o.Instrument,
+			Fee:            float32(o.Fee),
+			TradeID:        o.ID,
+			TradedPrice:    float32(o.Price),
+			TradedQuantity: float32(o.Units),
+			FeeCurrency:    o.FeeAsset,
+			OrderID:        o.ID,
+		}},
+	}
+}
+
+func fakeCryptoComResult(w http.ResponseWriter, id uint64, method apiMethod, result interface{}, code ...ResponseCode) {
+	resp := &CryptoComResponse{Id: id, Method: string(method)}
+
+	if len(code) > 0 && code[0] != SUCCESS {
+		resp.Code = code[0]
+		w.WriteHeader(http.StatusBadRequest)
+	}
+
+	if result != nil {
+		resp.Result, _ = json.Marshal(result)
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"fmt"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+var _ exchanges.DepthData = (*Client)(nil)
+
+type BookSubscriptionEvent struct {
+	SubscriptionEvent
+	Depth int          `json:"depth"`
+	Data  []*BookEvent `json:"data"`
+}
+
+type BookEvent struct {
+	Bids      [][3]float64 `json:"bids"` // [price, quantity, number of orders]
+	Asks      [][3]float64 `json:"asks"`
+	Timestamp int64        `json:"t"`
+}
+
+func (e *BookEvent) toSnapshot(instrument string, n int) *exchanges.BookSnapshot {
+	return &exchanges.BookSnapshot{
+		Market:     "crypto.com",
+		Instrument: instrument,
+		Bids:       toLevels(e.Bids, n),
+		Asks:       toLevels(e.Asks, n),
+		Timestamp:  e.Timestamp,
+	}
+}
+
+func toLevels(levels [][3]float64, n int) []exchanges.PriceLevel {
+	if n > 0 && len(levels) > n {
+		levels = levels[:n]
+	}
+
+	pl := make([]exchanges.PriceLevel, 0, len(levels))
+	for _, l := range levels {
+		pl = append(pl, exchanges.PriceLevel{Price: l[0], Quantity: l[1]})
+	}
+	return pl
+}
+
+//SubscribeDepthAll streams the order books of the trade instruments. The book channels
+//publish full snapshots so no local book is maintained. Depth can only be collected
+//for explicit instruments, not AllInstruments
+func (c *Client) SubscribeDepthAll(levels int) (<-chan *exchanges.BookSnapshot, error) {
+	if levels <= 0 {
+		return nil, fmt.Errorf("levels must be positive")
+	}
+
+	c.tradeMu.Lock()
+	c.depthLevels = levels
+	instruments := c.tradeInstruments
+	c.tradeMu.Unlock()
+
+	if err := c.ws.setChannels(depthConn, bookChannels(instruments, levels)); err != nil {
+		return nil, err
+	}
+
+	return c.ws.subscribeDepth(levels)
+}
+
+//bookChannels book channel names of the instruments. The exchange only provides
+//depths of 10 or 150 levels
+func bookChannels(instruments []string, levels int) []string {
+	depth := 10
+	if levels > 10 {
+		depth = 150
+	}
+
+	channels := make([]string, 0, len(instruments))
+	for _, in := range instruments {
+		if in == exchanges.AllInstruments {
+			continue
+		}
+		channels = append(channels, fmt.Sprintf("book.%s.%d", in, depth))
+	}
+
+	return channels
+}
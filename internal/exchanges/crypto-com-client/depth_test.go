@@ -0,0 +1,30 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+func TestBookEvent(t *testing.T) {
+	raw := `{"instrument_name":"ETH_CRO","subscription":"book.ETH_CRO.10","channel":"book","depth":10,
+		"data":[{"bids":[[11746.488,128,8],[11746.1,1,1]],"asks":[[11747.488,201,12]],"t":1587523078844}]}`
+
+	event := &BookSubscriptionEvent{}
+	require.NoError(t, json.Unmarshal([]byte(raw), event))
+	require.Len(t, event.Data, 1)
+
+	snap := event.Data[0].toSnapshot(event.InstrumentName, 1)
+	assert.Equal(t, "ETH_CRO", snap.Instrument)
+	assert.Equal(t, []exchanges.PriceLevel{{Price: 11746.488, Quantity: 128}}, snap.Bids)
+	assert.Equal(t, []exchanges.PriceLevel{{Price: 11747.488, Quantity: 201}}, snap.Asks)
+	assert.Equal(t, int64(1587523078844), snap.Timestamp)
+}
+
+func TestBookChannels(t *testing.T) {
+	assert.Equal(t, []string{"book.BTC_USDT.10"}, bookChannels([]string{"BTC_USDT", exchanges.AllInstruments}, 5))
+	assert.Equal(t, []string{"book.BTC_USDT.150"}, bookChannels([]string{"BTC_USDT"}, 20))
+}
@@ -7,8 +7,6 @@ import (
 
 var (
 	ErrUnknownMethod = errors.New("unknown API method")
-
-	errLocked = errors.New("connection locked")
 )
 
 type ResponseError struct {
@@ -12,12 +12,12 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func newHttpClent() *http.Client {
-	return &http.Client{}
-}
-
 const (
-	restEndpoint     = "https://api.crypto.com/v2/"
+	restEndpoint = "https://api.crypto.com/v2/"
+
+	//UATRestEndpoint sandbox environment
+	UATRestEndpoint = "https://uat-api.3ona.co/v2/"
+
 	wsMarketEndpoint = "wss://stream.crypto.com/v2/market"
 )
 
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	instrumentsRefreshInterval = 1 * time.Hour
+)
+
+type Instrument struct {
+	InstrumentName       string `json:"instrument_name"`        //e.g. BTC_USDT
+	QuoteCurrency        string `json:"quote_currency"`         //e.g. USDT
+	BaseCurrency         string `json:"base_currency"`          //e.g. BTC
+	PriceDecimals        int    `json:"price_decimals"`         //Maximum decimal places for specifying price
+	QuantityDecimals     int    `json:"quantity_decimals"`      //Maximum decimal places for specifying quantity
+	MarginTradingEnabled bool   `json:"margin_trading_enabled"` //true or false
+	MaxQuantity          string `json:"max_quantity"`           //Maximum order quantity
+	MinQuantity          string `json:"min_quantity"`           //Minimum order quantity
+}
+
+type InstrumentsResponse struct {
+	Instruments []*Instrument `json:"instruments"`
+}
+
+//GetInstruments lists all supported instruments
+func (c *Client) GetInstruments() ([]*Instrument, error) {
+	resp, err := c.doReq(getInstruments, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	instruments := &InstrumentsResponse{}
+	if err := json.Unmarshal(resp.Result, instruments); err != nil {
+		return nil, err
+	}
+
+	return instruments.Instruments, nil
+}
+
+//instrument provides the cached metadata of a single instrument, refreshing
+//the cache if it has expired
+func (c *Client) instrument(name string) (*Instrument, error) {
+	c.instrumentsMu.Lock()
+	defer c.instrumentsMu.Unlock()
+
+	if c.instruments == nil || time.Since(c.instrumentsUpdated) > instrumentsRefreshInterval {
+		list, err := c.GetInstruments()
+		if err != nil {
+			return nil, err
+		}
+
+		c.instruments = make(map[string]*Instrument, len(list))
+		for _, in := range list {
+			c.instruments[in.InstrumentName] = in
+		}
+		c.instrumentsUpdated = time.Now()
+	}
+
+	in, ok := c.instruments[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown instrument %s", name)
+	}
+
+	return in, nil
+}
+
+func truncatePrecision(f float64, pres int) float64 {
+	i := math.Pow10(pres)
+	return math.Trunc(f*i) / i
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrumentCache(t *testing.T) {
+	n := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		assert.Equal(t, "/v2/public/get-instruments", r.URL.Path)
+		w.Write([]byte(`{"id":1,"method":"public/get-instruments","code":0,"result":{"instruments":[
+			{"instrument_name":"BTC_USDT","quote_currency":"USDT","base_currency":"BTC","price_decimals":2,"quantity_decimals":6}
+		]}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithEndpoint(testKey, testSecret, srv.URL+"/v2")
+
+	in, err := c.instrument("BTC_USDT")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 6, in.QuantityDecimals)
+	}
+
+	_, err = c.instrument("ETH_USDT")
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 0.123456, truncatePrecision(0.1234567, in.QuantityDecimals))
+}
@@ -7,20 +7,30 @@ type apiMethod string
 const (
 	getTicker       apiMethod = "public/get-ticker"
 	getTrades       apiMethod = "public/get-trades"
+	getInstruments  apiMethod = "public/get-instruments"
 	createOrder     apiMethod = "private/create-order"
+	cancelOrder     apiMethod = "private/cancel-order"
 	getOrderDetails apiMethod = "private/get-order-details"
 	getOrderHistory apiMethod = "private/get-order-history"
 	getUserTrades   apiMethod = "private/get-trades"
+
+	getAccountSummary apiMethod = "private/get-account-summary"
+	createWithdrawal  apiMethod = "private/create-withdrawal"
 )
 
 var (
 	methodToHttpMethod = map[apiMethod]string{
 		getTicker:       http.MethodGet,
 		getTrades:       http.MethodGet,
+		getInstruments:  http.MethodGet,
 		createOrder:     http.MethodPost,
+		cancelOrder:     http.MethodPost,
 		getOrderDetails: http.MethodPost,
 		getOrderHistory: http.MethodPost,
 		getUserTrades:   http.MethodPost,
+
+		getAccountSummary: http.MethodPost,
+		createWithdrawal:  http.MethodPost,
 	}
 )
 
@@ -28,9 +38,14 @@ var (
 	requiresSigning = map[apiMethod]bool{
 		getTicker:       false,
 		getTrades:       false,
+		getInstruments:  false,
 		createOrder:     true,
+		cancelOrder:     true,
 		getOrderDetails: true,
 		getOrderHistory: true,
 		getUserTrades:   true,
+
+		getAccountSummary: true,
+		createWithdrawal:  true,
 	}
 )
@@ -3,6 +3,7 @@ package client
 import (
 	"encoding/json"
 	"strconv"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,12 +22,20 @@ const (
 )
 
 type OrderResponse struct {
-	price string
-	units string
+	price    string
+	units    string
+	orderID  string
+	status   exchanges.OrderStatus
+	fees     string
+	feeAsset string
 }
 
-func (or *OrderResponse) Price() string { return or.price }
-func (or *OrderResponse) Units() string { return or.units }
+func (or *OrderResponse) Price() string                 { return or.price }
+func (or *OrderResponse) Units() string                 { return or.units }
+func (or *OrderResponse) OrderID() string               { return or.orderID }
+func (or *OrderResponse) Status() exchanges.OrderStatus { return or.status }
+func (or *OrderResponse) Fees() string                  { return or.fees }
+func (or *OrderResponse) FeeAsset() string              { return or.feeAsset }
 
 func (c *Client) Buy(instrument string, price float32, units float64) (exchanges.OrderResponse, error) {
 	return c.createImmediateOrder(instrument, true, OrderTypeMarket, price, units)
@@ -36,6 +45,29 @@ func (c *Client) Sell(instrument string, price float32, units float64) (exchange
 	return c.createImmediateOrder(instrument, false, OrderTypeMarket, 0, units)
 }
 
+//OrderStatus queries the current state of an order
+func (c *Client) OrderStatus(instrument string, orderID string) (exchanges.OrderResponse, error) {
+	order, err := c.orderDetails(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderDetailsToResponse(orderID, order), nil
+}
+
+//CancelOrder cancels an active order
+func (c *Client) CancelOrder(instrument string, orderID string) (exchanges.OrderResponse, error) {
+	_, err := c.doReq(cancelOrder, map[string]interface{}{
+		"instrument_name": instrument,
+		"order_id":        orderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.OrderStatus(instrument, orderID)
+}
+
 func (c *Client) getOrderHistory() (*CryptoComResponse, error) {
 	return c.doReq(getOrderHistory, map[string]interface{}{})
 }
@@ -58,13 +90,18 @@ func (c *Client) createImmediateOrder(instrument string, side bool, orderType Or
 		"type":            orderType,
 	}
 
+	meta, err := c.instrument(instrument)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	if side { //buy
 		switch orderType {
 		case OrderTypeMarket:
 			if price < -1 {
 				return nil, status.Error(codes.FailedPrecondition, "price must be set")
 			}
-			params["notional"] = price
+			params["notional"] = truncatePrecision(float64(price), meta.PriceDecimals)
 		}
 	} else { //sell
 		switch orderType {
@@ -72,7 +109,7 @@ func (c *Client) createImmediateOrder(instrument string, side bool, orderType Or
 			if quantity < 0 {
 				return nil, status.Error(codes.FailedPrecondition, "quantity must be set")
 			}
-			params["quantity"] = quantity
+			params["quantity"] = truncatePrecision(quantity, meta.QuantityDecimals)
 		}
 	}
 
@@ -91,12 +128,67 @@ func (c *Client) createImmediateOrder(instrument string, side bool, orderType Or
 		return nil, err
 	}
 
+	return orderDetailsToResponse(conf.OrderID, order), nil
+}
+
+//orderDetailsToResponse converts order details into an exchange order response
+//using the cumulative fill values from the order info
+func orderDetailsToResponse(orderID string, order *OrderDetails) *OrderResponse {
 	fnResp := &OrderResponse{
-		price: strconv.FormatFloat(float64(order.TradeList[0].TradedPrice), 'f', 10, 64),
-		units: strconv.FormatFloat(float64(order.TradeList[0].TradedQuantity), 'f', 10, 64),
+		orderID: orderID,
+		status:  exchanges.OrderStatusOpen,
+	}
+
+	var units, value, fees float64
+	for _, trade := range order.TradeList {
+		units += float64(trade.TradedQuantity)
+		value += float64(trade.TradedPrice) * float64(trade.TradedQuantity)
+		fees += float64(trade.Fee)
+		if fnResp.feeAsset == "" {
+			fnResp.feeAsset = trade.FeeCurrency
+		}
 	}
 
-	return fnResp, nil
+	if order.Info != nil {
+		fnResp.status = parseOrderStatus(order.Info.Status, units > 0)
+		if order.Info.FeeCurrency != "" {
+			fnResp.feeAsset = order.Info.FeeCurrency
+		}
+	}
+
+	price := 0.0
+	if units != 0 {
+		price = value / units
+	}
+
+	//Commission charged in the purchased asset reduces the units received
+	if order.Info != nil && order.Info.Side == "BUY" &&
+		strings.HasPrefix(order.Info.InstrumentName, fnResp.feeAsset+"_") {
+		units -= fees
+	}
+
+	fnResp.price = strconv.FormatFloat(price, 'f', 10, 64)
+	fnResp.units = strconv.FormatFloat(units, 'f', 10, 64)
+	fnResp.fees = strconv.FormatFloat(fees, 'f', 10, 64)
+
+	return fnResp
+}
+
+//parseOrderStatus maps crypto.com order states to exchange order states
+func parseOrderStatus(s string, hasFills bool) exchanges.OrderStatus {
+	switch s {
+	case "FILLED":
+		return exchanges.OrderStatusFilled
+	case "CANCELED", "EXPIRED":
+		return exchanges.OrderStatusCancelled
+	case "REJECTED":
+		return exchanges.OrderStatusRejected
+	default:
+		if hasFills {
+			return exchanges.OrderStatusPartiallyFilled
+		}
+		return exchanges.OrderStatusOpen
+	}
 }
 
 func (c *Client) orderDetails(orderID string) (*OrderDetails, error) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
 )
 
 const (
@@ -38,3 +39,22 @@ func TestTrades(t *testing.T) {
 	}
 	assert.NotEmpty(t, r.Result)
 }
+
+func TestOrderDetailsToResponse(t *testing.T) {
+	details := &OrderDetails{
+		TradeList: []*OrderDetailsTrade{
+			{TradedPrice: 10, TradedQuantity: 1, Fee: 0.01},
+			{TradedPrice: 20, TradedQuantity: 1, Fee: 0.02},
+		},
+		Info: &OrderDetailsInfo{Status: "ACTIVE"},
+	}
+
+	res := orderDetailsToResponse("1", details)
+	assert.Equal(t, "1", res.OrderID())
+	assert.Equal(t, exchanges.OrderStatusPartiallyFilled, res.Status())
+	assert.Equal(t, "15.0000000000", res.Price())
+	assert.Equal(t, "2.0000000000", res.Units())
+
+	details.Info.Status = "FILLED"
+	assert.Equal(t, exchanges.OrderStatusFilled, orderDetailsToResponse("1", details).Status())
+}
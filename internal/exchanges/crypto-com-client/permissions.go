@@ -0,0 +1,69 @@
+package client
+
+import (
+	"errors"
+)
+
+//APIPermissions what the API key is allowed to do
+type APIPermissions struct {
+	Read      bool
+	SpotTrade bool
+	Withdraw  bool
+}
+
+//Permissions checks the key and secret with a signed account summary call and
+//detects what the key is allowed to do. crypto.com doesn't expose key permissions,
+//so trading and withdrawals are probed with requests the exchange will always
+//refuse; only an unauthorized response shows the permission is missing
+func (c *Client) Permissions() (*APIPermissions, error) {
+	if _, err := c.doReq(getAccountSummary, map[string]interface{}{}); err != nil {
+		return nil, err
+	}
+
+	perms := &APIPermissions{Read: true}
+
+	var err error
+
+	//cancelling an order which doesn't exist
+	perms.SpotTrade, err = c.probePermission(cancelOrder, map[string]interface{}{
+		"instrument_name": "BTC_USDT",
+		"order_id":        "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//withdrawing nothing to no address
+	perms.Withdraw, err = c.probePermission(createWithdrawal, map[string]interface{}{
+		"currency": "BTC",
+		"amount":   0,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return perms, nil
+}
+
+//probePermission makes a request which should be rejected, reporting if the key
+//was allowed to make it. Any unexpected outcome assumes the permission is granted
+func (c *Client) probePermission(method apiMethod, params map[string]interface{}) (bool, error) {
+	_, err := c.doReq(method, params)
+	if err == nil {
+		return true, nil
+	}
+
+	respErr := &ResponseError{}
+	if !errors.As(err, &respErr) {
+		return false, err
+	}
+
+	switch respErr.Code {
+	case UNAUTHORIZED:
+		return false, nil
+	case TOO_MANY_REQUESTS, INVALID_NONCE:
+		return false, err
+	default:
+		return true, nil
+	}
+}
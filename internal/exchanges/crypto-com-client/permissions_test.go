@@ -0,0 +1,88 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//newFakeAccount starts a fake crypto.com API where the key may only call the allowed methods
+func newFakeAccount(t *testing.T, allowed ...apiMethod) *Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/private/", func(w http.ResponseWriter, r *http.Request) {
+		req := &fakeRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			fakeCryptoComResult(w, req.Id, apiMethod(req.Method), nil, SYS_ERROR)
+			return
+		}
+
+		method := apiMethod(req.Method)
+
+		if req.ApiKey != "key" || (method != getAccountSummary && !hasMethod(allowed, method)) {
+			fakeCryptoComResult(w, req.Id, method, nil, UNAUTHORIZED)
+			return
+		}
+
+		switch method {
+		case getAccountSummary:
+			fakeCryptoComResult(w, req.Id, method, &AccountSummaryResponse{})
+		default:
+			fakeCryptoComResult(w, req.Id, method, nil, MISSING_ARGUMENT)
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewClientWithEndpoint("key", "secret", srv.URL+"/v2/")
+}
+
+func hasMethod(methods []apiMethod, method apiMethod) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestPermissions(t *testing.T) {
+	c := newFakeAccount(t, cancelOrder)
+
+	perms, err := c.Permissions()
+	require.NoError(t, err)
+	assert.Equal(t, &APIPermissions{Read: true, SpotTrade: true, Withdraw: false}, perms)
+}
+
+func TestPermissionsWithdraw(t *testing.T) {
+	c := newFakeAccount(t, cancelOrder, createWithdrawal)
+
+	perms, err := c.Permissions()
+	require.NoError(t, err)
+	assert.Equal(t, &APIPermissions{Read: true, SpotTrade: true, Withdraw: true}, perms)
+}
+
+func TestPermissionsReadOnly(t *testing.T) {
+	c := newFakeAccount(t)
+
+	perms, err := c.Permissions()
+	require.NoError(t, err)
+	assert.Equal(t, &APIPermissions{Read: true}, perms)
+}
+
+func TestPermissionsInvalidKey(t *testing.T) {
+	c := newFakeAccount(t)
+	c.key = "bad"
+
+	_, err := c.Permissions()
+
+	respErr := &ResponseError{}
+	require.True(t, errors.As(err, &respErr))
+	assert.Equal(t, UNAUTHORIZED, respErr.Code)
+}
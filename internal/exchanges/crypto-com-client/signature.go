@@ -2,13 +2,12 @@ package client
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 //sign see https://exchange-docs.crypto.com/spot/index.html?python#digital-signature
@@ -36,8 +35,5 @@ func (c *Client) sign(method apiMethod, params map[string]interface{}, id, nonce
 
 	sigPayload := fmt.Sprintf("%s%d%s%s%d", method, id, c.key, paramStrBuf.String(), nonce)
 
-	h := hmac.New(sha256.New, []byte(c.secret))
-	h.Write([]byte(sigPayload))
-
-	return hex.EncodeToString(h.Sum(nil))
+	return transport.SignHMACSHA256([]byte(c.secret), []byte(sigPayload))
 }
@@ -1,19 +1,19 @@
 package client
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
-	"io"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges/transport"
 )
 
 type CryptoComRequest struct {
@@ -33,399 +33,289 @@ func (r *CryptoComRequest) genNonce() {
 	r.Nonce = uint64(time.Now().Unix())
 }
 
+const (
+	tradesConn = "trades"
+	depthConn  = "depth"
+)
+
 type wsManager struct {
-	inFlight           map[int]chan *CryptoComResponse
+	endpoint           string
 	tickSubscriptions  map[string]chan *TickerSubscriptionEvent
+	tickHistory        map[string]bool
 	tradeSubscriptions map[string]chan *ticks.Trade
+	depthSubscription  chan *exchanges.BookSnapshot
+	depthLevels        int
+	channels           map[string]map[string]bool
 	mu                 sync.Mutex
-	conns              map[string]*wsClientConn
+	dialMu             sync.Mutex
+	conns              map[string]*transport.WSConn
+	log                *logrus.Logger
 }
 
-func newWsManager() *wsManager {
+func newWsManager(endpoint string) *wsManager {
 	return &wsManager{
-		inFlight:           map[int]chan *CryptoComResponse{},
+		endpoint:           endpoint,
 		tickSubscriptions:  map[string]chan *TickerSubscriptionEvent{},
+		tickHistory:        map[string]bool{},
 		tradeSubscriptions: map[string]chan *ticks.Trade{},
-		conns:              map[string]*wsClientConn{},
+		channels:           map[string]map[string]bool{tradesConn: {"trade": true}},
+		conns:              map[string]*transport.WSConn{},
+		log:                logrus.New(),
 	}
 }
 
 func (m *wsManager) SubscribeTickerAll() (<-chan *TickerSubscriptionEvent, error) {
-	ch := make(chan *TickerSubscriptionEvent, 10)
-
-	m.mu.Lock()
-	m.tickSubscriptions["*"] = ch
-	m.mu.Unlock()
-
-	tc, err := m.tickerConn()
-	if err != nil {
-		return nil, err
-	}
-
-	err = m.sendTickerAll(tc)
-	if err != nil {
-		return nil, err
-	}
-
-	return ch, nil
-}
-
-func (m *wsManager) sendTickerAll(tc *wsClientConn) error {
-	req := &CryptoComRequest{
-		Method: "subscribe",
-		Params: map[string]interface{}{
-			"channels": []string{"ticker"},
-		},
-	}
-	req.genID()
-	req.genNonce()
-
-	if err := tc.write(req); err != nil {
-		return err
-	}
-
-	return nil
+	return m.SubscribeTicker("*", false)
 }
 
 func (m *wsManager) SubscribeTradesAll() (<-chan *ticks.Trade, error) {
+	m.mu.Lock()
 	ch, exists := m.tradeSubscriptions["*"]
 	if !exists {
 		ch = make(chan *ticks.Trade, 100)
-
-		m.mu.Lock()
 		m.tradeSubscriptions["*"] = ch
-		m.mu.Unlock()
-	}
-
-	tc, err := m.tradesConn()
-	if err != nil {
-		return nil, err
 	}
+	m.mu.Unlock()
 
-	err = m.sendTradesAll(tc)
-	if err != nil {
+	if _, err := m.conn(tradesConn, m.resubscribe(tradesConn)); err != nil {
 		return nil, err
 	}
 
 	return ch, nil
 }
 
-func (m *wsManager) sendTradesAll(tc *wsClientConn) error {
-	req := &CryptoComRequest{
-		Method: "subscribe",
-		Params: map[string]interface{}{
-			"channels": []string{"trade"},
-		},
-	}
-	req.genID()
-	req.genNonce()
-
-	if err := tc.write(req); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *wsManager) SubscribeTicker(instrument string, history bool) (<-chan *TickerSubscriptionEvent, error) {
-	var ch chan *TickerSubscriptionEvent
-	if _, ok := m.tickSubscriptions[instrument]; !ok {
+	m.mu.Lock()
+	ch, exists := m.tickSubscriptions[instrument]
+	if !exists {
 		ch = make(chan *TickerSubscriptionEvent, 10)
-
-		m.mu.Lock()
 		m.tickSubscriptions[instrument] = ch
-		m.mu.Unlock()
+		m.tickHistory[instrument] = history
 	}
+	m.mu.Unlock()
 
-	tc, err := m.tickerConn()
+	tc, isNew, err := m.connIsNew("ticker", m.resubscribeTickers)
 	if err != nil {
 		return nil, err
 	}
 
-	tc.mu.Lock()
-	defer tc.mu.Unlock()
-
-	if history {
-		//request historical data
-		req := &CryptoComRequest{
-			Method: "subscribe",
-			Params: map[string]interface{}{
-				"channels": []string{fmt.Sprintf("candlestick.1m.%s", instrument)},
-			},
-		}
-		req.genID()
-		req.genNonce()
-
-		if err := tc.write(req); err != nil {
+	//new connections subscribe to all existing tickers on connect
+	if !isNew {
+		if err := m.sendTickerSub(tc, instrument, history); err != nil {
 			return nil, err
 		}
+	}
 
-		_, msg, err := tc.conn.ReadMessage()
-		if err != nil {
-			return nil, err
-		}
+	return ch, nil
+}
 
-		resp := &CryptoComResponse{}
-		if err := json.Unmarshal(msg, resp); err != nil {
-			return nil, err
-		}
+//subscribeDepth streams book snapshots of the depth channels truncated to the top levels
+func (m *wsManager) subscribeDepth(levels int) (<-chan *exchanges.BookSnapshot, error) {
+	m.mu.Lock()
+	m.depthLevels = levels
+	ch := m.depthSubscription
+	if ch == nil {
+		ch = make(chan *exchanges.BookSnapshot, 100)
+		m.depthSubscription = ch
+	}
+	m.mu.Unlock()
 
-		if resp.Code != SUCCESS {
-			return nil, fmt.Errorf("failed to subscribe: %s", resp.Message)
-		}
+	if _, err := m.conn(depthConn, m.resubscribe(depthConn)); err != nil {
+		return nil, err
+	}
 
-		_, msg, err = tc.conn.ReadMessage()
-		if err != nil {
-			return nil, err
-		}
+	return ch, nil
+}
 
-		resp = &CryptoComResponse{}
-		if err := json.Unmarshal(msg, resp); err != nil {
-			return nil, err
+//resubscribe subscribes to all channels of the labelled connection
+func (m *wsManager) resubscribe(label string) func(tc *transport.WSConn) error {
+	return func(tc *transport.WSConn) error {
+		m.mu.Lock()
+		channels := make([]string, 0, len(m.channels[label]))
+		for channel := range m.channels[label] {
+			channels = append(channels, channel)
 		}
+		m.mu.Unlock()
 
-		if resp.Code != SUCCESS {
-			return nil, fmt.Errorf("failed to subscribe: %s", resp.Message)
+		if len(channels) == 0 {
+			return nil
 		}
 
-		tc.handleSubscribeEvent(resp)
-	}
-
-	resp, err := m.sendTickerSub(instrument, tc)
-	if err != nil {
-		return nil, err
+		return m.subscribe(tc, channels...)
 	}
+}
 
-	tc.handleSubscribeEvent(resp)
-
-	fmt.Println("subscribed", instrument)
+//setChannels updates the channels of the labelled connection, subscribing and
+//unsubscribing any changes on an active connection
+func (m *wsManager) setChannels(label string, channels []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	return ch, nil
-}
+	current := m.channels[label]
 
-func (m *wsManager) sendTickerSub(instrument string, tc *wsClientConn) (*CryptoComResponse, error) {
-	req := &CryptoComRequest{
-		Method: "subscribe",
-		Params: map[string]interface{}{
-			"channels": []string{fmt.Sprintf("ticker.%s", instrument)},
-		},
+	want := make(map[string]bool, len(channels))
+	add := []string{}
+	for _, channel := range channels {
+		want[channel] = true
+		if !current[channel] {
+			add = append(add, channel)
+		}
 	}
-	req.genID()
-	req.genNonce()
 
-	if err := tc.write(req); err != nil {
-		return nil, err
+	remove := []string{}
+	for channel := range current {
+		if !want[channel] {
+			remove = append(remove, channel)
+		}
 	}
 
-	_, msg, err := tc.conn.ReadMessage()
-	if err != nil {
-		return nil, err
+	m.channels[label] = want
+
+	tc, ok := m.conns[label]
+	if !ok {
+		return nil
 	}
 
-	resp := &CryptoComResponse{}
-	if err := json.Unmarshal(msg, resp); err != nil {
-		return nil, err
+	if len(add) > 0 {
+		if err := m.subscribe(tc, add...); err != nil {
+			return err
+		}
 	}
 
-	if resp.Code != SUCCESS {
-		return nil, fmt.Errorf("failed to subscribe: %s", resp.Message)
+	if len(remove) > 0 {
+		return m.unsubscribe(tc, remove...)
 	}
 
-	return resp, nil
+	return nil
 }
 
-func (m *wsManager) tickerConn() (*wsClientConn, error) {
-	tc, ok := m.conns["ticker"]
-	if ok {
-		return tc, nil
+func (m *wsManager) resubscribeTickers(tc *transport.WSConn) error {
+	m.mu.Lock()
+	subs := make(map[string]bool, len(m.tickHistory))
+	for instrument, history := range m.tickHistory {
+		subs[instrument] = history
 	}
+	m.mu.Unlock()
 
-	return m.newConn("ticker", false)
-}
-
-func (m *wsManager) tradesConn() (*wsClientConn, error) {
-	tc, ok := m.conns["trades"]
-	if ok {
-		return tc, nil
+	for instrument, history := range subs {
+		if err := m.sendTickerSub(tc, instrument, history); err != nil {
+			return err
+		}
 	}
 
-	return m.newConn("trades", false)
+	return nil
 }
 
-func (m *wsManager) newConn(label string, locked bool) (*wsClientConn, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	conn := &wsClientConn{
-		manager: m,
-		locked:  locked,
-		label:   label,
+func (m *wsManager) sendTickerSub(tc *transport.WSConn, instrument string, history bool) error {
+	if instrument == "*" {
+		return m.subscribe(tc, "ticker")
 	}
 
-	m.conns[label] = conn
-
-	if err := conn.connect(); err != nil {
-		return nil, err
+	if history {
+		//request historical data
+		if err := m.subscribe(tc, fmt.Sprintf("candlestick.1m.%s", instrument)); err != nil {
+			return err
+		}
 	}
 
-	go conn.readPump()
-
-	return conn, nil
+	return m.subscribe(tc, fmt.Sprintf("ticker.%s", instrument))
 }
 
-type wsClientConn struct {
-	manager *wsManager
-
-	conn       *websocket.Conn
-	connDialer *websocket.Dialer
-	locked     bool
-	label      string
-
-	mu sync.Mutex
+func (m *wsManager) subscribe(tc *transport.WSConn, channels ...string) error {
+	return m.sendChannels(tc, "subscribe", channels)
 }
 
-func (c *wsClientConn) connect() error {
-	c.connDialer = &websocket.Dialer{}
-	conn, resp, err := c.connDialer.Dial(wsMarketEndpoint, nil)
-	if err != nil {
-		logrus.New().Errorf("CRYPTO.com WS resp: %+v\n", resp)
-		return err
-	}
-
-	c.conn = conn
-	c.manager.conns[c.label] = c
-
-	c.conn.SetReadLimit(10 << 20)
-
-	time.Sleep(1 * time.Second) //Suggested sleep time before making new requests
-
-	return nil
+func (m *wsManager) unsubscribe(tc *transport.WSConn, channels ...string) error {
+	return m.sendChannels(tc, "unsubscribe", channels)
 }
 
-func (c *wsClientConn) write(cmd *CryptoComRequest) error {
-	if c.locked {
-		return errLocked
+func (m *wsManager) sendChannels(tc *transport.WSConn, method string, channels []string) error {
+	sort.Strings(channels)
+
+	req := &CryptoComRequest{
+		Method: method,
+		Params: map[string]interface{}{
+			"channels": channels,
+		},
 	}
+	req.genID()
+	req.genNonce()
 
-	return c.conn.WriteJSON(cmd)
+	return tc.WriteJSON(req)
 }
 
-func (c *wsClientConn) readPump() {
-	buf := bytes.NewBuffer(nil)
-
-	for {
-		buf.Reset()
-		c.conn.SetReadDeadline(time.Now().Add(40 * time.Second))
-
-		c.mu.Lock()
-		_, r, err := c.conn.NextReader()
-		if err != nil {
-			fmt.Println("failed to read:", err)
-			c.mu.Unlock()
-			break
-		}
-
-		tr := io.TeeReader(r, buf)
+func (m *wsManager) conn(label string, onConnect func(*transport.WSConn) error) (*transport.WSConn, error) {
+	tc, _, err := m.connIsNew(label, onConnect)
+	return tc, err
+}
 
-		resp := &CryptoComResponse{}
-		err = json.NewDecoder(tr).Decode(resp)
-		if err == io.EOF {
-			// One value is expected in the message.
-			err = io.ErrUnexpectedEOF
-		}
+//connIsNew gets or creates the connection for the label, indicating if the connection was just created
+func (m *wsManager) connIsNew(label string, onConnect func(*transport.WSConn) error) (*transport.WSConn, bool, error) {
+	//dial without holding mu as the subscriptions are sent on connect
+	m.dialMu.Lock()
+	defer m.dialMu.Unlock()
 
-		c.mu.Unlock()
+	m.mu.Lock()
+	tc, ok := m.conns[label]
+	m.mu.Unlock()
 
-		if err != nil {
-			fmt.Println("failed to decode:", err, buf.String())
-			continue
-		}
+	if ok {
+		return tc, false, nil
+	}
 
-		switch resp.Method {
-		case "subscribe":
-			c.handleSubscribeEvent(resp)
-		case "public/heartbeat":
-			c.handleHeartbeat(resp)
-		default:
-			fmt.Println("unknown message type:", resp.Method)
-			fmt.Println("[debug] WS-in:", buf.String())
-		}
+	tc, err := transport.DialWS(transport.WSConfig{
+		Endpoint:     m.endpoint,
+		ConnectDelay: 1 * time.Second, //Suggested sleep time before making new requests
+		OnConnect:    onConnect,
+		OnMessage:    m.handleMessage,
+		Log:          m.log.WithField("exchange", "crypto.com"),
+	})
+	if err != nil {
+		return nil, false, err
 	}
 
-	c.reconnect()
+	m.mu.Lock()
+	m.conns[label] = tc
+	m.mu.Unlock()
+
+	return tc, true, nil
 }
 
-func (c *wsClientConn) reconnect() {
-	time.Sleep(5 * time.Second)
-	for {
-		err := c.connect()
-		if err == nil {
-			break
-		}
-		fmt.Println("failed to reconnect crypto.com", err)
-		time.Sleep(5 * time.Second)
+func (m *wsManager) handleMessage(tc *transport.WSConn, msg []byte) {
+	resp := &CryptoComResponse{}
+	if err := json.Unmarshal(msg, resp); err != nil {
+		m.log.Warnf("failed to decode: %s %s", err, msg)
+		return
 	}
 
-	fmt.Println("reconnected crypto.com")
-
-	var err error
-
-	if c.label == "ticker" {
-		//resubscribe
-		for sub := range c.manager.tickSubscriptions {
-			for {
-				if sub == "*" {
-					err = c.manager.sendTickerAll(c)
-				} else {
-					_, err = c.manager.sendTickerSub(sub, c)
-				}
-				if strings.Contains(err.Error(), "connection reset") {
-					c.reconnect()
-					return
-				}
-				if err == nil {
-					break
-				}
-				fmt.Println("[error]", err)
-			}
+	switch resp.Method {
+	case "subscribe":
+		if resp.Code != SUCCESS {
+			m.log.Errorf("failed to subscribe: %d %s", resp.Code, resp.Message)
+			return
 		}
-		fmt.Println("resubscribed tickers")
-	}
-	if c.label == "trades" {
-		//resubscribe
-		for sub := range c.manager.tradeSubscriptions {
-			for {
-				if sub == "*" {
-					err = c.manager.sendTradesAll(c)
-				} else {
-					break
-				}
-				if err == nil {
-					break
-				}
-				if strings.Contains(err.Error(), "connection reset") {
-					c.reconnect()
-					return
-				}
-				fmt.Println("[error]", err)
-			}
+		m.handleSubscribeEvent(resp)
+	case "unsubscribe":
+		if resp.Code != SUCCESS {
+			m.log.Errorf("failed to unsubscribe: %d %s", resp.Code, resp.Message)
 		}
-		fmt.Println("resubscribed trades")
+	case "public/heartbeat":
+		m.handleHeartbeat(tc, resp)
+	default:
+		m.log.Debugf("unknown message type: %s %s", resp.Method, msg)
 	}
-
-	go c.readPump()
 }
 
-func (c *wsClientConn) handleHeartbeat(resp *CryptoComResponse) {
+func (m *wsManager) handleHeartbeat(tc *transport.WSConn, resp *CryptoComResponse) {
 	req := &CryptoComRequest{
 		Id:     resp.Id,
 		Method: "public/respond-heartbeat",
 	}
 
-	c.write(req)
+	tc.WriteJSON(req)
 }
 
-func (c *wsClientConn) handleSubscribeEvent(resp *CryptoComResponse) {
+func (m *wsManager) handleSubscribeEvent(resp *CryptoComResponse) {
 	if len(resp.Result) == 0 {
 		//can assume it's a confirmation of subscription
 		return
@@ -434,7 +324,7 @@ func (c *wsClientConn) handleSubscribeEvent(resp *CryptoComResponse) {
 	baseEvent := &SubscriptionEvent{}
 	err := json.Unmarshal(resp.Result, baseEvent)
 	if err != nil {
-		fmt.Printf("[error] %s", err)
+		m.log.Errorf("%s", err)
 		return
 	}
 
@@ -443,11 +333,14 @@ func (c *wsClientConn) handleSubscribeEvent(resp *CryptoComResponse) {
 		event := &TradeSubscriptionEvent{}
 		err := json.Unmarshal(resp.Result, event)
 		if err != nil {
-			fmt.Printf("[error] %s", err)
+			m.log.Errorf("%s", err)
 			return
 		}
 
-		allch, found := c.manager.tradeSubscriptions["*"]
+		m.mu.Lock()
+		allch, found := m.tradeSubscriptions["*"]
+		m.mu.Unlock()
+
 		if found {
 			for _, d := range event.Data {
 				daySummary := strings.HasSuffix(event.InstrumentName, "CVX_1D")
@@ -473,25 +366,45 @@ func (c *wsClientConn) handleSubscribeEvent(resp *CryptoComResponse) {
 				allch <- event
 			}
 		}
+	case "book":
+		event := &BookSubscriptionEvent{}
+		err := json.Unmarshal(resp.Result, event)
+		if err != nil {
+			m.log.Errorf("%s", err)
+			return
+		}
+
+		m.mu.Lock()
+		ch, levels := m.depthSubscription, m.depthLevels
+		m.mu.Unlock()
+
+		if ch != nil {
+			for _, d := range event.Data {
+				select {
+				case ch <- d.toSnapshot(event.InstrumentName, levels):
+				default:
+				}
+			}
+		}
 	case "ticker":
 		event := &TickerSubscriptionEvent{}
 		err := json.Unmarshal(resp.Result, event)
 		if err != nil {
-			fmt.Printf("[error] %s", err)
+			m.log.Errorf("%s", err)
 			return
 		}
 
-		ch, found := c.manager.tickSubscriptions[event.InstrumentName]
+		m.mu.Lock()
+		ch, found := m.tickSubscriptions[event.InstrumentName]
+		allch, allFound := m.tickSubscriptions["*"]
+		m.mu.Unlock()
+
 		if found {
 			ch <- event
-		} else {
-			fmt.Println("[debug] no sub exists?")
 		}
 
-		allch, found := c.manager.tickSubscriptions["*"]
-		if found {
+		if allFound {
 			allch <- event
 		}
 	}
-
 }
@@ -0,0 +1,26 @@
+/*
+Package exchanges defines the contract exchange adapters implement to be used
+for trade collection and order placement.
+
+An adapter is made up of:
+
+  - Exchange: market order placement, order state queries and cancellation.
+    Buys are given the quote amount to spend in price, sells the base units
+    to sell. Responses report the average fill price, units received after any
+    commission charged in the base asset, the exchange order ID, a normalised
+    OrderStatus and the commission paid with the asset it was charged in.
+  - MarketData: a stream of public trades for the collected instruments,
+    normalised to ticks.Trade with the adapter's market name. The collected
+    instruments can be changed while streaming.
+  - Metadata: the instruments available for trading, their status and trading
+    rules (precision, tick size, lot step, minimum quantity and notional).
+  - Balances: free balance of an asset in the account.
+  - Fees: maker and taker commission rates of the account for an instrument.
+
+Adapters which cannot provide part of the contract return ErrNotSupported.
+
+Shared HTTP, signing and reconnecting websocket plumbing is in the transport
+package. The exchangetest package provides a conformance suite which new
+adapters should run against a local fake of the exchange API.
+*/
+package exchanges
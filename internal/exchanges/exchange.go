@@ -3,9 +3,37 @@ package exchanges
 type Exchange interface {
 	Buy(instrument string, price float32, units float64) (OrderResponse, error)
 	Sell(instrument string, price float32, units float64) (OrderResponse, error)
+
+	//OrderStatus fetches the current state of a previously placed order
+	OrderStatus(instrument string, orderID string) (OrderResponse, error)
+
+	//CancelOrder requests the exchange cancels an order which has not yet been filled
+	CancelOrder(instrument string, orderID string) (OrderResponse, error)
 }
 
 type OrderResponse interface {
 	Price() string
 	Units() string
+	OrderID() string
+	Status() OrderStatus
+
+	//Fees paid on the filled portion of the order, denominated in FeeAsset
+	Fees() string
+	FeeAsset() string
+}
+
+//OrderStatus normalised order states across exchanges
+type OrderStatus int
+
+const (
+	OrderStatusOpen OrderStatus = iota
+	OrderStatusPartiallyFilled
+	OrderStatusFilled
+	OrderStatusCancelled
+	OrderStatusRejected
+)
+
+//Terminal indicates the order will not receive any further fills
+func (os OrderStatus) Terminal() bool {
+	return os == OrderStatusFilled || os == OrderStatusCancelled || os == OrderStatusRejected
 }
@@ -0,0 +1,253 @@
+/*
+Package exchangetest provides a conformance suite for exchange adapters.
+
+Adapters are run against a local fake of the exchange API which translates the
+exchange's wire protocol to and from a simulated Market. The Market holds the
+price, balances, fees, orders and trade stream so the suite can assert an
+adapter normalises the exchange responses correctly.
+*/
+package exchangetest
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+//Order an order filled by the simulated market
+type Order struct {
+	ID         string
+	Instrument string
+	Buy        bool
+	Units      float64
+	Price      float64
+	Fee        float64
+	FeeAsset   string
+	Status     exchanges.OrderStatus
+}
+
+//Value quote value of the order
+func (o *Order) Value() float64 {
+	return o.Units * o.Price
+}
+
+//Trade a public trade published by the simulated market
+type Trade struct {
+	ID         int64
+	Instrument string
+	Price      float64
+	Units      float64
+	Buy        bool
+	Timestamp  int64
+}
+
+//Market a simulated single instrument exchange which immediately fills market orders.
+//Commission is charged in the base asset on buys and the quote asset on sells
+type Market struct {
+	Instrument string
+	Base       string
+	Quote      string
+
+	mu       sync.Mutex
+	price    float64
+	maker    float64
+	taker    float64
+	balances map[string]float64
+	orders   map[string]*Order
+	nextID   int64
+	subs     []chan *Trade
+}
+
+//NewMarket creates a simulated market for the instrument
+func NewMarket(instrument string) *Market {
+	base, quote := exchanges.SplitInstrument(instrument)
+
+	return &Market{
+		Instrument: instrument,
+		Base:       base,
+		Quote:      quote,
+		price:      100,
+		maker:      0.001,
+		taker:      0.001,
+		balances:   map[string]float64{},
+		orders:     map[string]*Order{},
+		nextID:     1000,
+	}
+}
+
+//SetPrice sets the price orders are filled at
+func (m *Market) SetPrice(price float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.price = price
+}
+
+//Price current fill price
+func (m *Market) Price() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.price
+}
+
+//SetFees sets the maker and taker commission rates
+func (m *Market) SetFees(maker, taker float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.maker, m.taker = maker, taker
+}
+
+//Fees current maker and taker commission rates
+func (m *Market) Fees() (float64, float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.maker, m.taker
+}
+
+//SetBalance sets the free balance of an asset
+func (m *Market) SetBalance(asset string, balance float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.balances[asset] = balance
+}
+
+//Balances copy of all asset balances
+func (m *Market) Balances() map[string]float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bals := make(map[string]float64, len(m.balances))
+	for asset, bal := range m.balances {
+		bals[asset] = bal
+	}
+
+	return bals
+}
+
+//Buy fills a market buy spending the quote amount
+func (m *Market) Buy(quote float64) (*Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if quote <= 0 {
+		return nil, fmt.Errorf("invalid quote amount %f", quote)
+	}
+
+	if m.balances[m.Quote] < quote {
+		return nil, fmt.Errorf("insufficient balance")
+	}
+
+	units := quote / m.price
+	fee := units * m.taker
+
+	m.balances[m.Quote] -= quote
+	m.balances[m.Base] += units - fee
+
+	return m.addOrder(true, units, fee, m.Base), nil
+}
+
+//Sell fills a market sell of the base units
+func (m *Market) Sell(units float64) (*Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if units <= 0 {
+		return nil, fmt.Errorf("invalid quantity %f", units)
+	}
+
+	if m.balances[m.Base] < units {
+		return nil, fmt.Errorf("insufficient balance")
+	}
+
+	value := units * m.price
+	fee := value * m.taker
+
+	m.balances[m.Base] -= units
+	m.balances[m.Quote] += value - fee
+
+	return m.addOrder(false, units, fee, m.Quote), nil
+}
+
+func (m *Market) addOrder(buy bool, units, fee float64, feeAsset string) *Order {
+	m.nextID++
+
+	o := &Order{
+		ID:         strconv.FormatInt(m.nextID, 10),
+		Instrument: m.Instrument,
+		Buy:        buy,
+		Units:      units,
+		Price:      m.price,
+		Fee:        fee,
+		FeeAsset:   feeAsset,
+		Status:     exchanges.OrderStatusFilled,
+	}
+
+	m.orders[o.ID] = o
+
+	return o
+}
+
+//Order finds a previously placed order
+func (m *Market) Order(id string) (*Order, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.orders[id]
+	return o, ok
+}
+
+//Subscribe receives all trades published after subscribing until unsubscribed
+func (m *Market) Subscribe() (<-chan *Trade, func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ch := make(chan *Trade, 100)
+	m.subs = append(m.subs, ch)
+
+	unsub := func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		for i, sub := range m.subs {
+			if sub == ch {
+				m.subs = append(m.subs[:i], m.subs[i+1:]...)
+				close(ch)
+				return
+			}
+		}
+	}
+
+	return ch, unsub
+}
+
+//Publish sends a trade at the current price to all subscribers
+func (m *Market) Publish(units float64, buy bool, ts int64) *Trade {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+
+	t := &Trade{
+		ID:         m.nextID,
+		Instrument: m.Instrument,
+		Price:      m.price,
+		Units:      units,
+		Buy:        buy,
+		Timestamp:  ts,
+	}
+
+	for _, ch := range m.subs {
+		select {
+		case ch <- t:
+		default:
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,258 @@
+package exchangetest
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+const (
+	//tolerance relative difference allowed for prices and quantities to allow
+	//for exchange specific rounding and fee buffers
+	tolerance = 0.01
+
+	tradeTimeout = 10 * time.Second
+)
+
+//NewAdapterFunc starts a fake exchange server backed by the market and
+//returns an adapter configured to use it
+type NewAdapterFunc func(t *testing.T, m *Market) exchanges.Adapter
+
+//Run runs the conformance suite against the adapter for the instrument
+func Run(t *testing.T, instrument string, newAdapter NewAdapterFunc) {
+	t.Run("Symbols", func(t *testing.T) {
+		m := NewMarket(instrument)
+		a := newAdapter(t, m)
+
+		symbols, err := a.Symbols()
+		require.NoError(t, err)
+
+		var found *exchanges.Symbol
+		for _, s := range symbols {
+			if s.Instrument == instrument {
+				found = s
+			}
+		}
+
+		if assert.NotNil(t, found, "instrument missing from symbols") {
+			assert.Equal(t, m.Base, found.Base)
+			assert.Equal(t, m.Quote, found.Quote)
+			assert.GreaterOrEqual(t, found.QuantityDecimals, 0)
+		}
+	})
+
+	t.Run("Fees", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetFees(0.001, 0.002)
+		a := newAdapter(t, m)
+
+		maker, taker, err := a.Fees(instrument)
+		if errors.Is(err, exchanges.ErrNotSupported) {
+			t.Skip("fees not supported")
+		}
+		require.NoError(t, err)
+
+		assert.InDelta(t, 0.001, maker, 1e-9)
+		assert.InDelta(t, 0.002, taker, 1e-9)
+	})
+
+	t.Run("Balance", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetBalance(m.Quote, 1234.5)
+		a := newAdapter(t, m)
+
+		bal, err := a.Balance(m.Quote)
+		require.NoError(t, err)
+		assert.InDelta(t, 1234.5, bal, 1e-6)
+
+		bal, err = a.Balance(m.Base)
+		require.NoError(t, err)
+		assert.Zero(t, bal)
+	})
+
+	t.Run("Buy", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetPrice(100)
+		m.SetBalance(m.Quote, 1000)
+		a := newAdapter(t, m)
+
+		res, err := a.Buy(instrument, 50, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, exchanges.OrderStatusFilled, res.Status())
+		assert.NotEmpty(t, res.OrderID())
+		assertApprox(t, 100, res.Price(), "price")
+
+		order, ok := m.Order(res.OrderID())
+		require.True(t, ok, "order ID not known by exchange")
+		assert.True(t, order.Buy)
+
+		assertApprox(t, order.Units-order.Fee, res.Units(), "units")
+		assertApprox(t, order.Fee, res.Fees(), "fees")
+		assert.Equal(t, order.FeeAsset, res.FeeAsset())
+
+		bal, err := a.Balance(m.Base)
+		require.NoError(t, err)
+		assertApprox(t, bal, res.Units(), "units received")
+	})
+
+	t.Run("Sell", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetPrice(200)
+		m.SetBalance(m.Base, 1)
+		a := newAdapter(t, m)
+
+		res, err := a.Sell(instrument, 200, 0.25)
+		require.NoError(t, err)
+
+		assert.Equal(t, exchanges.OrderStatusFilled, res.Status())
+		assertApprox(t, 200, res.Price(), "price")
+		assertApprox(t, 0.25, res.Units(), "units")
+
+		order, ok := m.Order(res.OrderID())
+		require.True(t, ok, "order ID not known by exchange")
+		assert.False(t, order.Buy)
+
+		assertApprox(t, order.Fee, res.Fees(), "fees")
+		assert.Equal(t, m.Quote, res.FeeAsset())
+	})
+
+	t.Run("OrderStatus", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetBalance(m.Base, 1)
+		a := newAdapter(t, m)
+
+		placed, err := a.Sell(instrument, 100, 0.5)
+		require.NoError(t, err)
+
+		res, err := a.OrderStatus(instrument, placed.OrderID())
+		require.NoError(t, err)
+
+		assert.Equal(t, placed.OrderID(), res.OrderID())
+		assert.Equal(t, exchanges.OrderStatusFilled, res.Status())
+		assertApprox(t, 0.5, res.Units(), "units")
+		assertApprox(t, 100, res.Price(), "price")
+
+		m.SetPrice(100)
+		m.SetBalance(m.Quote, 1000)
+
+		bought, err := a.Buy(instrument, 50, 0)
+		require.NoError(t, err)
+
+		res, err = a.OrderStatus(instrument, bought.OrderID())
+		require.NoError(t, err)
+
+		//querying an order reports the same net units and fees as placing it
+		assert.Equal(t, bought.Units(), res.Units())
+		assert.Equal(t, bought.Price(), res.Price())
+		fees, _ := strconv.ParseFloat(bought.Fees(), 64)
+		assertApprox(t, fees, res.Fees(), "fees")
+		assert.Equal(t, bought.FeeAsset(), res.FeeAsset())
+
+		_, err = a.OrderStatus(instrument, "999999999")
+		assert.Error(t, err, "unknown order should error")
+	})
+
+	t.Run("CancelFilled", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetBalance(m.Base, 1)
+		a := newAdapter(t, m)
+
+		placed, err := a.Sell(instrument, 100, 0.5)
+		require.NoError(t, err)
+
+		//filled orders may either reject the cancellation or report their final state
+		res, err := a.CancelOrder(instrument, placed.OrderID())
+		if err == nil {
+			assert.True(t, res.Status().Terminal())
+		}
+	})
+
+	t.Run("SubscribeTrades", func(t *testing.T) {
+		m := NewMarket(instrument)
+		m.SetPrice(123.5)
+		a := newAdapter(t, m)
+
+		require.NoError(t, a.SetTradeInstruments([]string{instrument}))
+
+		trades, err := a.SubscribeTradesAll()
+		require.NoError(t, err)
+
+		ts := time.Now().Unix() * 1000
+
+		trade := waitTrade(t, m, trades, ts, tradeTimeout)
+		if assert.NotNil(t, trade, "timed out waiting for trade") {
+			assert.NotEmpty(t, trade.Market)
+			assert.Equal(t, instrument, trade.Instrument)
+			assert.NotEmpty(t, trade.TradeID)
+			assert.InDelta(t, 123.5, trade.Amount, 0.01)
+			assert.InDelta(t, 0.75, trade.Units, 0.01)
+			assert.Equal(t, ts, trade.Timestamp)
+		}
+	})
+
+	t.Run("SetTradeInstruments", func(t *testing.T) {
+		m := NewMarket(instrument)
+		a := newAdapter(t, m)
+
+		require.NoError(t, a.SetTradeInstruments([]string{}))
+
+		trades, err := a.SubscribeTradesAll()
+		require.NoError(t, err)
+
+		ts := time.Now().Unix() * 1000
+
+		//wait past the connection delay to ensure nothing is subscribed
+		trade := waitTrade(t, m, trades, ts, 2*time.Second)
+		assert.Nil(t, trade, "received trade for uncollected instrument")
+
+		require.NoError(t, a.SetTradeInstruments([]string{instrument}))
+
+		trade = waitTrade(t, m, trades, ts, tradeTimeout)
+		assert.NotNil(t, trade, "timed out waiting for trade after resubscribing")
+	})
+}
+
+//waitTrade repeatedly publishes trades until one is received or the timeout passes
+func waitTrade(t *testing.T, m *Market, trades <-chan *ticks.Trade, ts int64, wait time.Duration) *ticks.Trade {
+	t.Helper()
+
+	publish := time.NewTicker(100 * time.Millisecond)
+	defer publish.Stop()
+	timeout := time.After(wait)
+
+	for {
+		select {
+		case <-publish.C:
+			m.Publish(0.75, true, ts)
+		case trade := <-trades:
+			return trade
+		case <-timeout:
+			return nil
+		}
+	}
+}
+
+//assertApprox asserts the formatted number is within tolerance of the expected value
+func assertApprox(t *testing.T, expected float64, actual string, field string) {
+	t.Helper()
+
+	v, err := strconv.ParseFloat(actual, 64)
+	if !assert.NoError(t, err, "%s is not a number", field) {
+		return
+	}
+
+	diff := math.Abs(v - expected)
+	if expected != 0 {
+		diff /= math.Abs(expected)
+	}
+
+	assert.LessOrEqual(t, diff, tolerance, "%s: expected %f got %f", field, expected, v)
+}
@@ -0,0 +1,40 @@
+package exchanges
+
+import "strings"
+
+var (
+	quoteAssets = []string{
+		"USDT",
+		"BUSD",
+		"USDC",
+		"AUD",
+		"USD",
+		"BTC",
+		"ETH",
+		"BNB",
+		"CRO",
+	}
+)
+
+//SplitInstrument splits an instrument into its base and quote assets.
+//Instruments may either be separated by an underscore (e.g. ETH_USDT) or
+//concatenated (e.g. BTCAUD) in which case the known quote assets are used
+func SplitInstrument(instrument string) (string, string) {
+	instrument = strings.ToUpper(instrument)
+
+	if parts := strings.SplitN(instrument, "_", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+
+	for _, q := range quoteAssets {
+		if strings.HasSuffix(instrument, q) && len(instrument) > len(q) {
+			return instrument[:len(instrument)-len(q)], q
+		}
+	}
+
+	if len(instrument) <= 3 {
+		return instrument, ""
+	}
+
+	return instrument[:len(instrument)-3], instrument[len(instrument)-3:]
+}
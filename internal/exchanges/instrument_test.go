@@ -0,0 +1,27 @@
+package exchanges
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitInstrument(t *testing.T) {
+	tests := []struct {
+		instrument string
+		base       string
+		quote      string
+	}{
+		{"btcaud", "BTC", "AUD"},
+		{"ETHUSDT", "ETH", "USDT"},
+		{"ETH_USDT", "ETH", "USDT"},
+		{"DOGEAUD", "DOGE", "AUD"},
+		{"XYZABC", "XYZ", "ABC"},
+	}
+
+	for _, test := range tests {
+		base, quote := SplitInstrument(test.instrument)
+		assert.Equal(t, test.base, base, test.instrument)
+		assert.Equal(t, test.quote, quote, test.instrument)
+	}
+}
@@ -0,0 +1,148 @@
+package exchanges
+
+import (
+	"sort"
+	"sync"
+)
+
+//PriceLevel total quantity available at a price
+type PriceLevel struct {
+	Price    float64
+	Quantity float64
+}
+
+//BookSnapshot the top levels of an order book
+type BookSnapshot struct {
+	Market     string
+	Instrument string
+
+	//Bids best (highest) price first
+	Bids []PriceLevel
+
+	//Asks best (lowest) price first
+	Asks []PriceLevel
+
+	//Timestamp in milliseconds
+	Timestamp int64
+}
+
+//BestBid highest bid price, 0 if there are no bids
+func (b *BookSnapshot) BestBid() float64 {
+	if len(b.Bids) == 0 {
+		return 0
+	}
+	return b.Bids[0].Price
+}
+
+//BestAsk lowest ask price, 0 if there are no asks
+func (b *BookSnapshot) BestAsk() float64 {
+	if len(b.Asks) == 0 {
+		return 0
+	}
+	return b.Asks[0].Price
+}
+
+//Spread difference between the best ask and bid, 0 if either side is empty
+func (b *BookSnapshot) Spread() float64 {
+	if len(b.Bids) == 0 || len(b.Asks) == 0 {
+		return 0
+	}
+	return b.BestAsk() - b.BestBid()
+}
+
+//Mid price between the best ask and bid, 0 if either side is empty
+func (b *BookSnapshot) Mid() float64 {
+	if len(b.Bids) == 0 || len(b.Asks) == 0 {
+		return 0
+	}
+	return (b.BestAsk() + b.BestBid()) / 2
+}
+
+//Imbalance of bid and ask quantity across the snapshot levels between -1 (all asks)
+//and 1 (all bids)
+func (b *BookSnapshot) Imbalance() float64 {
+	var bids, asks float64
+
+	for _, l := range b.Bids {
+		bids += l.Quantity
+	}
+	for _, l := range b.Asks {
+		asks += l.Quantity
+	}
+
+	if bids+asks == 0 {
+		return 0
+	}
+
+	return (bids - asks) / (bids + asks)
+}
+
+//OrderBook maintains the levels of an order book from snapshots and incremental updates
+type OrderBook struct {
+	mu   sync.Mutex
+	bids map[float64]float64
+	asks map[float64]float64
+}
+
+//NewOrderBook creates an empty order book
+func NewOrderBook() *OrderBook {
+	return &OrderBook{
+		bids: map[float64]float64{},
+		asks: map[float64]float64{},
+	}
+}
+
+//Reset removes all levels
+func (ob *OrderBook) Reset() {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	ob.bids = map[float64]float64{}
+	ob.asks = map[float64]float64{}
+}
+
+//Set sets the total quantity at a price level. A quantity of 0 removes the level
+func (ob *OrderBook) Set(bid bool, price, quantity float64) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	side := ob.asks
+	if bid {
+		side = ob.bids
+	}
+
+	if quantity <= 0 {
+		delete(side, price)
+		return
+	}
+
+	side[price] = quantity
+}
+
+//Snapshot provides the top n levels of each side
+func (ob *OrderBook) Snapshot(n int) (bids []PriceLevel, asks []PriceLevel) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	return topLevels(ob.bids, n, true), topLevels(ob.asks, n, false)
+}
+
+func topLevels(side map[float64]float64, n int, desc bool) []PriceLevel {
+	levels := make([]PriceLevel, 0, len(side))
+	for price, quantity := range side {
+		levels = append(levels, PriceLevel{price, quantity})
+	}
+
+	sort.Slice(levels, func(i, j int) bool {
+		if desc {
+			return levels[i].Price > levels[j].Price
+		}
+		return levels[i].Price < levels[j].Price
+	})
+
+	if n > 0 && len(levels) > n {
+		levels = levels[:n]
+	}
+
+	return levels
+}
@@ -0,0 +1,36 @@
+package exchanges
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderBook(t *testing.T) {
+	ob := NewOrderBook()
+
+	ob.Set(true, 99, 1)
+	ob.Set(true, 100, 2)
+	ob.Set(true, 98, 5)
+	ob.Set(false, 101, 1)
+	ob.Set(false, 102, 1)
+
+	//remove level
+	ob.Set(true, 98, 0)
+
+	bids, asks := ob.Snapshot(5)
+	assert.Equal(t, []PriceLevel{{100, 2}, {99, 1}}, bids)
+	assert.Equal(t, []PriceLevel{{101, 1}, {102, 1}}, asks)
+
+	bids, _ = ob.Snapshot(1)
+	assert.Len(t, bids, 1)
+
+	snap := &BookSnapshot{Bids: []PriceLevel{{100, 2}, {99, 1}}, Asks: []PriceLevel{{101, 1}}}
+	assert.Equal(t, 1.0, snap.Spread())
+	assert.Equal(t, 100.5, snap.Mid())
+	assert.Equal(t, 0.5, snap.Imbalance())
+
+	empty := &BookSnapshot{}
+	assert.Zero(t, empty.Spread())
+	assert.Zero(t, empty.Imbalance())
+}
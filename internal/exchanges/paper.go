@@ -0,0 +1,25 @@
+package exchanges
+
+import "strings"
+
+const (
+	//PaperMarket simulated market which fills against live trades of another market
+	PaperMarket = "paper"
+
+	defaultPaperPriceMarket = "binance.com"
+)
+
+//IsPaperMarket checks if the market is simulated, either "paper" or "paper.<market>"
+func IsPaperMarket(market string) bool {
+	return market == PaperMarket || strings.HasPrefix(market, PaperMarket+".")
+}
+
+//PriceMarket provides the market used for trade data of the given market.
+//Paper markets are priced against the live market they are simulating
+func PriceMarket(market string) string {
+	if market == PaperMarket {
+		return defaultPaperPriceMarket
+	}
+
+	return strings.TrimPrefix(market, PaperMarket+".")
+}
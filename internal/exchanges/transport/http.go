@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	//maxResponseSize limits the size of REST responses read from an exchange
+	maxResponseSize = 50 << 20
+
+	defaultHTTPTimeout = 30 * time.Second
+)
+
+//NewHTTPClient creates a HTTP client with defaults suitable for exchange REST APIs
+func NewHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: defaultHTTPTimeout,
+	}
+}
+
+//HTTPError a non-2xx response which could not be decoded into an exchange specific error
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("unexpected http resp %d: %s", e.StatusCode, e.Body)
+}
+
+//DoJSON performs the request and decodes the JSON response body into out.
+//Non-2xx responses are decoded into errOut if provided and it is returned as the
+//error, otherwise a HTTPError is returned
+func DoJSON(c *http.Client, req *http.Request, out interface{}, errOut error) error {
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if errOut != nil && json.Unmarshal(body, errOut) == nil {
+			return errOut
+		}
+
+		return &HTTPError{StatusCode: resp.StatusCode, Body: string(bytes.TrimSpace(body))}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+//SignHMACSHA256 hex encoded HMAC-SHA256 signature of the payload
+func SignHMACSHA256(secret []byte, payload []byte) string {
+	h := hmac.New(sha256.New, secret)
+	h.Write(payload)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
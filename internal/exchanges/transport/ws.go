@@ -0,0 +1,201 @@
+package transport
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultReadTimeout    = 40 * time.Second
+	defaultReconnectDelay = 5 * time.Second
+	defaultReadLimit      = 10 << 20
+)
+
+var (
+	//ErrClosed the connection has been closed and will not reconnect
+	ErrClosed = errors.New("connection closed")
+)
+
+//WSConfig configures a reconnecting websocket connection
+type WSConfig struct {
+	//Endpoint websocket URL to dial
+	Endpoint string
+
+	//OnConnect is called after every successful (re)connection before messages are read,
+	//typically used to (re)send subscriptions
+	OnConnect func(c *WSConn) error
+
+	//OnMessage is called with each message read from the connection
+	OnMessage func(c *WSConn, msg []byte)
+
+	//ReadTimeout max time between messages before the connection is considered dead
+	ReadTimeout time.Duration
+
+	//ReconnectDelay time to wait between reconnection attempts
+	ReconnectDelay time.Duration
+
+	//ConnectDelay time to wait after connecting before calling OnConnect, as some
+	//exchanges rate limit requests made immediately after connecting
+	ConnectDelay time.Duration
+
+	Log logrus.FieldLogger
+}
+
+//WSConn a websocket connection which automatically reconnects and resubscribes
+type WSConn struct {
+	cfg WSConfig
+
+	conn *websocket.Conn
+	wmu  sync.Mutex
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+//DialWS connects to the websocket endpoint and starts reading messages
+func DialWS(cfg WSConfig) (*WSConn, error) {
+	if cfg.ReadTimeout == 0 {
+		cfg.ReadTimeout = defaultReadTimeout
+	}
+	if cfg.ReconnectDelay == 0 {
+		cfg.ReconnectDelay = defaultReconnectDelay
+	}
+	if cfg.Log == nil {
+		cfg.Log = logrus.New()
+	}
+
+	c := &WSConn{
+		cfg:    cfg,
+		closed: make(chan struct{}),
+	}
+
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+
+	go c.readPump()
+
+	return c, nil
+}
+
+func (c *WSConn) connect() error {
+	conn, _, err := websocket.DefaultDialer.Dial(c.cfg.Endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	conn.SetReadLimit(defaultReadLimit)
+
+	//Close may have been called while dialling, in which case it has already closed
+	//the previous connection and won't see this one
+	c.wmu.Lock()
+	if c.isClosed() {
+		c.wmu.Unlock()
+		conn.Close()
+		return ErrClosed
+	}
+	c.conn = conn
+	c.wmu.Unlock()
+
+	if c.cfg.ConnectDelay > 0 {
+		time.Sleep(c.cfg.ConnectDelay)
+	}
+
+	if c.cfg.OnConnect != nil {
+		if err := c.cfg.OnConnect(c); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	return nil
+}
+
+//isClosed checks if Close has been called
+func (c *WSConn) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+//WriteJSON sends a JSON encoded message. Writes are safe to call concurrently
+func (c *WSConn) WriteJSON(v interface{}) error {
+	if c.isClosed() {
+		return ErrClosed
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	return c.conn.WriteJSON(v)
+}
+
+//Close closes the connection and stops any reconnection attempts
+func (c *WSConn) Close() error {
+	var err error
+
+	c.closeOnce.Do(func() {
+		close(c.closed)
+
+		c.wmu.Lock()
+		err = c.conn.Close()
+		c.wmu.Unlock()
+	})
+
+	return err
+}
+
+func (c *WSConn) readPump() {
+	for {
+		c.conn.SetReadDeadline(time.Now().Add(c.cfg.ReadTimeout))
+
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			if c.isClosed() {
+				return
+			}
+
+			c.cfg.Log.WithError(err).Warnf("websocket read failed %s", c.cfg.Endpoint)
+
+			if !c.reconnect() {
+				return
+			}
+			continue
+		}
+
+		if c.cfg.OnMessage != nil {
+			c.cfg.OnMessage(c, msg)
+		}
+	}
+}
+
+//reconnect attempts to reconnect until successful or the connection is closed
+func (c *WSConn) reconnect() bool {
+	c.conn.Close()
+
+	for {
+		select {
+		case <-c.closed:
+			return false
+		case <-time.After(c.cfg.ReconnectDelay):
+		}
+
+		err := c.connect()
+		if err == nil {
+			c.cfg.Log.Infof("reconnected %s", c.cfg.Endpoint)
+			return true
+		}
+		if err == ErrClosed {
+			return false
+		}
+
+		c.cfg.Log.WithError(err).Warnf("failed to reconnect %s", c.cfg.Endpoint)
+	}
+}
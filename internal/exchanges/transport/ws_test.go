@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSCloseDuringReconnect(t *testing.T) {
+	var conns int32
+	dialling := make(chan struct{})
+	reconnected := make(chan *websocket.Conn, 1)
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&conns, 1)
+		if n == 2 {
+			//hold the reconnection open until Close has been called
+			close(dialling)
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		if n == 1 {
+			conn.Close()
+			return
+		}
+
+		reconnected <- conn
+	}))
+	defer srv.Close()
+
+	c, err := DialWS(WSConfig{
+		Endpoint:       "ws" + strings.TrimPrefix(srv.URL, "http"),
+		ReconnectDelay: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	<-dialling
+	c.Close()
+
+	select {
+	case conn := <-reconnected:
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, _, err := conn.ReadMessage()
+		assert.Error(t, err, "connection made after close should be closed")
+		assert.False(t, isTimeout(err), "connection was left open")
+	case <-time.After(time.Second):
+		t.Fatal("reconnection not attempted")
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&conns))
+}
+
+func isTimeout(err error) bool {
+	ne, ok := err.(net.Error)
+	return ok && ne.Timeout()
+}
@@ -5,21 +5,32 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 
 	"github.com/spf13/viper"
 	"golang.org/x/crypto/argon2"
 )
 
-//TODO(tcfw): make per-user enc key
-func key(account string) ([]byte, error) {
+const (
+	dataKeySize = 32
+
+	//legacyKeyVersion marks secrets encrypted directly with a key derived from
+	//excreds.key before data keys were introduced
+	legacyKeyVersion = 0
+)
+
+var (
+	errShortCipherText = errors.New("cipher text too short")
+)
+
+//legacyKey derives the key secrets were encrypted with before envelope encryption
+func legacyKey(account string) ([]byte, error) {
 	masterStr := viper.GetString("excreds.key")
 	master, err := hex.DecodeString(masterStr)
 	if err != nil {
 		return nil, err
 	}
 
-	// master = append(master, []byte(account)...)
-
 	key := argon2.Key(master, []byte(account), 3, 32*1024, 4, 32)
 	return key, nil
 }
@@ -38,25 +49,75 @@ func newCrypto(k []byte) (cipher.AEAD, error) {
 	return aead, nil
 }
 
-func (s *Server) decryptSecret(account, secret string) (string, error) {
-	k, err := key(account)
+//seal encrypts the plain text with a random nonce prepended to the cipher text
+func seal(k []byte, plainText []byte, ad []byte) ([]byte, error) {
+	aead, err := newCrypto(k)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plainText, ad), nil
+}
+
+//open decrypts data produced by seal
+func open(k []byte, data []byte, ad []byte) ([]byte, error) {
 	aead, err := newCrypto(k)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	nLen := aead.NonceSize()
+	if len(data) < nLen {
+		return nil, errShortCipherText
+	}
+
+	return aead.Open(nil, data[:nLen], data[nLen:], ad)
+}
+
+//encryptSecret encrypts the secret with a new random data key, wrapping the data
+//key with the current master key. Both are bound to the account
+func (s *Server) encryptSecret(account, plainText string) (string, string, uint32, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", "", 0, err
+	}
+
+	secret, err := seal(dataKey, []byte(plainText), []byte(account))
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	wrapped, version, err := s.wrapKey(account, dataKey)
+	if err != nil {
+		return "", "", 0, err
 	}
 
+	return hex.EncodeToString(secret), wrapped, version, nil
+}
+
+//decryptSecret decrypts a secret using its wrapped data key
+func (s *Server) decryptSecret(account, secret, wrappedKey string, version uint32) (string, error) {
 	data, err := hex.DecodeString(secret)
 	if err != nil {
 		return "", err
 	}
 
-	nLen := aead.NonceSize()
+	var k []byte
+	if version == legacyKeyVersion {
+		k, err = legacyKey(account)
+	} else {
+		k, err = s.unwrapKey(account, wrappedKey, version)
+	}
+	if err != nil {
+		return "", err
+	}
 
-	secretText, err := aead.Open(nil, data[:nLen], data[nLen:], []byte(account))
+	secretText, err := open(k, data, []byte(account))
 	if err != nil {
 		return "", err
 	}
@@ -64,29 +125,57 @@ func (s *Server) decryptSecret(account, secret string) (string, error) {
 	return string(secretText), nil
 }
 
-func (s *Server) encryptSecret(account, plainText string) (string, error) {
-	k, err := key(account)
+//rewrapSecret wraps the data key of a secret with the current master key. Legacy
+//secrets are re-encrypted with a new data key
+func (s *Server) rewrapSecret(account, secret, wrappedKey string, version uint32) (string, string, uint32, error) {
+	if version == legacyKeyVersion {
+		plainText, err := s.decryptSecret(account, secret, wrappedKey, version)
+		if err != nil {
+			return "", "", 0, err
+		}
+
+		return s.encryptSecret(account, plainText)
+	}
+
+	dataKey, err := s.unwrapKey(account, wrappedKey, version)
 	if err != nil {
-		return "", err
+		return "", "", 0, err
 	}
 
-	aead, err := newCrypto(k)
+	wrapped, newVersion, err := s.wrapKey(account, dataKey)
 	if err != nil {
-		return "", err
+		return "", "", 0, err
 	}
 
-	nLen := aead.NonceSize()
+	return secret, wrapped, newVersion, nil
+}
 
-	data := make([]byte, nLen+len(plainText)+aead.Overhead())
+//wrapKey encrypts a data key with the current master key
+func (s *Server) wrapKey(account string, dataKey []byte) (string, uint32, error) {
+	version, kek, err := s.kek.Current()
+	if err != nil {
+		return "", 0, err
+	}
 
-	_, err = rand.Read(data[:nLen])
+	wrapped, err := seal(kek, dataKey, []byte(account))
 	if err != nil {
-		return "", err
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(wrapped), version, nil
+}
+
+//unwrapKey decrypts a data key with the master key version it was wrapped with
+func (s *Server) unwrapKey(account, wrappedKey string, version uint32) ([]byte, error) {
+	kek, err := s.kek.Key(version)
+	if err != nil {
+		return nil, err
 	}
 
-	encD := aead.Seal(nil, data[:nLen], []byte(plainText), []byte(account))
-	copy(data[nLen:], encD)
-	secret := hex.EncodeToString(data)
+	data, err := hex.DecodeString(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
 
-	return secret, nil
+	return open(kek, data, []byte(account))
 }
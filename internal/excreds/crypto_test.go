@@ -2,27 +2,148 @@ package excreds
 
 import (
 	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEncDec(t *testing.T) {
-	viper.Set("excreds.key", hex.EncodeToString([]byte("test")))
+	kek, err := newStaticKEK(hex.EncodeToString([]byte("test")))
+	require.NoError(t, err)
+
 	account := "abcdef"
 
-	s := &Server{}
+	s := &Server{kek: kek}
 
-	secret, err := s.encryptSecret(account, "test")
+	secret, dataKey, version, err := s.encryptSecret(account, "test")
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	pt, err := s.decryptSecret(account, secret)
+	pt, err := s.decryptSecret(account, secret, dataKey, version)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	assert.Equal(t, "test", pt)
+
+	_, err = s.decryptSecret("other", secret, dataKey, version)
+	assert.Error(t, err, "data key is bound to the account")
+}
+
+func writeKEK(t *testing.T, dir string, version string) {
+	key := make([]byte, kekSize)
+	key[0] = version[0]
+
+	err := ioutil.WriteFile(filepath.Join(dir, version+kekFileExt), []byte(hex.EncodeToString(key)+"\n"), 0600)
+	require.NoError(t, err)
+}
+
+func TestRewrap(t *testing.T) {
+	viper.Set("excreds.key", hex.EncodeToString([]byte("test")))
+	account := "abcdef"
+
+	dir, err := ioutil.TempDir("", "kek")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeKEK(t, dir, "1")
+
+	kek, err := newFileKEK(dir)
+	require.NoError(t, err)
+
+	s := &Server{kek: kek}
+
+	//legacy secrets are re-encrypted with a data key
+	lk, err := legacyKey(account)
+	require.NoError(t, err)
+	legacy, err := seal(lk, []byte("legacy"), []byte(account))
+	require.NoError(t, err)
+
+	secret, dataKey, version, err := s.rewrapSecret(account, hex.EncodeToString(legacy), "", legacyKeyVersion)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), version)
+
+	pt, err := s.decryptSecret(account, secret, dataKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", pt)
+
+	//rotating keeps the secret and only rewraps the data key
+	writeKEK(t, dir, "2")
+	require.NoError(t, kek.Reload())
+
+	rSecret, rDataKey, rVersion, err := s.rewrapSecret(account, secret, dataKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), rVersion)
+	assert.Equal(t, secret, rSecret)
+	assert.NotEqual(t, dataKey, rDataKey)
+
+	pt, err = s.decryptSecret(account, rSecret, rDataKey, rVersion)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", pt)
+
+	//previous versions can still be read
+	pt, err = s.decryptSecret(account, secret, dataKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", pt)
+}
+
+func TestStaticToFileKEK(t *testing.T) {
+	masterStr := hex.EncodeToString([]byte("test"))
+	account := "abcdef"
+
+	static, err := newStaticKEK(masterStr)
+	require.NoError(t, err)
+
+	secret, dataKey, version, err := (&Server{kek: static}).encryptSecret(account, "test")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(staticKEKVersion), version)
+
+	dir, err := ioutil.TempDir("", "kek")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeKEK(t, dir, "1")
+
+	viper.Set("excreds.kek.dir", dir)
+	viper.Set("excreds.key", masterStr)
+	defer viper.Set("excreds.kek.dir", "")
+
+	kek, err := newKEKProvider()
+	require.NoError(t, err)
+
+	s := &Server{kek: kek}
+
+	//secrets wrapped with the static key stay readable after switching
+	pt, err := s.decryptSecret(account, secret, dataKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, "test", pt)
+
+	rSecret, rDataKey, rVersion, err := s.rewrapSecret(account, secret, dataKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), rVersion)
+
+	pt, err = s.decryptSecret(account, rSecret, rDataKey, rVersion)
+	require.NoError(t, err)
+	assert.Equal(t, "test", pt)
+}
+
+func TestFileKEKInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kek")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = newFileKEK(dir)
+	assert.Error(t, err, "no keys")
+
+	err = ioutil.WriteFile(filepath.Join(dir, "1"+kekFileExt), []byte("abcd"), 0600)
+	require.NoError(t, err)
+
+	_, err = newFileKEK(dir)
+	assert.Error(t, err, "short key")
 }
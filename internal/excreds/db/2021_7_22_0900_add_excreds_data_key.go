@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"add_excreds_data_key",
+		time.Date(2021, 7, 22, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			//key_version 0 marks secrets still encrypted with the legacy per-account key
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS data_key STRING NOT NULL DEFAULT '';
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS key_version INT NOT NULL DEFAULT 0;
+				CREATE INDEX IF NOT EXISTS keyversionidx ON excreds (key_version);
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				DROP INDEX IF EXISTS excreds@keyversionidx;
+				ALTER TABLE excreds DROP COLUMN IF EXISTS key_version;
+				ALTER TABLE excreds DROP COLUMN IF EXISTS data_key;
+			`)
+			return err
+		},
+	))
+}
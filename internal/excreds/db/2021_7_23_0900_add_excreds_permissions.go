@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"add_excreds_permissions",
+		time.Date(2021, 7, 23, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS perm_read BOOL NOT NULL DEFAULT false;
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS perm_spot_trade BOOL NOT NULL DEFAULT false;
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS perm_withdraw BOOL NOT NULL DEFAULT false;
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS checked_at TIMESTAMPTZ;
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE excreds DROP COLUMN IF EXISTS checked_at;
+				ALTER TABLE excreds DROP COLUMN IF EXISTS perm_withdraw;
+				ALTER TABLE excreds DROP COLUMN IF EXISTS perm_spot_trade;
+				ALTER TABLE excreds DROP COLUMN IF EXISTS perm_read;
+			`)
+			return err
+		},
+	))
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"add_excreds_environment",
+		time.Date(2021, 7, 9, 10, 20, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS environment INT NOT NULL DEFAULT 0;
+				ALTER TABLE excreds ADD COLUMN IF NOT EXISTS endpoint STRING NOT NULL DEFAULT '';
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -2,14 +2,20 @@ package excreds
 
 import (
 	"context"
+	"database/sql"
+	"net/url"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gogo/status"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
 	excredsAPI "pm.tcfw.com.au/source/ataas/api/pb/excreds"
 	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
 	migrate "pm.tcfw.com.au/source/ataas/internal/excreds/db"
 	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
 )
@@ -21,6 +27,23 @@ const (
 var (
 	validEx = map[string]string{
 		"binance.com": "binance.com",
+		"crypto.com":  "crypto.com",
+	}
+
+	//endpointHosts the production and testnet API hosts endpoints may be overridden with
+	endpointHosts = map[string][]string{
+		"binance.com": {
+			"api.binance.com",
+			"api1.binance.com",
+			"api2.binance.com",
+			"api3.binance.com",
+			"api4.binance.com",
+			"testnet.binance.vision",
+		},
+		"crypto.com": {
+			"api.crypto.com",
+			"uat-api.3ona.co",
+		},
 	}
 )
 
@@ -32,6 +55,14 @@ var (
 		"key",
 		"secret",
 		"createdAt",
+		"environment",
+		"endpoint",
+		"data_key",
+		"key_version",
+		"perm_read",
+		"perm_spot_trade",
+		"perm_withdraw",
+		"checked_at",
 	}
 )
 
@@ -39,14 +70,21 @@ type Server struct {
 	excredsAPI.UnimplementedExCredsServiceServer
 
 	log *logrus.Logger
+	kek KEKProvider
 }
 
 func NewServer(ctx context.Context) (*Server, error) {
+	kek, err := newKEKProvider()
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Server{
 		log: logrus.New(),
+		kek: kek,
 	}
 
-	err := s.Migrate(ctx)
+	err = s.Migrate(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -75,21 +113,51 @@ func (s *Server) New(ctx context.Context, req *excredsAPI.ExchangeCreds) (*excre
 	}
 	req.Account = acn
 
+	if err := validateEndpoint(req.Exchange, req.Endpoint); err != nil {
+		return nil, err
+	}
+
 	_, err = s.Get(ctx, &excredsAPI.GetRequest{Account: acn, Exchange: req.Exchange})
 	if status.Code(err) == codes.OK {
 		return nil, status.Error(codes.AlreadyExists, "already exists")
 	}
 
-	req.Secret, err = s.encryptSecret(acn, req.Secret)
+	perms, err := checkCreds(req)
 	if err != nil {
 		return nil, err
 	}
 
-	q := db.Build().Insert(tblName).Columns("account", "exchange", "key", "secret").Values(
+	secret, dataKey, keyVersion, err := s.encryptSecret(acn, req.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Insert(tblName).Columns(
+		"account",
+		"exchange",
+		"key",
+		"secret",
+		"environment",
+		"endpoint",
+		"data_key",
+		"key_version",
+		"perm_read",
+		"perm_spot_trade",
+		"perm_withdraw",
+		"checked_at",
+	).Values(
 		acn,
 		req.Exchange,
 		req.Key,
-		req.Secret,
+		secret,
+		int32(req.Environment),
+		req.Endpoint,
+		dataKey,
+		keyVersion,
+		perms.Read,
+		perms.SpotTrade,
+		perms.Withdraw,
+		sq.Expr("NOW()"),
 	)
 
 	err = db.SimpleExec(ctx, q)
@@ -99,8 +167,20 @@ func (s *Server) New(ctx context.Context, req *excredsAPI.ExchangeCreds) (*excre
 
 	//read back
 	v, err := s.Get(ctx, &excredsAPI.GetRequest{Account: acn, Exchange: req.Exchange})
+	if err != nil {
+		return nil, err
+	}
 	v.Secret = ""
-	return v, err
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionExCredsCreated,
+		Account: acn,
+		Target:  v.Id,
+		Success: true,
+		Detail:  v.Exchange,
+	})
+
+	return v, nil
 }
 
 func (s *Server) List(ctx context.Context, req *excredsAPI.ListRequest) (*excredsAPI.ListResponse, error) {
@@ -109,7 +189,17 @@ func (s *Server) List(ctx context.Context, req *excredsAPI.ListRequest) (*excred
 		return nil, err
 	}
 
-	q := db.Build().Select("exchange", "key").From(tblName).Where(sq.Eq{"account": acn})
+	q := db.Build().Select(
+		"id",
+		"exchange",
+		"key",
+		"environment",
+		"endpoint",
+		"perm_read",
+		"perm_spot_trade",
+		"perm_withdraw",
+		"checked_at",
+	).From(tblName).Where(sq.Eq{"account": acn})
 
 	res, done, err := db.SimpleQuery(ctx, q)
 	if err != nil {
@@ -120,26 +210,85 @@ func (s *Server) List(ctx context.Context, req *excredsAPI.ListRequest) (*excred
 	creds := []*excredsAPI.ExchangeCreds{}
 
 	for res.Next() {
-		cred := &excredsAPI.ExchangeCreds{}
+		cred := &excredsAPI.ExchangeCreds{Permissions: &excredsAPI.Permissions{}}
+		var env int32
+		var checkedAt sql.NullTime
 		err := res.Scan(
+			&cred.Id,
 			&cred.Exchange,
 			&cred.Key,
+			&env,
+			&cred.Endpoint,
+			&cred.Permissions.Read,
+			&cred.Permissions.SpotTrade,
+			&cred.Permissions.Withdraw,
+			&checkedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
+		cred.Environment = excredsAPI.Environment(env)
+		if checkedAt.Valid {
+			cred.CheckedAt = checkedAt.Time.Format(time.RFC3339)
+		}
 		creds = append(creds, cred)
 	}
 
 	return &excredsAPI.ListResponse{Creds: creds}, nil
 }
 
+//Delete removes exchange credentials from the account
 func (s *Server) Delete(ctx context.Context, req *excredsAPI.DeleteRequest) (*excredsAPI.DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+	acn, err := passportUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := db.Exec(ctx, tx, db.Build().Delete(tblName).Where(sq.Eq{"id": req.Id, "account": acn}))
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionExCredsDeleted,
+		Account: acn,
+		Target:  req.Id,
+		Success: true,
+	})
+
+	return &excredsAPI.DeleteResponse{}, nil
 }
 
 func (s *Server) Get(ctx context.Context, req *excredsAPI.GetRequest) (*excredsAPI.ExchangeCreds, error) {
-	q := db.Build().Select(allColumns...).From(tblName).Where(sq.Eq{"account": req.Account, "exchange": req.Exchange}).Limit(1)
+	return s.get(ctx, sq.Eq{"account": req.Account, "exchange": req.Exchange}, req.Decrypt)
+}
+
+//get fetches a single set of credentials, optionally decrypting the secret
+func (s *Server) get(ctx context.Context, where sq.Eq, decrypt bool) (*excredsAPI.ExchangeCreds, error) {
+	q := db.Build().Select(allColumns...).From(tblName).Where(where).Limit(1)
 	res, done, err := db.SimpleQuery(ctx, q)
 	if err != nil {
 		return nil, err
@@ -150,8 +299,12 @@ func (s *Server) Get(ctx context.Context, req *excredsAPI.GetRequest) (*excredsA
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	cred := &excredsAPI.ExchangeCreds{}
+	cred := &excredsAPI.ExchangeCreds{Permissions: &excredsAPI.Permissions{}}
 	var createdAt time.Time
+	var checkedAt sql.NullTime
+	var env int32
+	var dataKey string
+	var keyVersion uint32
 
 	err = res.Scan(
 		&cred.Id,
@@ -160,15 +313,32 @@ func (s *Server) Get(ctx context.Context, req *excredsAPI.GetRequest) (*excredsA
 		&cred.Key,
 		&cred.Secret,
 		&createdAt,
+		&env,
+		&cred.Endpoint,
+		&dataKey,
+		&keyVersion,
+		&cred.Permissions.Read,
+		&cred.Permissions.SpotTrade,
+		&cred.Permissions.Withdraw,
+		&checkedAt,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	cred.CreatedAt = createdAt.Format(time.RFC3339)
+	cred.Environment = excredsAPI.Environment(env)
+	if checkedAt.Valid {
+		cred.CheckedAt = checkedAt.Time.Format(time.RFC3339)
+	}
+
+	if decrypt {
+		//Credentials stored before endpoints were restricted must not be sent elsewhere
+		if err := validateEndpoint(cred.Exchange, cred.Endpoint); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, "credentials use an endpoint which is no longer allowed")
+		}
 
-	if req.Decrypt {
-		cred.Secret, err = s.decryptSecret(req.Account, cred.Secret)
+		cred.Secret, err = s.decryptSecret(cred.Account, cred.Secret, dataKey, keyVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -176,3 +346,32 @@ func (s *Server) Get(ctx context.Context, req *excredsAPI.GetRequest) (*excredsA
 
 	return cred, nil
 }
+
+//validateEndpoint ensures endpoint overrides only point at the known API hosts of
+//the exchange over TLS, so signed requests are never sent elsewhere
+func validateEndpoint(exchange, endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || u.User != nil {
+		return status.Error(codes.InvalidArgument, "invalid endpoint")
+	}
+
+	if u.Scheme != "https" {
+		return status.Error(codes.InvalidArgument, "endpoint must use https")
+	}
+
+	if port := u.Port(); port != "" && port != "443" {
+		return status.Error(codes.InvalidArgument, "endpoint not allowed")
+	}
+
+	for _, host := range endpointHosts[exchange] {
+		if strings.EqualFold(u.Hostname(), host) {
+			return nil
+		}
+	}
+
+	return status.Error(codes.InvalidArgument, "endpoint not allowed")
+}
@@ -0,0 +1,20 @@
+package excreds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEndpoint(t *testing.T) {
+	assert.NoError(t, validateEndpoint("binance.com", ""))
+	assert.NoError(t, validateEndpoint("binance.com", "https://testnet.binance.vision"))
+	assert.NoError(t, validateEndpoint("binance.com", "https://API1.binance.com:443"))
+	assert.NoError(t, validateEndpoint("crypto.com", "https://uat-api.3ona.co/v2/"))
+	assert.Error(t, validateEndpoint("binance.com", "http://testnet.binance.vision"))
+	assert.Error(t, validateEndpoint("binance.com", "not a url"))
+	assert.Error(t, validateEndpoint("binance.com", "https://internal.example.com"))
+	assert.Error(t, validateEndpoint("binance.com", "https://testnet.binance.vision:8443"))
+	assert.Error(t, validateEndpoint("binance.com", "https://user@testnet.binance.vision"))
+	assert.Error(t, validateEndpoint("crypto.com", "https://testnet.binance.vision"))
+}
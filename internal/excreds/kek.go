@@ -0,0 +1,204 @@
+package excreds
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	kekSize = 32
+
+	kekFileExt = ".key"
+
+	//staticKEKVersion is reserved for the key derived from excreds.key so it never
+	//collides with the versions of key files
+	staticKEKVersion = math.MaxUint32
+)
+
+//KEKProvider provides the versioned master keys used to wrap the data key of
+//each credential
+type KEKProvider interface {
+	//Current provides the version and key used to wrap new data keys
+	Current() (uint32, []byte, error)
+
+	//Key provides the key for a previous or current version
+	Key(version uint32) ([]byte, error)
+}
+
+//newKEKProvider creates the provider set by config. Keys are read from the
+//excreds.kek.dir directory if set, otherwise a single key is derived from excreds.key.
+//When both are set the derived key can still unwrap data keys, so switching to key
+//files only requires rotating
+func newKEKProvider() (KEKProvider, error) {
+	dir := viper.GetString("excreds.kek.dir")
+	masterStr := viper.GetString("excreds.key")
+
+	if dir == "" {
+		return newStaticKEK(masterStr)
+	}
+
+	k, err := newFileKEK(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if masterStr != "" {
+		static, err := newStaticKEK(masterStr)
+		if err != nil {
+			return nil, err
+		}
+		k.static = static
+	}
+
+	return k, nil
+}
+
+//staticKEK is a single version master key
+type staticKEK struct {
+	key []byte
+}
+
+func newStaticKEK(masterStr string) (*staticKEK, error) {
+	master, err := hex.DecodeString(masterStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(master) == 0 {
+		return nil, fmt.Errorf("missing excreds master key")
+	}
+
+	return &staticKEK{key: argon2.Key(master, []byte("kek"), 3, 32*1024, 4, kekSize)}, nil
+}
+
+func (k *staticKEK) Current() (uint32, []byte, error) {
+	return staticKEKVersion, k.key, nil
+}
+
+func (k *staticKEK) Key(version uint32) ([]byte, error) {
+	if version != staticKEKVersion {
+		return nil, fmt.Errorf("unknown key version %d", version)
+	}
+
+	return k.key, nil
+}
+
+//fileKEK reads hex encoded keys from a directory, one per file named by its
+//version (e.g. 2.key). The highest version is used to wrap new data keys, so
+//rotating is a matter of adding a new file and rewrapping
+type fileKEK struct {
+	dir string
+
+	//static unwraps data keys wrapped before switching from excreds.key
+	static *staticKEK
+
+	mu      sync.RWMutex
+	keys    map[uint32][]byte
+	current uint32
+}
+
+func newFileKEK(dir string) (*fileKEK, error) {
+	k := &fileKEK{dir: dir}
+
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+//Reload rereads the keys from the directory
+func (k *fileKEK) Reload() error {
+	files, err := ioutil.ReadDir(k.dir)
+	if err != nil {
+		return err
+	}
+
+	keys := map[uint32][]byte{}
+	var current uint32
+
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != kekFileExt {
+			continue
+		}
+
+		version, err := strconv.ParseUint(strings.TrimSuffix(f.Name(), kekFileExt), 10, 32)
+		if err != nil || version == 0 || version == staticKEKVersion {
+			return fmt.Errorf("invalid key file name %s", f.Name())
+		}
+
+		dat, err := ioutil.ReadFile(filepath.Join(k.dir, f.Name()))
+		if err != nil {
+			return err
+		}
+
+		key, err := hex.DecodeString(strings.TrimSpace(string(dat)))
+		if err != nil {
+			return fmt.Errorf("invalid key file %s: %s", f.Name(), err)
+		}
+		if len(key) != kekSize {
+			return fmt.Errorf("key file %s must be %d bytes", f.Name(), kekSize)
+		}
+
+		keys[uint32(version)] = key
+		if uint32(version) > current {
+			current = uint32(version)
+		}
+	}
+
+	if current == 0 {
+		return fmt.Errorf("no keys found in %s", k.dir)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys = keys
+	k.current = current
+
+	return nil
+}
+
+func (k *fileKEK) Current() (uint32, []byte, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.current, k.keys[k.current], nil
+}
+
+func (k *fileKEK) Key(version uint32) ([]byte, error) {
+	if version == staticKEKVersion && k.static != nil {
+		return k.static.key, nil
+	}
+
+	k.mu.RLock()
+	key, ok := k.keys[version]
+	k.mu.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	//Another instance may have rotated to a key added since the keys were read
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok = k.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown key version %d", version)
+	}
+
+	return key, nil
+}
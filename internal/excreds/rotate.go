@@ -0,0 +1,124 @@
+package excreds
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	excredsAPI "pm.tcfw.com.au/source/ataas/api/pb/excreds"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
+)
+
+const (
+	rotateBatchSize = 100
+)
+
+type wrappedCred struct {
+	id         string
+	account    string
+	secret     string
+	dataKey    string
+	keyVersion uint32
+}
+
+//RotateKeys rewraps the data key of every credential not using the current master
+//key, including legacy secrets. Credentials are updated one at a time so they can
+//still be read while rotating
+func (s *Server) RotateKeys(ctx context.Context, req *excredsAPI.RotateKeysRequest) (*excredsAPI.RotateKeysResponse, error) {
+	//Pick up any keys added since starting
+	if r, ok := s.kek.(interface{ Reload() error }); ok {
+		if err := r.Reload(); err != nil {
+			return nil, err
+		}
+	}
+
+	current, _, err := s.kek.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &excredsAPI.RotateKeysResponse{KeyVersion: current}
+	lastID := ""
+
+	for {
+		batch, err := outdatedCreds(ctx, current, lastID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cred := range batch {
+			if err := s.rewrapCred(ctx, cred); err != nil {
+				s.log.WithError(err).WithField("id", cred.id).Error("failed to rewrap exchange creds")
+				resp.Failed++
+				continue
+			}
+
+			resp.Rewrapped++
+		}
+
+		if len(batch) < rotateBatchSize {
+			break
+		}
+
+		lastID = batch[len(batch)-1].id
+	}
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionExCredsRotated,
+		Success: resp.Failed == 0,
+		Detail:  fmt.Sprintf("version %d: %d rewrapped, %d failed", current, resp.Rewrapped, resp.Failed),
+	})
+
+	return resp, nil
+}
+
+//outdatedCreds fetches the next batch of credentials not wrapped with the current
+//master key version, ordered by id
+func outdatedCreds(ctx context.Context, current uint32, after string) ([]*wrappedCred, error) {
+	q := db.Build().Select("id", "account", "secret", "data_key", "key_version").From(tblName).
+		Where(sq.NotEq{"key_version": current}).
+		OrderBy("id").
+		Limit(rotateBatchSize)
+
+	if after != "" {
+		q = q.Where(sq.Gt{"id": after})
+	}
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	batch := []*wrappedCred{}
+
+	for res.Next() {
+		cred := &wrappedCred{}
+		if err := res.Scan(&cred.id, &cred.account, &cred.secret, &cred.dataKey, &cred.keyVersion); err != nil {
+			return nil, err
+		}
+
+		batch = append(batch, cred)
+	}
+
+	return batch, res.Err()
+}
+
+//rewrapCred stores the credential rewrapped with the current master key, unless it
+//has been changed since it was read
+func (s *Server) rewrapCred(ctx context.Context, cred *wrappedCred) error {
+	secret, dataKey, version, err := s.rewrapSecret(cred.account, cred.secret, cred.dataKey, cred.keyVersion)
+	if err != nil {
+		return err
+	}
+
+	q := db.Build().Update(tblName).SetMap(sq.Eq{
+		"secret":      secret,
+		"data_key":    dataKey,
+		"key_version": version,
+	}).Where(sq.Eq{"id": cred.id, "key_version": cred.keyVersion, "data_key": cred.dataKey})
+
+	return db.SimpleExec(ctx, q)
+}
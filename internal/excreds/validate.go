@@ -0,0 +1,165 @@
+package excreds
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/status"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	excredsAPI "pm.tcfw.com.au/source/ataas/api/pb/excreds"
+	"pm.tcfw.com.au/source/ataas/db"
+	binance "pm.tcfw.com.au/source/ataas/internal/exchanges/binance-client"
+	cryptoCom "pm.tcfw.com.au/source/ataas/internal/exchanges/crypto-com-client"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+type credsValidator func(creds *excredsAPI.ExchangeCreds) (*excredsAPI.Permissions, error)
+
+var (
+	//validators check credentials against the exchange, detecting what the key is
+	//allowed to do. Credentials for exchanges without a validator are refused
+	validators = map[string]credsValidator{
+		"binance.com": validateBinance,
+		"crypto.com":  validateCryptoCom,
+	}
+)
+
+//validateBinance makes a signed account call with the credentials
+func validateBinance(creds *excredsAPI.ExchangeCreds) (*excredsAPI.Permissions, error) {
+	endpoint := creds.Endpoint
+	if endpoint == "" && creds.Environment == excredsAPI.Environment_TESTNET {
+		endpoint = binance.TestnetRestEndpoint
+	}
+
+	var c *binance.Client
+	if endpoint == "" {
+		c = binance.NewClient(creds.Key, creds.Secret)
+	} else {
+		c = binance.NewClientWithEndpoint(creds.Key, creds.Secret, endpoint)
+	}
+
+	perms, err := c.Permissions()
+	if err != nil {
+		errResp := &binance.ErrResp{}
+		if errors.As(err, &errResp) {
+			//the message comes from the remote host so isn't passed on to the caller
+			return nil, status.Error(codes.InvalidArgument, "exchange rejected credentials")
+		}
+		return nil, status.Error(codes.Unavailable, "failed to reach exchange")
+	}
+
+	return &excredsAPI.Permissions{
+		Read:      perms.Read,
+		SpotTrade: perms.SpotTrade,
+		Withdraw:  perms.Withdraw,
+	}, nil
+}
+
+//validateCryptoCom makes a signed account summary call with the credentials and
+//probes what the key is allowed to do
+func validateCryptoCom(creds *excredsAPI.ExchangeCreds) (*excredsAPI.Permissions, error) {
+	endpoint := creds.Endpoint
+	if endpoint == "" && creds.Environment == excredsAPI.Environment_TESTNET {
+		endpoint = cryptoCom.UATRestEndpoint
+	}
+
+	var c *cryptoCom.Client
+	if endpoint == "" {
+		c = cryptoCom.NewClient(creds.Key, creds.Secret)
+	} else {
+		c = cryptoCom.NewClientWithEndpoint(creds.Key, creds.Secret, endpoint)
+	}
+
+	perms, err := c.Permissions()
+	if err != nil {
+		errResp := &cryptoCom.ResponseError{}
+		if errors.As(err, &errResp) {
+			//the message comes from the remote host so isn't passed on to the caller
+			return nil, status.Error(codes.InvalidArgument, "exchange rejected credentials")
+		}
+		return nil, status.Error(codes.Unavailable, "failed to reach exchange")
+	}
+
+	return &excredsAPI.Permissions{
+		Read:      perms.Read,
+		SpotTrade: perms.SpotTrade,
+		Withdraw:  perms.Withdraw,
+	}, nil
+}
+
+//checkCreds validates the credentials with the exchange, rejecting keys which can
+//withdraw funds. Credentials are only ever sent to the allowed API hosts of the exchange
+func checkCreds(creds *excredsAPI.ExchangeCreds) (*excredsAPI.Permissions, error) {
+	validator, ok := validators[creds.Exchange]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "exchange does not support checking credentials")
+	}
+
+	if err := validateEndpoint(creds.Exchange, creds.Endpoint); err != nil {
+		return nil, err
+	}
+
+	perms, err := validator(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	if perms.Withdraw {
+		return perms, status.Error(codes.InvalidArgument, "keys with withdrawals enabled are not allowed")
+	}
+
+	return perms, nil
+}
+
+//Test rechecks stored credentials with the exchange and records the permissions
+//currently detected
+func (s *Server) Test(ctx context.Context, req *excredsAPI.TestRequest) (*excredsAPI.ExchangeCreds, error) {
+	acn, err := passportUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	cred, err := s.get(ctx, sq.Eq{"id": req.Id, "account": acn}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := validators[cred.Exchange]; !ok {
+		return nil, status.Error(codes.Unimplemented, "exchange does not support testing credentials")
+	}
+
+	perms, checkErr := checkCreds(cred)
+	if perms == nil {
+		return nil, checkErr
+	}
+
+	//Keep the detected permissions even when they are rejected so they can be shown
+	q := db.Build().Update(tblName).SetMap(sq.Eq{
+		"perm_read":       perms.Read,
+		"perm_spot_trade": perms.SpotTrade,
+		"perm_withdraw":   perms.Withdraw,
+		"checked_at":      sq.Expr("NOW()"),
+	}).Where(sq.Eq{"id": cred.Id})
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if checkErr != nil {
+		return nil, checkErr
+	}
+
+	cred, err = s.get(ctx, sq.Eq{"id": cred.Id, "account": acn}, false)
+	if err != nil {
+		return nil, err
+	}
+	cred.Secret = ""
+
+	return cred, nil
+}
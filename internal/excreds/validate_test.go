@@ -0,0 +1,35 @@
+package excreds
+
+import (
+	"testing"
+
+	"github.com/gogo/status"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	excredsAPI "pm.tcfw.com.au/source/ataas/api/pb/excreds"
+)
+
+func TestCheckCreds(t *testing.T) {
+	detected := &excredsAPI.Permissions{Read: true, SpotTrade: true}
+	validators["test"] = func(creds *excredsAPI.ExchangeCreds) (*excredsAPI.Permissions, error) {
+		return detected, nil
+	}
+	defer delete(validators, "test")
+
+	perms, err := checkCreds(&excredsAPI.ExchangeCreds{Exchange: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, detected, perms)
+
+	detected = &excredsAPI.Permissions{Read: true, SpotTrade: true, Withdraw: true}
+	perms, err = checkCreds(&excredsAPI.ExchangeCreds{Exchange: "test"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.True(t, perms.Withdraw, "detected permissions are still returned")
+
+	perms, err = checkCreds(&excredsAPI.ExchangeCreds{Exchange: "test", Endpoint: "https://attacker.example"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "endpoints are checked before calling the exchange")
+	assert.Nil(t, perms)
+
+	perms, err = checkCreds(&excredsAPI.ExchangeCreds{Exchange: "unchecked"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "exchanges which can't be checked are refused")
+	assert.Nil(t, perms)
+}
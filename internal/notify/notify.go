@@ -6,6 +6,8 @@ import (
 
 	"github.com/mailgun/mailgun-go/v4"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	notifyAPI "pm.tcfw.com.au/source/ataas/api/pb/notify"
 	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
 )
@@ -36,16 +38,44 @@ func (s *Server) Send(ctx context.Context, req *notifyAPI.SendRequest) (*notifyA
 		return nil, err
 	}
 
-	user, err := users.Find(ctx, &usersAPI.UserRequest{
+	query := &usersAPI.UserRequest{
 		Query:  &usersAPI.UserRequest_Account{Account: req.Uid},
 		Status: usersAPI.UserRequest_ACTIVE,
-	})
-	if err != nil {
+	}
+
+	if req.Type == notifyAPI.SendRequest_ACCOUNT {
+		//account messages such as verification emails go to pending users too
+		query = &usersAPI.UserRequest{
+			Query:  &usersAPI.UserRequest_Id{Id: req.Uid},
+			Status: usersAPI.UserRequest_ANY,
+		}
+	}
+
+	recipients := []string{}
+
+	user, err := users.Find(ctx, query)
+	if status.Code(err) == codes.NotFound && req.Type != notifyAPI.SendRequest_ACCOUNT {
+		//organisation accounts have no user of their own, so notify each member
+		members, merr := users.ListOrgMembers(ctx, &usersAPI.OrgRequest{Id: req.Uid})
+		if merr != nil {
+			s.log.Warn(merr)
+			return nil, err
+		}
+		for _, member := range members.Members {
+			recipients = append(recipients, member.Email)
+		}
+	} else if err != nil {
 		s.log.Warn(err)
 		return nil, err
+	} else {
+		recipients = append(recipients, user.Email)
+	}
+
+	if len(recipients) == 0 {
+		return &notifyAPI.SendResponse{}, nil
 	}
 
-	message := mg.NewMessage("ataas@tcfw.com.au", req.Title, req.Body, user.Email)
+	message := mg.NewMessage("ataas@tcfw.com.au", req.Title, req.Body, recipients...)
 
 	resp, id, err := mg.Send(ctx, message)
 
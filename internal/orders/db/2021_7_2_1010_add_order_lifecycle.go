@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"add_order_lifecycle",
+		time.Date(2021, 7, 2, 10, 10, 12, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			//Break out of tx as new columns cannot be backfilled in the same transaction
+			conn := tx.Conn()
+			tx.Commit(ctx)
+			_, err := conn.Exec(ctx, `
+			ALTER TABLE orders ADD COLUMN IF NOT EXISTS status INT NOT NULL DEFAULT 2;
+			ALTER TABLE orders ADD COLUMN IF NOT EXISTS filled_quantity INT64 NOT NULL DEFAULT 0;
+			ALTER TABLE orders ADD COLUMN IF NOT EXISTS avg_price INT64 NOT NULL DEFAULT 0;
+			ALTER TABLE orders ADD COLUMN IF NOT EXISTS fees INT64 NOT NULL DEFAULT 0;
+			ALTER TABLE orders ADD COLUMN IF NOT EXISTS exchange_order_id STRING NOT NULL DEFAULT '';
+			ALTER TABLE orders ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+			CREATE INDEX IF NOT EXISTS orders_status ON orders (status);
+			UPDATE orders SET filled_quantity = quantity, avg_price = price, updated_at = ts;
+			`)
+			tx, _ = tx.Begin(ctx)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_paper_tables",
+		time.Date(2021, 7, 8, 14, 0, 21, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS paper_balances (
+					account UUID NOT NULL,
+					asset STRING NOT NULL,
+					balance INT64 NOT NULL DEFAULT 0,
+					PRIMARY KEY (account, asset)
+				);
+				CREATE TABLE IF NOT EXISTS paper_orders (
+					id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+					account UUID NOT NULL,
+					instrument STRING NOT NULL,
+					side BOOL NOT NULL,
+					price INT64 NOT NULL,
+					quantity INT64 NOT NULL,
+					fees INT64 NOT NULL,
+					fee_asset STRING NOT NULL,
+					ts TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+					INDEX account (account)
+				);
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -3,27 +3,70 @@ package orders
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"pm.tcfw.com.au/source/ataas/api/pb/excreds"
 	"pm.tcfw.com.au/source/ataas/internal/exchanges"
 	binance "pm.tcfw.com.au/source/ataas/internal/exchanges/binance-client"
+	cryptoCom "pm.tcfw.com.au/source/ataas/internal/exchanges/crypto-com-client"
 )
 
 type MarketList map[string]exchanges.Exchange
 
+//Get finds the exchange for a market, creating paper exchanges on demand
+func (ml MarketList) Get(ctx context.Context, account, market string) (exchanges.Exchange, bool) {
+	if exchanges.IsPaperMarket(market) {
+		return newPaperExchange(ctx, account, market), true
+	}
+
+	ex, ok := ml[market]
+	return ex, ok
+}
+
 func initForUser(ctx context.Context, account string) (MarketList, error) {
 	ml := MarketList{}
 
-	// ml["crypto.com"] = cryptoCom.NewClientWithEndpoint(viper.GetString(""), cryptocomSecret, "https://uat-api.3ona.co/v2/")
-
 	creds, err := getCreds(ctx, account, "binance.com")
-	if err != nil {
+	if err != nil && status.Code(err) != codes.NotFound {
 		return nil, err
+	} else if err == nil {
+		endpoint := credsEndpoint(creds, binance.TestnetRestEndpoint)
+		if endpoint == "" {
+			ml["binance.com"] = binance.NewClient(creds.Key, creds.Secret)
+		} else {
+			ml["binance.com"] = binance.NewClientWithEndpoint(creds.Key, creds.Secret, endpoint)
+		}
+	}
+
+	creds, err = getCreds(ctx, account, "crypto.com")
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	} else if err == nil {
+		endpoint := credsEndpoint(creds, cryptoCom.UATRestEndpoint)
+		if endpoint == "" {
+			ml["crypto.com"] = cryptoCom.NewClient(creds.Key, creds.Secret)
+		} else {
+			ml["crypto.com"] = cryptoCom.NewClientWithEndpoint(creds.Key, creds.Secret, endpoint)
+		}
 	}
 
-	ml["binance.com"] = binance.NewClient(creds.Key, creds.Secret)
 	return ml, nil
 }
 
+//credsEndpoint selects the REST endpoint from the credential overrides, or the testnet
+//endpoint for testnet credentials. An empty endpoint means the production default
+func credsEndpoint(creds *excreds.ExchangeCreds, testnet string) string {
+	if creds.Endpoint != "" {
+		return creds.Endpoint
+	}
+
+	if creds.Environment == excreds.Environment_TESTNET {
+		return testnet
+	}
+
+	return ""
+}
+
 func getCreds(ctx context.Context, account, exchange string) (*excreds.ExchangeCreds, error) {
 	creds, err := excredsSvc()
 	if err != nil {
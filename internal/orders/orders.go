@@ -17,10 +17,14 @@ import (
 	"pm.tcfw.com.au/source/ataas/db"
 	"pm.tcfw.com.au/source/ataas/internal/exchanges"
 	migrate "pm.tcfw.com.au/source/ataas/internal/orders/db"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
 )
 
 const (
 	tblName = "orders"
+
+	//maxBookAge order book snapshots older than this are not used for pricing
+	maxBookAge = 30 * time.Second
 )
 
 var (
@@ -31,6 +35,14 @@ var (
 		"price",
 		"quantity",
 		"ts",
+		"status",
+		"filled_quantity",
+		"avg_price",
+		"fees",
+		"exchange_order_id",
+		"updated_at",
+		"fee_asset",
+		"created_by",
 	}
 )
 
@@ -74,18 +86,35 @@ func (s *Server) Create(ctx context.Context, req *ordersAPI.CreateRequest) (*ord
 		return nil, err
 	}
 
+	//Orders placed directly by users must be on blocks of the account they act on
+	//and are attributed to them. Other services provide the attribution
+	if _, err := passportUtils.GetAuthToken(ctx); err == nil {
+		acn, err := passportUtils.AccountFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if block.Account != acn {
+			return nil, status.Error(codes.NotFound, "block not found")
+		}
+
+		req.CreatedBy, err = passportUtils.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	markets, err := initForUser(ctx, block.Account)
 	if err != nil {
 		return nil, err
 	}
-	market, exists := markets[block.Market]
+	market, exists := markets.Get(ctx, block.Account, block.Market)
 	if !exists {
 		return nil, status.Error(codes.FailedPrecondition, "market not supported")
 	}
 
 	var exchangeRes exchanges.OrderResponse
 
-	bestPrice, err := s.getMarketPrice(ctx, block.Market, block.Instrument)
+	bestPrice, err := s.getMarketPrice(ctx, block.Market, block.Instrument, req.Action == ordersAPI.Action_BUY)
 	if err != nil {
 		return nil, err
 	}
@@ -117,23 +146,41 @@ func (s *Server) Create(ctx context.Context, req *ordersAPI.CreateRequest) (*ord
 		return nil, err
 	}
 
-	units, err := strconv.ParseFloat(exchangeRes.Units(), 64)
+	filled, err := strconv.ParseFloat(exchangeRes.Units(), 64)
 	if err != nil {
 		return nil, err
 	}
 
+	fees, _ := strconv.ParseFloat(exchangeRes.Fees(), 64)
+
 	price := pricefstr
 	if price == 0 {
 		price = float64(bestPrice)
 	}
 
+	//quantity is always what was requested, fills are tracked separately
+	avgPrice := 0.0
+	if filled > 0 {
+		avgPrice = pricefstr
+	}
+
+	orderStatus := ordersAPI.OrderStatus(exchangeRes.Status())
+
 	q := db.Build().Insert(tblName).Columns(allColumns...).Values(
 		id,
 		req.BlockID,
 		req.Action == ordersAPI.Action_BUY,
 		int64(price*1000000),
-		int64(units*1000000),
+		int64(req.Units*1000000),
 		t,
+		int32(orderStatus),
+		int64(filled*1000000),
+		int64(avgPrice*1000000),
+		int64(fees*1000000),
+		exchangeRes.OrderID(),
+		t,
+		exchangeRes.FeeAsset(),
+		req.CreatedBy,
 	)
 
 	err = db.SimpleExec(ctx, q)
@@ -142,12 +189,20 @@ func (s *Server) Create(ctx context.Context, req *ordersAPI.CreateRequest) (*ord
 	}
 
 	order := &ordersAPI.Order{
-		Id:        id,
-		BlockID:   req.BlockID,
-		Action:    req.Action,
-		Units:     units,
-		Price:     float32(price),
-		Timestamp: t.Format(time.RFC3339),
+		Id:              id,
+		BlockID:         req.BlockID,
+		Action:          req.Action,
+		Units:           req.Units,
+		Price:           float32(price),
+		Timestamp:       t.Format(time.RFC3339),
+		Status:          orderStatus,
+		FilledUnits:     filled,
+		AvgPrice:        float32(avgPrice),
+		Fees:            fees,
+		ExchangeOrderID: exchangeRes.OrderID(),
+		UpdatedAt:       t.Format(time.RFC3339),
+		FeeAsset:        exchangeRes.FeeAsset(),
+		CreatedBy:       req.CreatedBy,
 	}
 
 	return &ordersAPI.CreateResponse{Order: order}, nil
@@ -176,35 +231,65 @@ func (s *Server) Get(ctx context.Context, req *ordersAPI.GetRequest) (*ordersAPI
 	orders := []*ordersAPI.Order{}
 
 	for res.Next() {
-		order := &ordersAPI.Order{}
-
-		var side bool
-		var t time.Time
-
-		var orderPrice int
-		var orderUnits int
-
-		err := res.Scan(
-			&order.Id,
-			&order.BlockID,
-			&side,
-			&orderPrice,
-			&orderUnits,
-			&t,
-		)
+		order, err := scanOrder(res)
 		if err != nil {
 			return nil, err
 		}
 
-		order.Price = float32(orderPrice) / 1000000
-		order.Units = float64(orderUnits) / 1000000
+		orders = append(orders, order)
+	}
+
+	return &ordersAPI.GetResponse{Orders: orders}, nil
+}
+
+//ListOpen lists orders which have not reached a terminal state for a single block
+//or all blocks the account has access to
+func (s *Server) ListOpen(ctx context.Context, req *ordersAPI.ListOpenRequest) (*ordersAPI.GetResponse, error) {
+	blocksSvc, err := blocksSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	blockIDs := []string{}
 
-		order.Action = ordersAPI.Action_SELL
-		if side {
-			order.Action = ordersAPI.Action_BUY
+	if req.BlockID != "" {
+		//Make sure we have access to the block
+		block, err := blocksSvc.Get(ctx, &blocks.GetRequest{Id: req.BlockID})
+		if err != nil {
+			return nil, err
+		}
+		blockIDs = append(blockIDs, block.Id)
+	} else {
+		list, err := blocksSvc.List(ctx, &blocks.ListRequest{})
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range list.Blocks {
+			blockIDs = append(blockIDs, block.Id)
 		}
+	}
+
+	orders := []*ordersAPI.Order{}
+
+	if len(blockIDs) == 0 {
+		return &ordersAPI.GetResponse{Orders: orders}, nil
+	}
+
+	q := db.Build().Select(allColumns...).From(tblName).
+		Where(sq.Eq{"block_id": blockIDs, "status": openStatuses}).
+		OrderBy("ts")
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 
-		order.Timestamp = t.Format(time.RFC3339)
+	for res.Next() {
+		order, err := scanOrder(res)
+		if err != nil {
+			return nil, err
+		}
 
 		orders = append(orders, order)
 	}
@@ -212,14 +297,252 @@ func (s *Server) Get(ctx context.Context, req *ordersAPI.GetRequest) (*ordersAPI
 	return &ordersAPI.GetResponse{Orders: orders}, nil
 }
 
-func (s *Server) getMarketPrice(ctx context.Context, market, instrument string) (float32, error) {
+//Cancel requests the exchange cancel an open order
+func (s *Server) Cancel(ctx context.Context, req *ordersAPI.CancelRequest) (*ordersAPI.CancelResponse, error) {
+	order, err := s.get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	blocksSvc, err := blocksSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	//Make sure we have access to the block
+	block, err := blocksSvc.Get(ctx, &blocks.GetRequest{Id: order.BlockID})
+	if err != nil {
+		return nil, err
+	}
+
+	if exchanges.OrderStatus(order.Status).Terminal() {
+		return nil, status.Error(codes.FailedPrecondition, "order is no longer open")
+	}
+
+	if order.ExchangeOrderID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "order has no exchange reference")
+	}
+
+	markets, err := initForUser(ctx, block.Account)
+	if err != nil {
+		return nil, err
+	}
+	market, exists := markets.Get(ctx, block.Account, block.Market)
+	if !exists {
+		return nil, status.Error(codes.FailedPrecondition, "market not supported")
+	}
+
+	exchangeRes, err := market.CancelOrder(block.Instrument, order.ExchangeOrderID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel order: %s", err)
+	}
+
+	if err := s.applyExchangeState(ctx, order, exchangeRes); err != nil {
+		return nil, err
+	}
+
+	return &ordersAPI.CancelResponse{Order: order}, nil
+}
+
+//get fetches a single order by id
+func (s *Server) get(ctx context.Context, id string) (*ordersAPI.Order, error) {
+	q := db.Build().Select(allColumns...).From(tblName).Where(sq.Eq{"id": id}).Limit(1)
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return scanOrder(res)
+}
+
+//applyExchangeState updates the stored order from the latest exchange state. The
+//exchange reports the total filled so far, leaving the requested quantity as is.
+//Settled orders are applied to their block before being stored so a failure is
+//retried by the watcher
+func (s *Server) applyExchangeState(ctx context.Context, order *ordersAPI.Order, exchangeRes exchanges.OrderResponse) error {
+	filled, err := strconv.ParseFloat(exchangeRes.Units(), 64)
+	if err != nil {
+		return err
+	}
+
+	reportedPrice, err := strconv.ParseFloat(exchangeRes.Price(), 64)
+	if err != nil {
+		return err
+	}
+
+	avgPrice := fillPrice(order, filled, reportedPrice)
+
+	fees, _ := strconv.ParseFloat(exchangeRes.Fees(), 64)
+	feeAsset := exchangeRes.FeeAsset()
+	if fees == 0 || feeAsset == "" {
+		//not all exchanges report fees when querying order state
+		fees = order.Fees
+		feeAsset = order.FeeAsset
+	}
+
+	t := time.Now()
+
+	order.Status = ordersAPI.OrderStatus(exchangeRes.Status())
+	order.FilledUnits = filled
+	order.AvgPrice = float32(avgPrice)
+	order.Fees = fees
+	order.FeeAsset = feeAsset
+	order.UpdatedAt = t.Format(time.RFC3339)
+
+	if err := settleBlock(ctx, order); err != nil {
+		return err
+	}
+
+	q := db.Build().Update(tblName).
+		Set("status", int32(order.Status)).
+		Set("filled_quantity", int64(filled*1000000)).
+		Set("avg_price", int64(avgPrice*1000000)).
+		Set("fees", int64(fees*1000000)).
+		Set("fee_asset", feeAsset).
+		Set("updated_at", t).
+		Where(sq.Eq{"id": order.Id})
+
+	return db.SimpleExec(ctx, q)
+}
+
+//settleBlock passes the fills of an order which will no longer be filled on to
+//its block
+func settleBlock(ctx context.Context, order *ordersAPI.Order) error {
+	if order.Status == ordersAPI.OrderStatus_OPEN || order.Status == ordersAPI.OrderStatus_PARTIALLY_FILLED {
+		return nil
+	}
+
+	blocksSvc, err := blocksSvc()
+	if err != nil {
+		return err
+	}
+
+	_, err = blocksSvc.ApplyOrder(ctx, order)
+	return err
+}
+
+//fillPrice provides the average price of all fills of the order. Exchanges which
+//don't report the average price of the fills keep the previous average
+func fillPrice(order *ordersAPI.Order, filled, reported float64) float64 {
+	if filled <= 0 {
+		return 0
+	}
+
+	if reported > 0 {
+		return reported
+	}
+
+	return float64(order.AvgPrice)
+}
+
+type scannable interface {
+	Scan(...interface{}) error
+}
+
+//scanOrder scans a single row from the orders table
+func scanOrder(row scannable) (*ordersAPI.Order, error) {
+	order := &ordersAPI.Order{}
+
+	var side bool
+	var t, updated time.Time
+	var orderStatus int32
+
+	var orderPrice, orderUnits, filledUnits, avgPrice, fees int64
+
+	err := row.Scan(
+		&order.Id,
+		&order.BlockID,
+		&side,
+		&orderPrice,
+		&orderUnits,
+		&t,
+		&orderStatus,
+		&filledUnits,
+		&avgPrice,
+		&fees,
+		&order.ExchangeOrderID,
+		&updated,
+		&order.FeeAsset,
+		&order.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Price = float32(orderPrice) / 1000000
+	order.Units = float64(orderUnits) / 1000000
+	order.FilledUnits = float64(filledUnits) / 1000000
+	order.AvgPrice = float32(avgPrice) / 1000000
+	order.Fees = float64(fees) / 1000000
+	order.Status = ordersAPI.OrderStatus(orderStatus)
+
+	order.Action = ordersAPI.Action_SELL
+	if side {
+		order.Action = ordersAPI.Action_BUY
+	}
+
+	order.Timestamp = t.Format(time.RFC3339)
+	order.UpdatedAt = updated.Format(time.RFC3339)
+
+	return order, nil
+}
+
+func (s *Server) getMarketPrice(ctx context.Context, market, instrument string, buy bool) (float32, error) {
+	price, _, err := bookPrice(ctx, market, instrument, buy)
+	if err != nil {
+		s.log.Errorf("no market data available: %s", err)
+		return 0, err
+	}
+
+	return price, nil
+}
+
+//bookPrice gets the price an order would execute at from the best ask for buys or the
+//best bid for sells. When no recent order book is available, the last traded price is
+//used instead, indicated by fromBook being false
+func bookPrice(ctx context.Context, market, instrument string, buy bool) (price float32, fromBook bool, err error) {
+	ticks, err := ticksSvc()
+	if err != nil {
+		return 0, false, err
+	}
+
+	depth, err := ticks.Depth(ctx, &ticksAPI.DepthRequest{
+		Market:     exchanges.PriceMarket(market),
+		Instrument: instrument,
+		Levels:     1,
+	})
+	if err == nil && len(depth.Data) > 0 {
+		book := depth.Data[0]
+		age := time.Since(time.Unix(0, book.Timestamp*int64(time.Millisecond)))
+
+		if age < maxBookAge {
+			if buy && len(book.Asks) > 0 {
+				return float32(book.Asks[0].Price), true, nil
+			} else if !buy && len(book.Bids) > 0 {
+				return float32(book.Bids[0].Price), true, nil
+			}
+		}
+	}
+
+	price, err = marketPrice(ctx, market, instrument)
+	return price, false, err
+}
+
+//marketPrice gets the last traded price of an instrument
+func marketPrice(ctx context.Context, market, instrument string) (float32, error) {
 	ticks, err := ticksSvc()
 	if err != nil {
 		return 0, err
 	}
 
 	trades, err := ticks.Trades(ctx, &ticksAPI.GetRequest{
-		Market:     market,
+		Market:     exchanges.PriceMarket(market),
 		Instrument: instrument,
 		Depth:      100,
 	})
@@ -228,7 +551,6 @@ func (s *Server) getMarketPrice(ctx context.Context, market, instrument string)
 	}
 
 	if len(trades.Data) == 0 {
-		s.log.Errorf("no market data available")
 		return 0, fmt.Errorf("no data")
 	}
 
@@ -242,7 +564,7 @@ func (s *Server) getBestMarketPrice(ctx context.Context, market, instrument stri
 	}
 
 	trades, err := ticks.TradesRange(ctx, &ticksAPI.RangeRequest{
-		Market:     market,
+		Market:     exchanges.PriceMarket(market),
 		Instrument: instrument,
 		Since:      "20m",
 	})
@@ -0,0 +1,356 @@
+package orders
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/status"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	ordersAPI "pm.tcfw.com.au/source/ataas/api/pb/orders"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+const (
+	paperBalancesTblName = "paper_balances"
+	paperOrdersTblName   = "paper_orders"
+)
+
+func init() {
+	viper.SetDefault("orders.paper.fee", 0.001)
+	viper.SetDefault("orders.paper.slippage", 0.0005)
+	viper.SetDefault("orders.paper.balance", 10000)
+	viper.SetDefault("orders.paper.assets", []string{"AUD", "USDT"})
+}
+
+//paperExchange simulates an exchange by filling market orders against the best price
+//of the underlying order book, or the latest trade when no book is available,
+//keeping virtual balances per account
+type paperExchange struct {
+	ctx     context.Context
+	account string
+	market  string
+
+	fee      float64
+	slippage float64
+}
+
+var _ exchanges.Exchange = (*paperExchange)(nil)
+
+func newPaperExchange(ctx context.Context, account string, market string) *paperExchange {
+	return &paperExchange{
+		ctx:      ctx,
+		account:  account,
+		market:   exchanges.PriceMarket(market),
+		fee:      viper.GetFloat64("orders.paper.fee"),
+		slippage: viper.GetFloat64("orders.paper.slippage"),
+	}
+}
+
+//fillPrice price a market order fills at. Slippage is only simulated when filling
+//against the last trade as the book price already includes the spread
+func (pe *paperExchange) fillPrice(instrument string, buy bool) (float64, error) {
+	price, fromBook, err := bookPrice(pe.ctx, pe.market, instrument, buy)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromBook {
+		return float64(price), nil
+	}
+
+	if buy {
+		return float64(price) * (1 + pe.slippage), nil
+	}
+
+	return float64(price) * (1 - pe.slippage), nil
+}
+
+type paperOrderResponse struct {
+	price    float64
+	units    float64
+	fees     float64
+	feeAsset string
+	orderID  string
+}
+
+func (or *paperOrderResponse) Price() string                 { return strconv.FormatFloat(or.price, 'f', -1, 64) }
+func (or *paperOrderResponse) Units() string                 { return strconv.FormatFloat(or.units, 'f', -1, 64) }
+func (or *paperOrderResponse) OrderID() string               { return or.orderID }
+func (or *paperOrderResponse) Status() exchanges.OrderStatus { return exchanges.OrderStatusFilled }
+func (or *paperOrderResponse) Fees() string                  { return strconv.FormatFloat(or.fees, 'f', -1, 64) }
+func (or *paperOrderResponse) FeeAsset() string              { return or.feeAsset }
+
+//Buy spends the quote amount given in price at the best ask, or the last trade price
+//plus slippage. Fees are taken from the units received
+func (pe *paperExchange) Buy(instrument string, price float32, units float64) (exchanges.OrderResponse, error) {
+	if price <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "price must be set")
+	}
+
+	fillPrice, err := pe.fillPrice(instrument, true)
+	if err != nil {
+		return nil, err
+	}
+
+	base, quote := exchanges.SplitInstrument(instrument)
+
+	spend := float64(price)
+	filled := spend / fillPrice
+	fees := filled * pe.fee
+
+	//report the average price paid per unit received, as exchanges do when
+	//commission is charged in the purchased asset
+	res := &paperOrderResponse{
+		price:    spend / (filled - fees),
+		units:    filled - fees,
+		fees:     fees,
+		feeAsset: base,
+	}
+
+	err = pe.fill(instrument, true, res, map[string]float64{
+		quote: -spend,
+		base:  res.units,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+//Sell sells the units at the best bid, or the last trade price minus slippage.
+//Fees are taken from the quote proceeds
+func (pe *paperExchange) Sell(instrument string, price float32, units float64) (exchanges.OrderResponse, error) {
+	if units <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "quantity must be set")
+	}
+
+	fillPrice, err := pe.fillPrice(instrument, false)
+	if err != nil {
+		return nil, err
+	}
+
+	base, quote := exchanges.SplitInstrument(instrument)
+
+	proceeds := units * fillPrice
+	fees := proceeds * pe.fee
+
+	res := &paperOrderResponse{
+		price:    fillPrice,
+		units:    units,
+		fees:     fees,
+		feeAsset: quote,
+	}
+
+	err = pe.fill(instrument, false, res, map[string]float64{
+		base:  -units,
+		quote: proceeds - fees,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+//OrderStatus looks up a previously filled paper order
+func (pe *paperExchange) OrderStatus(instrument string, orderID string) (exchanges.OrderResponse, error) {
+	q := db.Build().Select("id", "price", "quantity", "fees", "fee_asset").
+		From(paperOrdersTblName).
+		Where(sq.Eq{"id": orderID, "account": pe.account}).
+		Limit(1)
+
+	res, done, err := db.SimpleQuery(pe.ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	var price, units, fees int64
+	order := &paperOrderResponse{}
+
+	if err := res.Scan(&order.orderID, &price, &units, &fees, &order.feeAsset); err != nil {
+		return nil, err
+	}
+
+	order.price = float64(price) / 1000000
+	order.units = float64(units) / 1000000
+	order.fees = float64(fees) / 1000000
+
+	return order, nil
+}
+
+//CancelOrder paper orders are always filled immediately so can never be cancelled
+func (pe *paperExchange) CancelOrder(instrument string, orderID string) (exchanges.OrderResponse, error) {
+	return nil, status.Error(codes.FailedPrecondition, "order already filled")
+}
+
+//fill applies the balance changes and records the order in a single transaction
+func (pe *paperExchange) fill(instrument string, side bool, res *paperOrderResponse, changes map[string]float64) error {
+	conn, err := db.Conn(pe.ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(pe.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(pe.ctx)
+
+	if err := seedPaperBalances(pe.ctx, tx, pe.account); err != nil {
+		return err
+	}
+
+	for asset, change := range changes {
+		if change >= 0 {
+			continue
+		}
+
+		bal, err := paperBalance(pe.ctx, tx, pe.account, asset)
+		if err != nil {
+			return err
+		}
+
+		if bal+change < 0 {
+			return status.Errorf(codes.FailedPrecondition, "insufficient paper balance of %s", asset)
+		}
+	}
+
+	for asset, change := range changes {
+		q := db.Build().Insert(paperBalancesTblName).
+			Columns("account", "asset", "balance").
+			Values(pe.account, asset, int64(change*1000000)).
+			Suffix("ON CONFLICT (account, asset) DO UPDATE SET balance = paper_balances.balance + excluded.balance")
+
+		if _, err := db.Exec(pe.ctx, tx, q); err != nil {
+			return err
+		}
+	}
+
+	res.orderID = uuid.New().String()
+
+	q := db.Build().Insert(paperOrdersTblName).
+		Columns("id", "account", "instrument", "side", "price", "quantity", "fees", "fee_asset", "ts").
+		Values(
+			res.orderID,
+			pe.account,
+			instrument,
+			side,
+			int64(res.price*1000000),
+			int64(res.units*1000000),
+			int64(res.fees*1000000),
+			res.feeAsset,
+			time.Now(),
+		)
+
+	if _, err := db.Exec(pe.ctx, tx, q); err != nil {
+		return err
+	}
+
+	return tx.Commit(pe.ctx)
+}
+
+//seedPaperBalances gives accounts without any paper balances the configured starting balance
+func seedPaperBalances(ctx context.Context, tx pgx.Tx, account string) error {
+	q := db.Build().Select("count(*)").From(paperBalancesTblName).Where(sq.Eq{"account": account})
+
+	var n int
+	res, err := db.Query(ctx, tx, q)
+	if err != nil {
+		return err
+	}
+	if res.Next() {
+		err = res.Scan(&n)
+	}
+	res.Close()
+	if err != nil || n > 0 {
+		return err
+	}
+
+	start := viper.GetFloat64("orders.paper.balance")
+
+	//A concurrent first fill may have seeded the balances since they were counted
+	for _, asset := range viper.GetStringSlice("orders.paper.assets") {
+		q := db.Build().Insert(paperBalancesTblName).
+			Columns("account", "asset", "balance").
+			Values(account, asset, int64(start*1000000)).
+			Suffix("ON CONFLICT DO NOTHING")
+
+		if _, err := db.Exec(ctx, tx, q); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//paperBalance reads the current balance of an asset locking the row for update
+func paperBalance(ctx context.Context, tx pgx.Tx, account string, asset string) (float64, error) {
+	q := db.Build().Select("balance").From(paperBalancesTblName).
+		Where(sq.Eq{"account": account, "asset": asset}).
+		Suffix("FOR UPDATE")
+
+	res, err := db.Query(ctx, tx, q)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	if !res.Next() {
+		return 0, nil
+	}
+
+	var bal int64
+	if err := res.Scan(&bal); err != nil {
+		return 0, err
+	}
+
+	return float64(bal) / 1000000, nil
+}
+
+//PaperBalances lists the virtual balances used by paper markets
+func (s *Server) PaperBalances(ctx context.Context, req *ordersAPI.PaperBalancesRequest) (*ordersAPI.PaperBalancesResponse, error) {
+	acn, err := passportUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Select("asset", "balance").From(paperBalancesTblName).
+		Where(sq.Eq{"account": acn}).
+		OrderBy("asset")
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	balances := []*ordersAPI.PaperBalance{}
+
+	for res.Next() {
+		bal := &ordersAPI.PaperBalance{}
+		var balance int64
+
+		if err := res.Scan(&bal.Asset, &balance); err != nil {
+			return nil, err
+		}
+
+		bal.Balance = float64(balance) / 1000000
+		balances = append(balances, bal)
+	}
+
+	return &ordersAPI.PaperBalancesResponse{Balances: balances}, nil
+}
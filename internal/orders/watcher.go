@@ -0,0 +1,106 @@
+package orders
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"pm.tcfw.com.au/source/ataas/api/pb/blocks"
+	ordersAPI "pm.tcfw.com.au/source/ataas/api/pb/orders"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+const (
+	watchInterval = 10 * time.Second
+)
+
+var (
+	openStatuses = []int32{
+		int32(ordersAPI.OrderStatus_OPEN),
+		int32(ordersAPI.OrderStatus_PARTIALLY_FILLED),
+	}
+)
+
+//Watch polls the exchanges for updates to orders which have not yet
+//reached a terminal state until the context is cancelled
+func (s *Server) Watch(ctx context.Context) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.checkOpenOrders(ctx); err != nil {
+				s.log.WithError(err).Errorf("failed to check open orders")
+			}
+		}
+	}
+}
+
+//checkOpenOrders refreshes the state of each open order from its exchange
+func (s *Server) checkOpenOrders(ctx context.Context) error {
+	q := db.Build().Select(allColumns...).From(tblName).
+		Where(sq.Eq{"status": openStatuses}).
+		Where(sq.NotEq{"exchange_order_id": ""})
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	orders := []*ordersAPI.Order{}
+	for res.Next() {
+		order, err := scanOrder(res)
+		if err != nil {
+			done()
+			return err
+		}
+		orders = append(orders, order)
+	}
+	done()
+
+	for _, order := range orders {
+		if err := s.checkOrder(ctx, order); err != nil {
+			s.log.WithError(err).WithField("order", order.Id).Warnf("failed to update order state")
+		}
+	}
+
+	return nil
+}
+
+//checkOrder fetches the current order state from the exchange and stores any changes
+func (s *Server) checkOrder(ctx context.Context, order *ordersAPI.Order) error {
+	blocksSvc, err := blocksSvc()
+	if err != nil {
+		return err
+	}
+
+	block, err := blocksSvc.Find(ctx, &blocks.GetRequest{Id: order.BlockID})
+	if err != nil {
+		return err
+	}
+
+	markets, err := initForUser(ctx, block.Account)
+	if err != nil {
+		return err
+	}
+	market, exists := markets.Get(ctx, block.Account, block.Market)
+	if !exists {
+		return nil
+	}
+
+	exchangeRes, err := market.OrderStatus(block.Instrument, order.ExchangeOrderID)
+	if err != nil {
+		return err
+	}
+
+	if ordersAPI.OrderStatus(exchangeRes.Status()) == order.Status &&
+		exchangeRes.Status() != exchanges.OrderStatusPartiallyFilled {
+		return nil
+	}
+
+	return s.applyExchangeState(ctx, order, exchangeRes)
+}
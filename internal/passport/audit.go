@@ -0,0 +1,51 @@
+package passport
+
+import (
+	"context"
+	"net"
+
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
+)
+
+//loginFailed records the failed login and counts it towards the rate limit. Events
+//target the user when known so they appear in the users audit log
+func (s *Server) loginFailed(ctx context.Context, action string, user *usersAPI.User, remaining int, remoteIP net.IP, username string, reason string) (*passportAPI.AuthResponse, error) {
+	ev := &auditAPI.Event{
+		Action: action,
+		Target: username,
+		Detail: reason,
+	}
+	if user != nil {
+		ev.Target = user.Id
+		ev.Account = user.Account
+	}
+
+	audit.Record(ctx, ev)
+
+	return s.limiter.IncreaseResp(ctx, remaining, remoteIP, username, reason)
+}
+
+//auditLogin records a successful login by the user
+func auditLogin(ctx context.Context, user *usersAPI.User, method string) {
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionLogin,
+		Actor:   user.Id,
+		Account: user.Account,
+		Target:  user.Id,
+		Success: true,
+		Detail:  method,
+	})
+}
+
+//auditMFAChanged records changes to the MFA settings of the user
+func auditMFAChanged(ctx context.Context, user *usersAPI.User, detail string) {
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionMFAChanged,
+		Target:  user.Id,
+		Success: true,
+		Detail:  detail,
+	})
+}
@@ -0,0 +1,322 @@
+package passport
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
+	"pm.tcfw.com.au/source/ataas/internal/broadcast"
+	authUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+const (
+	clientSecretSize = 64
+)
+
+type apiClient struct {
+	*passportAPI.APIClient
+
+	account    string
+	sub        string
+	secretHash []byte
+}
+
+//CreateAPIClient creates new client credentials for the account limited to the given scopes.
+//The secret is only returned once
+func (s *Server) CreateAPIClient(ctx context.Context, req *passportAPI.NewAPIClientRequest) (*passportAPI.NewAPIClientResponse, error) {
+	claims, err := authUtils.TokenClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := claims["cid"]; ok {
+		return nil, status.Error(codes.PermissionDenied, "API clients cannot create API clients")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	scopes, err := validateScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := authUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &passportAPI.APIClient{
+		Id:      uuid.New().String(),
+		Name:    name,
+		Scopes:  scopes,
+		Created: time.Now().Unix(),
+	}
+	secret := RandomString(clientSecretSize)
+
+	q := db.Build().Insert("api_clients").
+		Columns("id", "account", "sub", "name", "secret_hash", "scopes").
+		Values(client.Id, account, claims["sub"], client.Name, hashToken(secret), client.Scopes)
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionClientCreated,
+		Account: account,
+		Target:  client.Id,
+		Success: true,
+		Detail:  strings.Join(client.Scopes, " "),
+	})
+
+	return &passportAPI.NewAPIClientResponse{Client: client, Secret: secret}, nil
+}
+
+//ListAPIClients lists the active client credentials of the account
+func (s *Server) ListAPIClients(ctx context.Context, _ *passportAPI.Empty) (*passportAPI.APIClientList, error) {
+	account, err := authUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Select("id", "name", "scopes", "created", "last_used").From("api_clients").
+		Where(sq.Eq{"account": account, "revoked": false}).
+		OrderBy("created")
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	ret := &passportAPI.APIClientList{Clients: []*passportAPI.APIClient{}}
+
+	for res.Next() {
+		client := &passportAPI.APIClient{}
+		var created time.Time
+		var lastUsed *time.Time
+
+		if err := res.Scan(&client.Id, &client.Name, &client.Scopes, &created, &lastUsed); err != nil {
+			return nil, err
+		}
+
+		client.Created = created.Unix()
+		if lastUsed != nil {
+			client.LastUsed = lastUsed.Unix()
+		}
+
+		ret.Clients = append(ret.Clients, client)
+	}
+
+	return ret, nil
+}
+
+//RevokeAPIClient revokes the client credentials and all tokens issued to the client
+func (s *Server) RevokeAPIClient(ctx context.Context, req *passportAPI.RevokeAPIClientRequest) (*passportAPI.Empty, error) {
+	account, err := authUtils.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid client id")
+	}
+
+	q := db.Build().Update("api_clients").Set("revoked", true).
+		Where(sq.Eq{"id": req.Id, "account": account, "revoked": false})
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := db.Exec(ctx, tx, q)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.revokeSessions(ctx, sq.Eq{"client": req.Id}, "api client revoked"); err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionClientRevoked,
+		Account: account,
+		Target:  req.Id,
+		Success: true,
+	})
+
+	return &passportAPI.Empty{}, nil
+}
+
+//authenticateClient issues a scoped token for the client credentials. No refresh token
+//is issued as clients can authenticate again once the token expires
+func (s *Server) authenticateClient(ctx context.Context, creds *passportAPI.OAuthClientSecretCreds, remoteIP net.IP) (*passportAPI.AuthResponse, error) {
+	ok, ttl, remaining := s.limiter.CheckUser(ctx, creds.Key, remoteIP)
+	if !ok {
+		return s.limiter.ReachedResp(ctx, remoteIP, ttl)
+	}
+
+	if _, err := uuid.Parse(creds.Key); err != nil || creds.Secret == "" {
+		return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, creds.Key, "bad request")
+	}
+
+	client, err := findClient(ctx, creds.Key)
+	if err == pgx.ErrNoRows {
+		return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, creds.Key, "Unknown client")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare(hashToken(creds.Secret), client.secretHash) != 1 {
+		return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, creds.Key, "Secret mismatch")
+	}
+
+	scopes := client.Scopes
+	if len(creds.Scopes) > 0 {
+		for _, scope := range creds.Scopes {
+			if !hasScope(client.Scopes, scope) {
+				return nil, status.Errorf(codes.PermissionDenied, "scope %s not granted to client", scope)
+			}
+		}
+		scopes = creds.Scopes
+	}
+
+	usersSvc, err := usersSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	//Clients stop working once the user which created them is no longer active
+	user, err := usersSvc.Find(withAuthContext(ctx), &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Id{Id: client.sub}, Status: usersAPI.UserRequest_ACTIVE})
+	if err != nil {
+		return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, creds.Key, "Inactive client user")
+	}
+
+	claims := UserClaims(user)
+	claims["acn"] = client.account
+	claims["cid"] = client.Id
+	claims["scp"] = scopes
+
+	tokenString, token, err := s.makeNewToken(ctx, claims, "")
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Update("api_clients").Set("last_used", sq.Expr("NOW()")).Where(sq.Eq{"id": client.Id})
+	if err := db.SimpleExec(ctx, q); err != nil {
+		s.log.WithError(err).Warn("failed to update client last used")
+	}
+
+	b, err := broadcast.Driver()
+	if err != nil {
+		return nil, err
+	}
+	b.Publish("passport", &broadcast.AuthenticateEvent{
+		Event:    &broadcast.Event{Type: "vanga.passport.authenticate"},
+		AuthType: "client_credentials",
+		Success:  true,
+		User:     user.Id,
+		IP:       remoteIP.String(),
+	})
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionLogin,
+		Actor:   user.Id,
+		Client:  client.Id,
+		Account: client.account,
+		Target:  client.Id,
+		Success: true,
+		Detail:  "client_credentials",
+	})
+
+	return &passportAPI.AuthResponse{
+		Success: true,
+		Tokens: &passportAPI.Tokens{
+			Token:       tokenString,
+			TokenExpire: token.Claims.(jwt.MapClaims)["exp"].(int64),
+		},
+	}, nil
+}
+
+//findClient finds active client credentials by id
+func findClient(ctx context.Context, id string) (*apiClient, error) {
+	q := db.Build().Select("id", "account", "sub", "name", "secret_hash", "scopes").From("api_clients").
+		Where(sq.Eq{"id": id, "revoked": false})
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return nil, pgx.ErrNoRows
+	}
+
+	client := &apiClient{APIClient: &passportAPI.APIClient{}}
+	if err := res.Scan(&client.Id, &client.account, &client.sub, &client.Name, &client.secretHash, &client.Scopes); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+//validateScopes checks all scopes can be granted, removing duplicates
+func validateScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+
+	valid := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !authUtils.IsScope(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %s", scope)
+		}
+		if !hasScope(valid, scope) {
+			valid = append(valid, scope)
+		}
+	}
+
+	return valid, nil
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
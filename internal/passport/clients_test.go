@@ -0,0 +1,20 @@
+package passport
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateScopes(t *testing.T) {
+	scopes, err := validateScopes([]string{"strategies:read", "backtest", "strategies:read"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"strategies:read", "backtest"}, scopes)
+
+	_, err = validateScopes(nil)
+	assert.Error(t, err)
+
+	_, err = validateScopes([]string{"admin"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_refresh_tokens",
+		time.Date(2021, 7, 16, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `ALTER TABLE sessions
+			ADD COLUMN family UUID,
+			ADD COLUMN refreshed TIMESTAMPTZ
+			`)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `CREATE TABLE refresh_tokens (
+				token_hash BYTES PRIMARY KEY,
+				family UUID NOT NULL,
+				jti UUID NOT NULL,
+				sub UUID NOT NULL,
+				exp TIMESTAMPTZ NOT NULL,
+				used bool DEFAULT false,
+				created TIMESTAMPTZ DEFAULT NOW(),
+
+				INDEX (family)
+			)`)
+			return err
+		},
+
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `DROP TABLE refresh_tokens`)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `ALTER TABLE sessions DROP COLUMN family, DROP COLUMN refreshed`)
+			return err
+		},
+	))
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_api_clients",
+		time.Date(2021, 7, 19, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `CREATE TABLE api_clients (
+				id UUID PRIMARY KEY,
+				account UUID NOT NULL,
+				sub UUID NOT NULL,
+				name STRING,
+				secret_hash BYTES NOT NULL,
+				scopes STRING[],
+				revoked bool DEFAULT false,
+				created TIMESTAMPTZ DEFAULT NOW(),
+				last_used TIMESTAMPTZ,
+
+				INDEX (account)
+			)`)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `ALTER TABLE sessions ADD COLUMN client UUID`)
+			return err
+		},
+
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `ALTER TABLE sessions DROP COLUMN client`)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `DROP TABLE api_clients`)
+			return err
+		},
+	))
+}
@@ -61,7 +61,6 @@ func (l *limiter) Inc(ctx context.Context, email string, ip net.IP) {
 	if err != nil {
 		return
 	}
-	defer client.Close()
 
 	for _, limit := range keysToIncrement {
 		exists := len(client.Keys(limit.key).Val())
@@ -89,7 +88,6 @@ func (l *limiter) checkKeyRateLimit(ctx context.Context, key string, limit int)
 	if err != nil {
 		return true, 0, limit //Allow on error
 	}
-	defer client.Close()
 
 	exists := len(client.Keys(key).Val())
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
@@ -19,7 +20,7 @@ func (s *Server) mfaChallenge(ctx context.Context, user *usersAPI.User) (*passpo
 	mfa := &passportAPI.MFAResponse{}
 
 	switch mfaType := user.Mfa.MFA.(type) {
-	case *usersAPI.MFA_FIDO:
+	case *usersAPI.MFA_FIDO, *usersAPI.MFA_FIDOKeys:
 		watn, err := webAuthn()
 		if err != nil {
 			return nil, err
@@ -28,19 +29,17 @@ func (s *Server) mfaChallenge(ctx context.Context, user *usersAPI.User) (*passpo
 		webAuthnUser := &webauthnUser{user}
 
 		assert, sessData, err := watn.BeginLogin(webAuthnUser)
-
-		assertData, err := json.Marshal(assert)
 		if err != nil {
 			return nil, err
 		}
 
-		//Store session data for challenge response
-		key := fmt.Sprintf("webAuthn.session.%x", assert.Response.AllowedCredentials[0].CredentialID)
-		cache, err := s.limiter.cache(ctx)
+		assertData, err := json.Marshal(assert)
 		if err != nil {
 			return nil, err
 		}
-		err = cache.Set(key, sessData, 5*time.Minute).Err()
+
+		//Store session data for challenge response
+		err = s.storeWebAuthnSession(ctx, webAuthnSessionKey("session", user.Id), sessData)
 		if err != nil {
 			return nil, err
 		}
@@ -65,7 +64,22 @@ func (s *Server) mfaChallenge(ctx context.Context, user *usersAPI.User) (*passpo
 	return &passportAPI.AuthResponse{Success: false, MFAResponse: mfa}, nil
 }
 
+//validateMFA checks the MFA response of the auth request against the users MFA
 func (s *Server) validateMFA(ctx context.Context, user *usersAPI.User, request *passportAPI.AuthRequest) (bool, error) {
-	//TODO(tcfw)
-	return false, nil
+	code := strings.TrimSpace(request.GetUserCreds().GetMFA())
+
+	switch mfaType := user.Mfa.MFA.(type) {
+	case *usersAPI.MFA_TOTP:
+		if isTOTPCode(code) {
+			return s.useTOTP(ctx, user.Id, mfaType.TOTP.Key, code)
+		}
+		return s.useBackupCode(ctx, user, code)
+
+	case *usersAPI.MFA_FIDO, *usersAPI.MFA_FIDOKeys:
+		return s.validateFIDO(ctx, user, code)
+
+	default:
+		//TODO(tcfw): SMS
+		return false, nil
+	}
 }
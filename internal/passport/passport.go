@@ -19,9 +19,11 @@ import (
 	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
 	"pm.tcfw.com.au/source/ataas/api/pb/users"
 	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
 	"pm.tcfw.com.au/source/ataas/internal/broadcast"
 	migrate "pm.tcfw.com.au/source/ataas/internal/passport/db"
 	authUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+	"pm.tcfw.com.au/source/ataas/internal/utils/recaptcha"
 	rpcUtils "pm.tcfw.com.au/source/ataas/internal/utils/rpc"
 )
 
@@ -79,6 +81,7 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 	}
 
 	var extraClaims map[string]interface{}
+	var user *users.User
 
 	switch authType := request.Creds.(type) {
 	case *passportAPI.AuthRequest_UserCreds:
@@ -92,16 +95,16 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 		}
 
 		if creds.Recaptcha == "" && creds.MFA == "" {
-			return s.limiter.IncreaseResp(ctx, remaining, remoteIP, username, "bad request")
+			return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, username, "bad request")
 		}
 
 		if viper.GetBool("recaptcha.enable") && creds.Recaptcha != "" {
-			valid, err := validateReCAPTCHA(ctx, creds.Recaptcha, remoteIP.String())
+			valid, err := recaptcha.Validate(ctx, creds.Recaptcha, remoteIP.String())
 			if err != nil {
 				return nil, err
 			}
 			if !valid {
-				return s.limiter.IncreaseResp(ctx, remaining, remoteIP, username, "bad request")
+				return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, username, "bad request")
 			}
 		}
 
@@ -111,7 +114,7 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 			return nil, err
 		}
 
-		user, err := usersSvc.Find(withAuthContext(ctx), &users.UserRequest{Query: &users.UserRequest_Email{Email: username}, Status: users.UserRequest_ACTIVE}, grpc.Header(&md))
+		user, err = usersSvc.Find(withAuthContext(ctx), &users.UserRequest{Query: &users.UserRequest_Email{Email: username}, Status: users.UserRequest_ACTIVE}, grpc.Header(&md))
 		if err != nil {
 			if serr, ok := status.FromError(err); ok && serr.Code() <= 16 {
 				s.log.WithField("status", serr.Code().String()).WithField("err", err).Error("failed to find user")
@@ -122,7 +125,7 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 				grpc.SendHeader(ctx, metadata.Pairs("x-http-code", "201"))
 				return nil, status.Error(codes.FailedPrecondition, "auth required")
 			}
-			return s.limiter.IncreaseResp(ctx, remaining, remoteIP, username, "Unknown user")
+			return s.loginFailed(ctx, audit.ActionLoginFailed, nil, remaining, remoteIP, username, "Unknown user")
 		}
 
 		if creds.Next {
@@ -132,14 +135,19 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 			}
 		}
 
+		//TOTP is only a second factor so the password is always required
+		totp := user.GetMfa().GetTOTP() != nil
+
 		//TODO(tcfw): Check blocked devFP+UID
-		if creds.InsecureLogin {
+		if creds.InsecureLogin || totp {
 			//Validate password hash
 			err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(creds.GetPassword()))
 			if err != nil {
-				return s.limiter.IncreaseResp(ctx, remaining, remoteIP, username, "Password mismatch")
+				return s.loginFailed(ctx, audit.ActionLoginFailed, user, remaining, remoteIP, username, "Password mismatch")
 			}
-		} else {
+		}
+
+		if !creds.InsecureLogin || totp {
 			if user.Mfa == nil {
 				return nil, status.Error(codes.FailedPrecondition, "bad request")
 			}
@@ -154,13 +162,16 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 				return nil, status.Error(500, err.Error())
 			}
 			if !valid {
-				return nil, status.Error(403, "Invalid challenge response")
+				return s.loginFailed(ctx, audit.ActionMFAFailed, user, remaining, remoteIP, username, "Invalid challenge response")
 			}
 		}
 
 		// clearRateLimit(username, remoteIP)
 		extraClaims = UserClaims(user)
 
+	case *passportAPI.AuthRequest_OauthClientSecretCreds:
+		return s.authenticateClient(ctx, request.GetOauthClientSecretCreds(), remoteIP)
+
 	default:
 		b.Publish("passport", &broadcast.AuthenticateEvent{
 			Event:    &broadcast.Event{Type: "vanga.passport.authenticate"},
@@ -171,11 +182,14 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 		return nil, fmt.Errorf("unknown auth type: %v", authType)
 	}
 
-	tokenString, token, err := s.makeNewToken(ctx, extraClaims)
+	tokenString, token, err := s.makeNewToken(ctx, extraClaims, "")
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, refreshExpire, err := s.makeNewRefresh(ctx, token, "")
 	if err != nil {
 		return nil, err
 	}
-	refreshToken := MakeNewRefresh(ctx)
 
 	b.Publish("passport", &broadcast.AuthenticateEvent{
 		Event:    &broadcast.Event{Type: "vanga.passport.authenticate"},
@@ -183,6 +197,12 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 		Success:  true,
 	})
 
+	method := "password"
+	if user.GetMfa() != nil {
+		method = "mfa"
+	}
+	auditLogin(ctx, user, method)
+
 	addSessionCookie(ctx, tokenString, token)
 
 	return &passportAPI.AuthResponse{
@@ -190,8 +210,8 @@ func (s *Server) Authenticate(ctx context.Context, request *passportAPI.AuthRequ
 		Tokens: &passportAPI.Tokens{
 			Token:         "",
 			TokenExpire:   token.Claims.(jwt.MapClaims)["exp"].(int64),
-			RefreshToken:  *refreshToken,
-			RefreshExpire: time.Now().Add(time.Hour * 8).Unix(),
+			RefreshToken:  refreshToken,
+			RefreshExpire: refreshExpire.Unix(),
 		},
 	}, nil
 }
@@ -209,7 +229,7 @@ func (s *Server) Sessions(ctx context.Context, _ *passportAPI.Empty) (*passportA
 		Sessions: []*passportAPI.Session{},
 	}
 
-	q := db.Build().Select("jti", "ip", "ua").From("sessions").Where(sq.And{sq.Eq{"sub": subject, "revoked": false}, sq.GtOrEq{"exp": time.Now()}})
+	q := db.Build().Select("jti", "ip", "ua", "refreshed").From("sessions").Where(sq.And{sq.Eq{"sub": subject, "revoked": false}, sq.GtOrEq{"exp": time.Now()}})
 	res, done, err := db.SimpleQuery(ctx, q)
 	if err != nil {
 		return nil, err
@@ -219,10 +239,16 @@ func (s *Server) Sessions(ctx context.Context, _ *passportAPI.Empty) (*passportA
 	for res.Next() {
 		session := &passportAPI.Session{}
 
-		if err := res.Scan(&session.Jti, &session.Ip, &session.UserAgent); err != nil {
+		var refreshed *time.Time
+
+		if err := res.Scan(&session.Jti, &session.Ip, &session.UserAgent, &refreshed); err != nil {
 			return nil, err
 		}
 
+		if refreshed != nil {
+			session.LastRefresh = refreshed.Unix()
+		}
+
 		ret.Sessions = append(ret.Sessions, session)
 	}
 
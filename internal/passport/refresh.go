@@ -0,0 +1,239 @@
+package passport
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jackc/pgx/v4"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
+)
+
+const (
+	refreshTokenSize = 64
+)
+
+var (
+	errInvalidRefresh = status.Error(codes.Unauthenticated, "invalid refresh token")
+)
+
+func init() {
+	viper.SetDefault("passport.refresh_ttl", "8h")
+}
+
+type refreshToken struct {
+	family string
+	jti    string
+	sub    string
+	exp    time.Time
+	used   bool
+}
+
+//hashToken refresh tokens and client secrets are only stored hashed
+func hashToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+//makeNewRefresh creates a new refresh token for the session of the token
+func (s *Server) makeNewRefresh(ctx context.Context, token *jwt.Token, family string) (string, time.Time, error) {
+	claims := token.Claims.(jwt.MapClaims)
+
+	if family == "" {
+		family = claims["jti"].(string)
+	}
+
+	refresh := RandomString(refreshTokenSize)
+	exp := time.Now().Add(viper.GetDuration("passport.refresh_ttl"))
+
+	q := db.Build().Insert("refresh_tokens").Columns("token_hash", "family", "jti", "sub", "exp").Values(
+		hashToken(refresh),
+		family,
+		claims["jti"].(string),
+		claims["sub"].(string),
+		exp,
+	)
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return refresh, exp, nil
+}
+
+//findRefresh finds a refresh token by its hash
+func findRefresh(ctx context.Context, token string) (*refreshToken, error) {
+	q := db.Build().Select("family", "jti", "sub", "exp", "used").From("refresh_tokens").Where(sq.Eq{"token_hash": hashToken(token)})
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return nil, pgx.ErrNoRows
+	}
+
+	rt := &refreshToken{}
+	if err := res.Scan(&rt.family, &rt.jti, &rt.sub, &rt.exp, &rt.used); err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+//useRefresh marks the refresh token as used, returning false if it was already used
+func useRefresh(ctx context.Context, token string) (bool, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := db.Build().Update("refresh_tokens").Set("used", true).Where(sq.Eq{"token_hash": hashToken(token), "used": false})
+	tag, err := db.Exec(ctx, tx, q)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+//isSessionActive checks if the session of the token hasn't been revoked, providing
+//when the token of the session expires
+func isSessionActive(ctx context.Context, jti string) (bool, time.Time, error) {
+	q := db.Build().Select("revoked", "exp").From("sessions").Where(sq.Eq{"jti": jti})
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return false, time.Time{}, nil
+	}
+
+	var revoked bool
+	var exp time.Time
+	if err := res.Scan(&revoked, &exp); err != nil {
+		return false, time.Time{}, err
+	}
+
+	return !revoked, exp, nil
+}
+
+//Refresh exchanges a refresh token for a new token and refresh token. Each refresh
+//token can only be used once; reuse of a refresh token indicates it has been
+//leaked so all sessions of the user are revoked
+func (s *Server) Refresh(ctx context.Context, req *passportAPI.RefreshRequest) (*passportAPI.AuthResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing refresh token")
+	}
+
+	rt, err := findRefresh(ctx, req.RefreshToken)
+	if err == pgx.ErrNoRows {
+		return nil, errInvalidRefresh
+	} else if err != nil {
+		return nil, err
+	}
+
+	if rt.used {
+		return nil, s.refreshReused(ctx, rt)
+	}
+
+	if rt.exp.Before(time.Now()) {
+		return nil, errInvalidRefresh
+	}
+
+	active, sessionExp, err := isSessionActive(ctx, rt.jti)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, errInvalidRefresh
+	}
+
+	ok, err := useRefresh(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		//Lost a race with another use of the same token
+		return nil, s.refreshReused(ctx, rt)
+	}
+
+	usersSvc, err := usersSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := usersSvc.Find(withAuthContext(ctx), &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Id{Id: rt.sub}, Status: usersAPI.UserRequest_ACTIVE})
+	if err != nil {
+		return nil, errInvalidRefresh
+	}
+
+	tokenString, token, err := s.makeNewToken(ctx, UserClaims(user), rt.family)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, refreshExpire, err := s.makeNewRefresh(ctx, token, rt.family)
+	if err != nil {
+		return nil, err
+	}
+
+	//The previous token of the session is replaced by the new token
+	if err := s.storeRevoked(ctx, rt.jti, sessionExp); err != nil {
+		return nil, err
+	}
+
+	addSessionCookie(ctx, tokenString, token)
+
+	return &passportAPI.AuthResponse{
+		Success: true,
+		Tokens: &passportAPI.Tokens{
+			TokenExpire:   token.Claims.(jwt.MapClaims)["exp"].(int64),
+			RefreshToken:  refreshToken,
+			RefreshExpire: refreshExpire.Unix(),
+		},
+	}, nil
+}
+
+//refreshReused revokes all sessions of the user after a refresh token was reused
+func (s *Server) refreshReused(ctx context.Context, rt *refreshToken) error {
+	s.log.WithField("sub", rt.sub).WithField("family", rt.family).Warn("refresh token reused, revoking all sessions")
+
+	if err := s.revokeAll(ctx, rt.sub, "refresh token reuse"); err != nil {
+		return err
+	}
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action: audit.ActionRefreshReused,
+		Target: rt.sub,
+		Detail: "all sessions revoked",
+	})
+
+	return errInvalidRefresh
+}
@@ -10,8 +10,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	auditAPI "pm.tcfw.com.au/source/ataas/api/pb/audit"
 	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
 	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/audit"
 	"pm.tcfw.com.au/source/ataas/internal/broadcast"
 	authUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
 )
@@ -53,6 +55,13 @@ func (s *Server) RevokeToken(ctx context.Context, request *passportAPI.Revoke) (
 		return nil, err
 	}
 
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionTokenRevoked,
+		Target:  request.Id,
+		Success: true,
+		Detail:  request.Reason,
+	})
+
 	clearSessionCookie(ctx)
 
 	return &passportAPI.Empty{}, nil
@@ -100,6 +109,38 @@ func (s *Server) RevokeAllTokens(ctx context.Context, _ *passportAPI.Empty) (*pa
 		return nil, err
 	}
 
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionTokensRevoked,
+		Target:  claims["sub"].(string),
+		Success: true,
+	})
+
+	return &passportAPI.Empty{}, nil
+}
+
+//RevokeUserTokens revokes all sessions of a user for other services, such as
+//when the user is deleted or their password changes
+func (s *Server) RevokeUserTokens(ctx context.Context, req *passportAPI.Revoke) (*passportAPI.Empty, error) {
+	service, err := authUtils.ServiceFromContext(ctx)
+	if err != nil || service == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "Unauthorised")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing user id")
+	}
+
+	if err := s.revokeAll(ctx, req.Id, req.Reason); err != nil {
+		return nil, err
+	}
+
+	audit.Record(ctx, &auditAPI.Event{
+		Action:  audit.ActionTokensRevoked,
+		Target:  req.Id,
+		Success: true,
+		Detail:  req.Reason,
+	})
+
 	return &passportAPI.Empty{}, nil
 }
 
@@ -140,7 +181,12 @@ func (s *Server) revokeToken(ctx context.Context, claims map[string]interface{},
 }
 
 func (s *Server) revokeAll(ctx context.Context, subject string, reason string) error {
-	q := db.Build().Select("jti", "sub", "exp").From("sessions").Where(sq.And{sq.Eq{"revoked": false, "sub": subject}, sq.GtOrEq{"exp": time.Now()}})
+	return s.revokeSessions(ctx, sq.Eq{"sub": subject}, reason)
+}
+
+//revokeSessions revokes all active sessions matching the filter
+func (s *Server) revokeSessions(ctx context.Context, filter sq.Sqlizer, reason string) error {
+	q := db.Build().Select("jti", "sub", "exp").From("sessions").Where(sq.And{filter, sq.Eq{"revoked": false}, sq.GtOrEq{"exp": time.Now()}})
 	res, done, err := db.SimpleQuery(ctx, q)
 	if err != nil {
 		return err
@@ -158,7 +204,7 @@ func (s *Server) revokeAll(ctx context.Context, subject string, reason string) e
 		s.revokeToken(ctx, map[string]interface{}{
 			"jti": jti,
 			"sub": sub,
-			"exp": exp.Unix(),
+			"exp": float64(exp.Unix()),
 		}, reason)
 	}
 
@@ -247,4 +293,10 @@ func (s *Server) cleanupRevoked() {
 	if err != nil {
 		s.log.WithError(err).Error("failed to gc sessions table")
 	}
+
+	q = db.Build().Delete("refresh_tokens").Where(sq.Lt{"exp": time.Now()})
+	err = db.SimpleExec(context.Background(), q)
+	if err != nil {
+		s.log.WithError(err).Error("failed to gc refresh tokens table")
+	}
 }
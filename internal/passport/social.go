@@ -50,8 +50,14 @@ func (s *Server) SocialLogin(ctx context.Context, request *passportAPI.SocialReq
 	extraClaims := UserClaims(user)
 	s.limiter.Clear(ctx, info.Email, remoteIP)
 
-	tokenString, token, _ := s.makeNewToken(ctx, extraClaims)
-	refreshToken := MakeNewRefresh(ctx)
+	tokenString, token, err := s.makeNewToken(ctx, extraClaims, "")
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, refreshExpire, err := s.makeNewRefresh(ctx, token, "")
+	if err != nil {
+		return nil, err
+	}
 
 	b, err := broadcast.Driver()
 	if err != nil {
@@ -65,6 +71,8 @@ func (s *Server) SocialLogin(ctx context.Context, request *passportAPI.SocialReq
 		IP:       remoteIP.String(),
 	})
 
+	auditLogin(ctx, user, request.GetProvider())
+
 	addSessionCookie(ctx, tokenString, token)
 
 	return &passportAPI.AuthResponse{
@@ -72,8 +80,8 @@ func (s *Server) SocialLogin(ctx context.Context, request *passportAPI.SocialReq
 		Tokens: &passportAPI.Tokens{
 			Token:         "",
 			TokenExpire:   token.Claims.(jwt.MapClaims)["exp"].(int64),
-			RefreshToken:  *refreshToken,
-			RefreshExpire: time.Now().Add(time.Hour * 8).Unix(),
+			RefreshToken:  refreshToken,
+			RefreshExpire: refreshExpire.Unix(),
 		},
 	}, nil
 }
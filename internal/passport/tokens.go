@@ -25,6 +25,7 @@ import (
 func init() {
 	viper.SetDefault("passport.token.key", "passport.key")
 	viper.SetDefault("passport.token.cert", "passport.cert")
+	viper.SetDefault("passport.client_token_ttl", "1h")
 }
 
 func (s *Server) verifyToken(ctx context.Context, request *passportAPI.VerifyTokenRequest) (*passportAPI.VerifyTokenResponse, error) {
@@ -108,8 +109,9 @@ func GetKeyPublic() (*ecdsa.PublicKey, error) {
 	return key, nil
 }
 
-//makeNewToken creates a new JWT token for the specific user
-func (s *Server) makeNewToken(ctx context.Context, extraClaims map[string]interface{}) (string, *jwt.Token, error) {
+//makeNewToken creates a new JWT token for the specific user. Tokens created by
+//refreshing a session continue the family of the session, otherwise a new family is started
+func (s *Server) makeNewToken(ctx context.Context, extraClaims map[string]interface{}, family string) (string, *jwt.Token, error) {
 	signer := jwt.New(jwt.SigningMethodES256)
 
 	//set claims
@@ -123,9 +125,15 @@ func (s *Server) makeNewToken(ctx context.Context, extraClaims map[string]interf
 
 	expr := time.Now().UTC().Add(time.Hour * 168)
 
+	//API client tokens are short lived as clients can always authenticate again
+	client, isClient := extraClaims["cid"]
+	if isClient {
+		expr = time.Now().UTC().Add(viper.GetDuration("passport.client_token_ttl"))
+	}
+
 	claims["nbf"] = time.Now().UTC().Unix() - 1
 	claims["iat"] = time.Now().UTC().Unix()
-	claims["exp"] = expr.Unix() // 1 week
+	claims["exp"] = expr.Unix() // 1 week by default
 	claims["jti"] = uuid.New().String()
 
 	for claimKey, claimValue := range extraClaims {
@@ -160,12 +168,22 @@ func (s *Server) makeNewToken(ctx context.Context, extraClaims map[string]interf
 		ua = md.Get("grpcgateway-user-agent")[0]
 	}
 
-	q := db.Build().Insert("sessions").Columns("jti", "sub", "exp", "ip", "ua").Values(
+	var refreshed interface{}
+	if family == "" {
+		family = claims["jti"].(string)
+	} else {
+		refreshed = time.Now()
+	}
+
+	q := db.Build().Insert("sessions").Columns("jti", "sub", "exp", "ip", "ua", "family", "refreshed", "client").Values(
 		claims["jti"].(string),
 		claims["sub"].(string),
 		expr,
 		ip,
 		ua,
+		family,
+		refreshed,
+		client,
 	)
 
 	err = db.SimpleExec(ctx, q)
@@ -199,12 +217,6 @@ func MakeTestToken(user *users.User) (string, error) {
 	return signer.SignedString([]byte("this is a super secret key, DO NOT USE FOR PRODUCTION"))
 }
 
-//MakeNewRefresh creates a new refresh token
-func MakeNewRefresh(ctx context.Context) *string {
-	randomString := RandomString(256)
-	return &randomString
-}
-
 //RandomString generates a n lengthed string (cryptographically)
 func RandomString(n int) string {
 	var randomBytes = make([]byte, n/2)
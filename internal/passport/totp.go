@@ -0,0 +1,373 @@
+package passport
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	authUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+const (
+	totpIssuer     = "ATaaS"
+	totpPeriod     = 30
+	totpDigits     = 6
+	totpSkew       = 1
+	totpSecretSize = 20
+
+	//totpEnrolTTL how long a generated secret waits to be confirmed
+	totpEnrolTTL = 10 * time.Minute
+	//totpUsedTTL outlives the window any used code can still be valid in
+	totpUsedTTL = 2 * (2*totpSkew + 1) * totpPeriod * time.Second
+
+	backupCodeCount  = 10
+	backupCodeLength = 10
+)
+
+var (
+	b32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	//totpUseScript atomically records the counter as used if it is newer than the last used
+	totpUseScript = redis.NewScript(`
+local last = tonumber(redis.call('GET', KEYS[1]) or '-1')
+if tonumber(ARGV[1]) <= last then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'EX', ARGV[2])
+return 1`)
+)
+
+//newTOTPSecret generates a random base32 encoded TOTP secret
+func newTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return b32NoPadding.EncodeToString(b), nil
+}
+
+//totpURI provisioning URI for authenticator apps, usually displayed as a QR code
+func totpURI(secret string, account string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", totpIssuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", totpDigits))
+	v.Set("period", fmt.Sprintf("%d", totpPeriod))
+
+	label := url.PathEscape(totpIssuer + ":" + account)
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, v.Encode())
+}
+
+//hotp RFC 4226 code for the counter
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0xf
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, bin%mod)
+}
+
+//matchTOTP checks the code against the time steps around t, returning the
+//matching time step counter
+func matchTOTP(secret string, code string, t time.Time) (uint64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	key, err := b32NoPadding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return 0, false
+	}
+
+	counter := uint64(t.Unix() / totpPeriod)
+
+	for i := -totpSkew; i <= totpSkew; i++ {
+		c := counter + uint64(i)
+		if subtle.ConstantTimeCompare([]byte(hotp(key, c)), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+
+	return 0, false
+}
+
+//isTOTPCode if the code is in the format of a TOTP code rather than a backup code
+func isTOTPCode(code string) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+//newBackupCodes generates a set of backup codes, returning the codes to display
+//to the user and the hashes to store
+func newBackupCodes() ([]string, []string, error) {
+	codes := make([]string, 0, backupCodeCount)
+	hashes := make([]string, 0, backupCodeCount)
+
+	b := make([]byte, backupCodeLength*5/8)
+
+	for i := 0; i < backupCodeCount; i++ {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		code := strings.ToLower(b32NoPadding.EncodeToString(b))
+		codes = append(codes, code[:backupCodeLength/2]+"-"+code[backupCodeLength/2:])
+		hashes = append(hashes, hashBackupCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+//hashBackupCode backup codes are stored hashed, ignoring case and formatting
+func hashBackupCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	h := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(h[:])
+}
+
+//useTOTP validates the code against the users secret, rejecting codes from
+//time steps which have already been used
+func (s *Server) useTOTP(ctx context.Context, uid string, secret string, code string) (bool, error) {
+	counter, ok := matchTOTP(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	cache, err := s.limiter.cache(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	key := fmt.Sprintf("totp.used.%s", uid)
+	res, err := totpUseScript.Run(cache, []string{key}, counter, int(totpUsedTTL.Seconds())).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return res == 1, nil
+}
+
+//useBackupCode validates and removes a backup code from the user
+func (s *Server) useBackupCode(ctx context.Context, user *usersAPI.User, code string) (bool, error) {
+	totp := user.GetMfa().GetTOTP()
+	hash := hashBackupCode(code)
+
+	n := -1
+	for i, c := range totp.BackupCodes {
+		if subtle.ConstantTimeCompare([]byte(c), []byte(hash)) == 1 {
+			n = i
+		}
+	}
+	if n == -1 {
+		return false, nil
+	}
+
+	//Guard against concurrent logins using the same code before it's removed
+	cache, err := s.limiter.cache(ctx)
+	if err != nil {
+		return false, err
+	}
+	claimed, err := cache.SetNX(fmt.Sprintf("totp.backup.%s.%s", user.Id, hash), 1, totpEnrolTTL).Result()
+	if err != nil {
+		return false, err
+	}
+	if !claimed {
+		return false, nil
+	}
+
+	totp.BackupCodes = append(totp.BackupCodes[:n], totp.BackupCodes[n+1:]...)
+
+	if err := amendUser(ctx, user); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+//EnrolTOTP generates a new TOTP secret for the user which must be confirmed
+//with a valid code via ConfirmTOTP before it's used for logins
+func (s *Server) EnrolTOTP(ctx context.Context, _ *passportAPI.Empty) (*passportAPI.TOTPEnrolment, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.GetMfa().GetTOTP() != nil {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP already enrolled")
+	}
+	if user.Mfa != nil {
+		return nil, status.Error(codes.FailedPrecondition, "another MFA type is already enrolled")
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.limiter.cache(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.Set(fmt.Sprintf("totp.enrol.%s", user.Id), secret, totpEnrolTTL).Err(); err != nil {
+		return nil, err
+	}
+
+	return &passportAPI.TOTPEnrolment{Secret: secret, Uri: totpURI(secret, user.Email)}, nil
+}
+
+//ConfirmTOTP enables TOTP MFA for the user once a code from the enrolled secret
+//is provided, returning a new set of backup codes
+func (s *Server) ConfirmTOTP(ctx context.Context, req *passportAPI.TOTPCode) (*passportAPI.BackupCodes, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.limiter.cache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//another MFA type may have been enrolled since the enrolment began
+	if user.Mfa != nil && user.Mfa.GetTOTP() == nil {
+		return nil, status.Error(codes.FailedPrecondition, "another MFA type is already enrolled")
+	}
+
+	enrolKey := fmt.Sprintf("totp.enrol.%s", user.Id)
+	secret, err := cache.Get(enrolKey).Result()
+	if err == redis.Nil {
+		return nil, status.Error(codes.FailedPrecondition, "no pending TOTP enrolment")
+	} else if err != nil {
+		return nil, err
+	}
+
+	valid, err := s.useTOTP(ctx, user.Id, secret, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	backupCodes, hashes, err := newBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	user.Mfa = &usersAPI.MFA{MFA: &usersAPI.MFA_TOTP{TOTP: &usersAPI.MFATOTP{Key: secret, BackupCodes: hashes}}}
+
+	if err := amendUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	cache.Del(enrolKey)
+
+	auditMFAChanged(ctx, user, "totp enrolled")
+
+	return &passportAPI.BackupCodes{Codes: backupCodes}, nil
+}
+
+//RegenerateBackupCodes replaces all backup codes of the user. A current TOTP
+//code is required
+func (s *Server) RegenerateBackupCodes(ctx context.Context, req *passportAPI.TOTPCode) (*passportAPI.BackupCodes, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totp := user.GetMfa().GetTOTP()
+	if totp == nil {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP not enrolled")
+	}
+
+	valid, err := s.useTOTP(ctx, user.Id, totp.Key, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	backupCodes, hashes, err := newBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	totp.BackupCodes = hashes
+
+	if err := amendUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	auditMFAChanged(ctx, user, "backup codes regenerated")
+
+	return &passportAPI.BackupCodes{Codes: backupCodes}, nil
+}
+
+//currentUser finds the user of the auth token
+func currentUser(ctx context.Context) (*usersAPI.User, error) {
+	claims, err := authUtils.TokenClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, ok := claims["sub"].(string)
+	if !ok || sub == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing subject")
+	}
+
+	usersSvc, err := usersSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	return usersSvc.Find(withAuthContext(ctx), &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Id{Id: sub}, Status: usersAPI.UserRequest_ACTIVE})
+}
+
+//amendUser saves the user via the users service
+func amendUser(ctx context.Context, user *usersAPI.User) error {
+	usersSvc, err := usersSvc()
+	if err != nil {
+		return err
+	}
+
+	_, err = usersSvc.Amend(withAuthContext(ctx), &usersAPI.UpdateRequest{Id: user.Id, User: user})
+	return err
+}
@@ -0,0 +1,79 @@
+package passport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHOTP(t *testing.T) {
+	//RFC 4226 appendix D
+	key := []byte("12345678901234567890")
+	expected := []string{"755224", "287082", "359152", "969429", "338314"}
+
+	for i, code := range expected {
+		assert.Equal(t, code, hotp(key, uint64(i)))
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	secret := b32NoPadding.EncodeToString([]byte("12345678901234567890"))
+
+	//RFC 6238 appendix B, truncated to 6 digits
+	counter, ok := matchTOTP(secret, "287082", time.Unix(59, 0))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), counter)
+
+	counter, ok = matchTOTP(secret, "081804", time.Unix(1111111109, 0))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1111111109/totpPeriod), counter)
+
+	//Skew
+	counter, ok = matchTOTP(secret, "081804", time.Unix(1111111109+totpPeriod, 0))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1111111109/totpPeriod), counter)
+
+	_, ok = matchTOTP(secret, "081804", time.Unix(1111111109+2*totpPeriod, 0))
+	assert.False(t, ok)
+
+	_, ok = matchTOTP(secret, "000000", time.Unix(59, 0))
+	assert.False(t, ok)
+}
+
+func TestTOTPSecret(t *testing.T) {
+	secret, err := newTOTPSecret()
+	require.NoError(t, err)
+
+	key, err := b32NoPadding.DecodeString(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, totpSecretSize)
+
+	now := time.Now()
+	code := hotp(key, uint64(now.Unix()/totpPeriod))
+	_, ok := matchTOTP(secret, code, now)
+	assert.True(t, ok)
+	assert.True(t, isTOTPCode(code))
+
+	uri := totpURI(secret, "someone@example.com")
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/ATaaS:someone@example.com?"))
+	assert.Contains(t, uri, "secret="+secret)
+}
+
+func TestBackupCodes(t *testing.T) {
+	codes, hashes, err := newBackupCodes()
+	require.NoError(t, err)
+	assert.Len(t, codes, backupCodeCount)
+	assert.Len(t, hashes, backupCodeCount)
+
+	for i, code := range codes {
+		assert.Len(t, code, backupCodeLength+1)
+		assert.False(t, isTOTPCode(code))
+		assert.Equal(t, hashes[i], hashBackupCode(code))
+		assert.Equal(t, hashes[i], hashBackupCode(strings.ToUpper(strings.Replace(code, "-", "", 1))))
+	}
+
+	assert.NotEqual(t, hashes[0], hashes[1])
+}
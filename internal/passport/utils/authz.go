@@ -0,0 +1,374 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+)
+
+//Policy who may call a method
+type Policy int
+
+const (
+	//PolicyDeny no one may call the method
+	PolicyDeny Policy = iota
+	//PolicyPublic anyone may call the method
+	PolicyPublic
+	//PolicyUser callers must have a valid token
+	PolicyUser
+	//PolicyAdmin callers must have a valid token with the admin claim
+	PolicyAdmin
+	//PolicyInternal only other services may call the method
+	PolicyInternal
+)
+
+func (p Policy) String() string {
+	switch p {
+	case PolicyPublic:
+		return "public"
+	case PolicyUser:
+		return "user"
+	case PolicyAdmin:
+		return "admin"
+	case PolicyInternal:
+		return "internal"
+	default:
+		return "deny"
+	}
+}
+
+var (
+	//methodPolicies the policy of each method. Methods not listed are denied.
+	//Calls made by other services are allowed for all policies, but calls they
+	//relay for a user are still checked against the user's token
+	methodPolicies = map[string]Policy{
+		"/ataas.audit.AuditService/ListAuditEvents":   PolicyUser,
+		"/ataas.audit.AuditService/ExportAuditEvents": PolicyAdmin,
+		"/ataas.audit.AuditService/Record":            PolicyInternal,
+
+		"/ataas.blocks.BlocksService/New":                PolicyUser,
+		"/ataas.blocks.BlocksService/List":               PolicyUser,
+		"/ataas.blocks.BlocksService/Get":                PolicyUser,
+		"/ataas.blocks.BlocksService/Update":             PolicyUser,
+		"/ataas.blocks.BlocksService/ManualAction":       PolicyUser,
+		"/ataas.blocks.BlocksService/ListManualActions":  PolicyUser,
+		"/ataas.blocks.BlocksService/DecideManualAction": PolicyUser,
+		"/ataas.blocks.BlocksService/Delete":             PolicyUser,
+		"/ataas.blocks.BlocksService/PnL":                PolicyUser,
+		"/ataas.blocks.BlocksService/AccountPnL":         PolicyUser,
+		"/ataas.blocks.BlocksService/PnLSeries":          PolicyUser,
+		"/ataas.blocks.BlocksService/CalcState":          PolicyInternal,
+		"/ataas.blocks.BlocksService/Find":               PolicyInternal,
+		"/ataas.blocks.BlocksService/ApplyOrder":         PolicyInternal,
+
+		"/ataas.excreds.ExCredsService/New":        PolicyUser,
+		"/ataas.excreds.ExCredsService/List":       PolicyUser,
+		"/ataas.excreds.ExCredsService/Delete":     PolicyUser,
+		"/ataas.excreds.ExCredsService/Test":       PolicyUser,
+		"/ataas.excreds.ExCredsService/Get":        PolicyInternal,
+		"/ataas.excreds.ExCredsService/RotateKeys": PolicyAdmin,
+
+		"/ataas.notify.NotifyService/Send": PolicyInternal,
+
+		"/ataas.orders.OrdersService/Create":        PolicyUser,
+		"/ataas.orders.OrdersService/Get":           PolicyUser,
+		"/ataas.orders.OrdersService/ListOpen":      PolicyUser,
+		"/ataas.orders.OrdersService/Cancel":        PolicyUser,
+		"/ataas.orders.OrdersService/PaperBalances": PolicyUser,
+
+		"/ataas.passport.PassportSevice/VerifyToken":            PolicyInternal,
+		"/ataas.passport.PassportSevice/Authenticate":           PolicyPublic,
+		"/ataas.passport.PassportSevice/Refresh":                PolicyPublic,
+		"/ataas.passport.PassportSevice/SocialLogin":            PolicyPublic,
+		"/ataas.passport.PassportSevice/FinishFIDOLogin":        PolicyPublic,
+		"/ataas.passport.PassportSevice/RevokeToken":            PolicyUser,
+		"/ataas.passport.PassportSevice/RevokeAllTokens":        PolicyUser,
+		"/ataas.passport.PassportSevice/RevokeUserTokens":       PolicyInternal,
+		"/ataas.passport.PassportSevice/Sessions":               PolicyUser,
+		"/ataas.passport.PassportSevice/CreateAPIClient":        PolicyUser,
+		"/ataas.passport.PassportSevice/ListAPIClients":         PolicyUser,
+		"/ataas.passport.PassportSevice/RevokeAPIClient":        PolicyUser,
+		"/ataas.passport.PassportSevice/BeginFIDORegistration":  PolicyUser,
+		"/ataas.passport.PassportSevice/FinishFIDORegistration": PolicyUser,
+		"/ataas.passport.PassportSevice/EnrolTOTP":              PolicyUser,
+		"/ataas.passport.PassportSevice/ConfirmTOTP":            PolicyUser,
+		"/ataas.passport.PassportSevice/RegenerateBackupCodes":  PolicyUser,
+
+		"/ataas.strategy.StrategyService/List":     PolicyUser,
+		"/ataas.strategy.StrategyService/History":  PolicyUser,
+		"/ataas.strategy.StrategyService/Create":   PolicyUser,
+		"/ataas.strategy.StrategyService/Delete":   PolicyUser,
+		"/ataas.strategy.StrategyService/Get":      PolicyUser,
+		"/ataas.strategy.StrategyService/BackTest": PolicyUser,
+		"/ataas.strategy.StrategyService/Update":   PolicyUser,
+
+		"/ataas.ticks.HistoryService/Trades":            PolicyUser,
+		"/ataas.ticks.HistoryService/TradesRange":       PolicyUser,
+		"/ataas.ticks.HistoryService/TradesRangeStream": PolicyUser,
+		"/ataas.ticks.HistoryService/Candles":           PolicyUser,
+		"/ataas.ticks.HistoryService/Depth":             PolicyUser,
+		"/ataas.ticks.HistoryService/Markets":           PolicyUser,
+		"/ataas.ticks.HistoryService/Collected":         PolicyUser,
+		"/ataas.ticks.HistoryService/AddCollected":      PolicyAdmin,
+		"/ataas.ticks.HistoryService/RemoveCollected":   PolicyAdmin,
+
+		"/ataas.users.UserService/Create":          PolicyPublic,
+		"/ataas.users.UserService/ValidateAccount": PolicyPublic,
+		"/ataas.users.UserService/ForgotPassword":  PolicyPublic,
+		"/ataas.users.UserService/ResetPassword":   PolicyPublic,
+		"/ataas.users.UserService/Delete":          PolicyUser,
+		"/ataas.users.UserService/Get":             PolicyUser,
+		"/ataas.users.UserService/List":            PolicyAdmin,
+		"/ataas.users.UserService/SetPassword":     PolicyUser,
+		"/ataas.users.UserService/Update":          PolicyUser,
+		"/ataas.users.UserService/Me":              PolicyUser,
+		"/ataas.users.UserService/CreateOrg":       PolicyUser,
+		"/ataas.users.UserService/ListOrgs":        PolicyUser,
+		"/ataas.users.UserService/ListOrgMembers":  PolicyUser,
+		"/ataas.users.UserService/AddOrgMember":    PolicyUser,
+		"/ataas.users.UserService/UpdateOrgMember": PolicyUser,
+		"/ataas.users.UserService/RemoveOrgMember": PolicyUser,
+		"/ataas.users.UserService/Find":            PolicyInternal,
+		"/ataas.users.UserService/Amend":           PolicyInternal,
+		"/ataas.users.UserService/Membership":      PolicyInternal,
+	}
+
+	//methodRoles the minimum role the caller must have in the account the request
+	//acts on. Methods not listed leave membership checks to the method itself
+	methodRoles = map[string]usersAPI.OrgRole{
+		"/ataas.strategy.StrategyService/Create": usersAPI.OrgRole_TRADER,
+		"/ataas.strategy.StrategyService/Update": usersAPI.OrgRole_TRADER,
+		"/ataas.strategy.StrategyService/Delete": usersAPI.OrgRole_TRADER,
+
+		"/ataas.blocks.BlocksService/New":                usersAPI.OrgRole_TRADER,
+		"/ataas.blocks.BlocksService/Update":             usersAPI.OrgRole_TRADER,
+		"/ataas.blocks.BlocksService/Delete":             usersAPI.OrgRole_TRADER,
+		"/ataas.blocks.BlocksService/DecideManualAction": usersAPI.OrgRole_TRADER,
+
+		"/ataas.orders.OrdersService/Create": usersAPI.OrgRole_TRADER,
+		"/ataas.orders.OrdersService/Cancel": usersAPI.OrgRole_TRADER,
+
+		"/ataas.excreds.ExCredsService/New":    usersAPI.OrgRole_OWNER,
+		"/ataas.excreds.ExCredsService/Delete": usersAPI.OrgRole_OWNER,
+
+		"/ataas.passport.PassportSevice/CreateAPIClient": usersAPI.OrgRole_OWNER,
+		"/ataas.passport.PassportSevice/RevokeAPIClient": usersAPI.OrgRole_OWNER,
+	}
+
+	publicKeyMu sync.Mutex
+	publicKey   *ecdsa.PublicKey
+)
+
+//MethodPolicy provides the policy of the method
+func MethodPolicy(method string) Policy {
+	return methodPolicies[method]
+}
+
+//tokenPublicKey the key passport signs tokens with
+func tokenPublicKey() (*ecdsa.PublicKey, error) {
+	publicKeyMu.Lock()
+	defer publicKeyMu.Unlock()
+
+	if publicKey != nil {
+		return publicKey, nil
+	}
+
+	dat, err := ioutil.ReadFile(viper.GetString("passport.token.cert"))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwt.ParseECPublicKeyFromPEM(dat)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey = key
+
+	return publicKey, nil
+}
+
+//VerifiedClaimsFromContext validates the signature and expiry of the token in
+//the context and returns its claims
+func VerifiedClaimsFromContext(ctx context.Context) (map[string]interface{}, error) {
+	token, err := GetAuthToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := tokenPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+//denial a rejected call
+type denial struct {
+	code   codes.Code
+	reason string
+	claims map[string]interface{}
+}
+
+//authorize checks the caller against the policy of the method
+func authorize(ctx context.Context, method string) *denial {
+	policy := MethodPolicy(method)
+
+	if policy == PolicyDeny {
+		return &denial{code: codes.PermissionDenied, reason: "no policy"}
+	}
+
+	if policy == PolicyPublic {
+		return nil
+	}
+
+	service, err := ServiceFromContext(ctx)
+	if err != nil {
+		return &denial{code: codes.Unauthenticated, reason: err.Error()}
+	}
+
+	if policy == PolicyInternal {
+		if service == "" {
+			return &denial{code: codes.PermissionDenied, reason: "internal method"}
+		}
+		return nil
+	}
+
+	//Services acting on their own behalf aren't limited. Calls relayed for a user
+	//are still limited to what the user may do
+	relayed := false
+	if service != "" {
+		if _, err := GetAuthToken(ctx); err != nil {
+			return nil
+		}
+		relayed = true
+	}
+
+	claims, err := VerifiedClaimsFromContext(ctx)
+	if err != nil {
+		return &denial{code: codes.Unauthenticated, reason: err.Error()}
+	}
+
+	if d := checkSession(ctx, claims); d != nil {
+		return d
+	}
+
+	//Relayed reads serve a call already checked against the scopes of the token, so
+	//only methods requiring a role are scope limited when relayed
+	_, privileged := methodRoles[method]
+	if _, scoped := claims["scp"]; scoped && (!relayed || privileged) && !ScopeAllowed(method, ScopesFromClaims(claims)) {
+		return &denial{code: codes.PermissionDenied, reason: "scope", claims: claims}
+	}
+
+	if _, admin := claims["admin"]; policy == PolicyAdmin && !admin {
+		return &denial{code: codes.PermissionDenied, reason: "not admin", claims: claims}
+	}
+
+	if min, ok := methodRoles[method]; ok {
+		if _, err := RequireRole(ctx, min); err != nil {
+			code := status.Code(err)
+			if code == codes.Unknown {
+				code = codes.Internal
+			}
+			return &denial{code: code, reason: "role: " + err.Error(), claims: claims}
+		}
+	}
+
+	return nil
+}
+
+//checkSession ensures the session of the token hasn't been revoked
+func checkSession(ctx context.Context, claims map[string]interface{}) *denial {
+	token, err := GetAuthToken(ctx)
+	if err != nil {
+		return &denial{code: codes.Unauthenticated, reason: err.Error(), claims: claims}
+	}
+
+	jti, _ := claims["jti"].(string)
+	exp, _ := claims["exp"].(float64)
+	if jti == "" || exp == 0 {
+		return &denial{code: codes.Unauthenticated, reason: "missing token id", claims: claims}
+	}
+
+	active, err := cachedSessionActive(ctx, token, jti, time.Unix(int64(exp), 0))
+	if err != nil {
+		return &denial{code: codes.Unavailable, reason: "session: " + err.Error(), claims: claims}
+	}
+	if !active {
+		return &denial{code: codes.Unauthenticated, reason: "session revoked", claims: claims}
+	}
+
+	return nil
+}
+
+//logDenial records rejected calls for auditing
+func logDenial(log *logrus.Logger, ctx context.Context, method string, d *denial) {
+	fields := logrus.Fields{
+		"method": method,
+		"policy": MethodPolicy(method).String(),
+		"reason": d.reason,
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		fields["peer"] = p.Addr.String()
+	}
+	if d.claims != nil {
+		fields["sub"] = d.claims["sub"]
+		if cid, ok := d.claims["cid"]; ok {
+			fields["cid"] = cid
+		}
+	}
+
+	log.WithFields(fields).Warn("authorization denied")
+}
+
+//AuthzUnaryServerInterceptor rejects calls which aren't allowed by the policy of the method
+func AuthzUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	log := logrus.New()
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if d := authorize(ctx, info.FullMethod); d != nil {
+			logDenial(log, ctx, info.FullMethod, d)
+			return nil, status.Error(d.code, "Unauthorised")
+		}
+		return handler(ctx, req)
+	}
+}
+
+//AuthzStreamServerInterceptor rejects streams which aren't allowed by the policy of the method
+func AuthzStreamServerInterceptor() grpc.StreamServerInterceptor {
+	log := logrus.New()
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if d := authorize(ss.Context(), info.FullMethod); d != nil {
+			logDenial(log, ss.Context(), info.FullMethod, d)
+			return status.Error(d.code, "Unauthorised")
+		}
+		return handler(srv, ss)
+	}
+}
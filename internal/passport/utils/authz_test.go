@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+)
+
+func testTokenKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKeyMu.Lock()
+	publicKey = &key.PublicKey
+	publicKeyMu.Unlock()
+
+	stubSessions(t)
+
+	return key
+}
+
+//stubSessions treats every session as active other than the revoked token ids
+func stubSessions(t *testing.T, revoked ...string) {
+	lookup := sessionLookup
+	t.Cleanup(func() {
+		sessionLookup = lookup
+		sessionCacheMu.Lock()
+		sessionCache = map[string]sessionEntry{}
+		sessionCacheMu.Unlock()
+	})
+
+	sessionLookup = func(ctx context.Context, token string) (bool, error) {
+		claims, err := TokenClaims(token)
+		if err != nil {
+			return false, err
+		}
+		for _, jti := range revoked {
+			if claims["jti"] == jti {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+func tokenContext(t *testing.T, key interface{}, method jwt.SigningMethod, claims jwt.MapClaims) context.Context {
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(time.Minute).Unix()
+	}
+	if _, ok := claims["jti"]; !ok {
+		claims["jti"] = uuid.New().String()
+	}
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token))
+}
+
+func serviceContext(t *testing.T, ctx context.Context) context.Context {
+	md, err := (&ServiceCredentials{}).GetRequestMetadata(ctx)
+	require.NoError(t, err)
+
+	in, _ := metadata.FromIncomingContext(ctx)
+	return metadata.NewIncomingContext(ctx, metadata.Join(in, metadata.New(md)))
+}
+
+func TestAuthzInterceptor(t *testing.T) {
+	key := testTokenKey(t)
+
+	interceptor := AuthzUnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) codes.Code {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+
+	const (
+		public   = "/ataas.passport.PassportSevice/Authenticate"
+		user     = "/ataas.strategy.StrategyService/Create"
+		list     = "/ataas.strategy.StrategyService/List"
+		admin    = "/ataas.users.UserService/List"
+		internal = "/ataas.excreds.ExCredsService/Get"
+	)
+
+	none := context.Background()
+	assert.Equal(t, codes.OK, call(none, public))
+	assert.Equal(t, codes.Unauthenticated, call(none, user))
+	assert.Equal(t, codes.PermissionDenied, call(none, internal))
+	assert.Equal(t, codes.PermissionDenied, call(none, "/ataas.unknown.Service/Method"))
+
+	userCtx := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "acn": "personal"})
+	assert.Equal(t, codes.OK, call(userCtx, user))
+	assert.Equal(t, codes.PermissionDenied, call(userCtx, admin))
+	assert.Equal(t, codes.PermissionDenied, call(userCtx, internal))
+
+	adminCtx := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "admin": true})
+	assert.Equal(t, codes.OK, call(adminCtx, admin))
+
+	//Unsigned or expired tokens
+	forged := tokenContext(t, []byte("forged"), jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user", "admin": true})
+	assert.Equal(t, codes.Unauthenticated, call(forged, admin))
+	expired := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "exp": time.Now().Add(-time.Minute).Unix()})
+	assert.Equal(t, codes.Unauthenticated, call(expired, user))
+
+	clientCtx := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "cid": "client", "scp": []string{ScopeStrategiesRead}})
+	assert.Equal(t, codes.OK, call(clientCtx, list))
+	assert.Equal(t, codes.PermissionDenied, call(clientCtx, user))
+
+	//Services may call internal methods and aren't limited on their own behalf
+	assert.Equal(t, codes.OK, call(serviceContext(t, none), internal))
+	assert.Equal(t, codes.OK, call(serviceContext(t, none), user))
+	assert.Equal(t, codes.OK, call(serviceContext(t, clientCtx), internal))
+
+	//Calls relayed for a client are limited by its scopes where a role is required
+	assert.Equal(t, codes.PermissionDenied, call(serviceContext(t, clientCtx), user))
+	assert.Equal(t, codes.OK, call(serviceContext(t, clientCtx), "/ataas.blocks.BlocksService/List"))
+	assert.Equal(t, codes.Unauthenticated, call(serviceContext(t, forged), user))
+}
+
+func TestAuthzRevokedSession(t *testing.T) {
+	key := testTokenKey(t)
+	stubSessions(t, "revoked")
+
+	interceptor := AuthzUnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) codes.Code {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+
+	const list = "/ataas.strategy.StrategyService/List"
+
+	active := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "acn": "personal"})
+	assert.Equal(t, codes.OK, call(active, list))
+
+	revokedCtx := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "acn": "personal", "jti": "revoked"})
+	assert.Equal(t, codes.Unauthenticated, call(revokedCtx, list))
+	assert.Equal(t, codes.Unauthenticated, call(serviceContext(t, revokedCtx), list), "relayed calls check the session too")
+}
+
+func TestServiceIdentity(t *testing.T) {
+	name, err := ServiceFromContext(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+
+	name, err = ServiceFromContext(serviceContext(t, context.Background()))
+	assert.NoError(t, err)
+	assert.Equal(t, "ataas", name)
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
+		Issuer:    "ataas",
+		Audience:  serviceIdentityAudience,
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	}).SignedString([]byte("forged"))
+	require.NoError(t, err)
+
+	_, err = ServiceFromContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(serviceIdentityHeader, forged)))
+	assert.Error(t, err)
+}
+
+func TestAuthzMemberRoles(t *testing.T) {
+	key := testTokenKey(t)
+
+	lookup := membershipLookup
+	defer func() { membershipLookup = lookup }()
+
+	membershipLookup = func(ctx context.Context, uid, account string) (usersAPI.OrgRole, error) {
+		switch account {
+		case "org-viewer":
+			return usersAPI.OrgRole_VIEWER, nil
+		case "org-trader":
+			return usersAPI.OrgRole_TRADER, nil
+		}
+		return 0, status.Error(codes.PermissionDenied, "not a member")
+	}
+
+	interceptor := AuthzUnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return AccountFromContext(ctx) }
+	call := func(ctx context.Context, method string) (interface{}, codes.Code) {
+		acn, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return acn, status.Code(err)
+	}
+
+	const (
+		trade = "/ataas.orders.OrdersService/Create"
+		list  = "/ataas.blocks.BlocksService/List"
+		creds = "/ataas.excreds.ExCredsService/New"
+	)
+
+	userCtx := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "acn": "personal"})
+	withAccount := func(account string) context.Context {
+		md, _ := metadata.FromIncomingContext(userCtx)
+		return metadata.NewIncomingContext(userCtx, metadata.Join(md, metadata.Pairs(AccountHeader, account)))
+	}
+
+	//Users own their personal account
+	acn, code := call(userCtx, creds)
+	assert.Equal(t, codes.OK, code)
+	assert.Equal(t, "personal", acn)
+
+	acn, code = call(withAccount("org-viewer"), list)
+	assert.Equal(t, codes.OK, code)
+	assert.Equal(t, "org-viewer", acn)
+
+	_, code = call(withAccount("org-viewer"), trade)
+	assert.Equal(t, codes.PermissionDenied, code)
+
+	acn, code = call(withAccount("org-trader"), trade)
+	assert.Equal(t, codes.OK, code)
+	assert.Equal(t, "org-trader", acn)
+
+	_, code = call(withAccount("org-trader"), creds)
+	assert.Equal(t, codes.PermissionDenied, code)
+
+	//Non-members can't act on the account, even where the method has no minimum role
+	_, code = call(withAccount("other"), trade)
+	assert.Equal(t, codes.PermissionDenied, code)
+	_, code = call(withAccount("other"), list)
+	assert.Equal(t, codes.PermissionDenied, code)
+
+	//API clients act on the account they were issued for and ignore the header
+	clientCtx := tokenContext(t, key, jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "acn": "org-viewer", "cid": "client", "scp": []string{ScopeBlocksRead}})
+	md, _ := metadata.FromIncomingContext(clientCtx)
+	clientCtx = metadata.NewIncomingContext(clientCtx, metadata.Join(md, metadata.Pairs(AccountHeader, "org-trader")))
+	acn, code = call(clientCtx, list)
+	assert.Equal(t, codes.OK, code)
+	assert.Equal(t, "org-viewer", acn)
+}
@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+)
+
+const (
+	//AccountHeader selects which account (personal or organisation) a request acts on
+	AccountHeader = "x-account"
+
+	membershipTTL = 30 * time.Second
+	//membershipCacheSize entries before expired entries are swept
+	membershipCacheSize = 1000
+)
+
+type membershipEntry struct {
+	role    usersAPI.OrgRole
+	expires time.Time
+}
+
+var (
+	_usersSvc   usersAPI.UserServiceClient
+	_usersSvcMu sync.Mutex
+
+	membershipCacheMu sync.Mutex
+	membershipCache   = map[string]membershipEntry{}
+
+	//membershipLookup resolves the role of a user in an account
+	membershipLookup = lookupMembership
+)
+
+func usersSvc() (usersAPI.UserServiceClient, error) {
+	_usersSvcMu.Lock()
+	defer _usersSvcMu.Unlock()
+
+	if _usersSvc == nil {
+		usersEndpoint, envExists := os.LookupEnv("USERS_HOST")
+		if !envExists {
+			usersEndpoint = viper.GetString("grpc.addr")
+		}
+
+		conn, err := grpc.Dial(usersEndpoint, grpc.WithInsecure(), grpc.WithPerRPCCredentials(&ServiceCredentials{}))
+		if err != nil {
+			return nil, err
+		}
+
+		_usersSvc = usersAPI.NewUserServiceClient(conn)
+	}
+
+	return _usersSvc, nil
+}
+
+//lookupMembership asks the users service for the role of the user in the account
+func lookupMembership(ctx context.Context, uid, account string) (usersAPI.OrgRole, error) {
+	svc, err := usersSvc()
+	if err != nil {
+		return 0, err
+	}
+
+	m, err := svc.Membership(ctx, &usersAPI.MembershipRequest{UserId: uid, Account: account})
+	if err != nil {
+		return 0, err
+	}
+
+	return m.Role, nil
+}
+
+//cachedMembership resolves the role of the user in the account, caching results
+//briefly to save a lookup on every call
+func cachedMembership(ctx context.Context, uid, account string) (usersAPI.OrgRole, error) {
+	key := uid + "/" + account
+
+	membershipCacheMu.Lock()
+	entry, ok := membershipCache[key]
+	membershipCacheMu.Unlock()
+
+	if ok && time.Now().Before(entry.expires) {
+		return entry.role, nil
+	}
+
+	role, err := membershipLookup(ctx, uid, account)
+	if err != nil {
+		return 0, err
+	}
+
+	membershipCacheMu.Lock()
+	if len(membershipCache) >= membershipCacheSize {
+		for k, e := range membershipCache {
+			if time.Now().After(e.expires) {
+				delete(membershipCache, k)
+			}
+		}
+	}
+	membershipCache[key] = membershipEntry{role: role, expires: time.Now().Add(membershipTTL)}
+	membershipCacheMu.Unlock()
+
+	return role, nil
+}
+
+//requestedAccount provides the account selected by the account header, if any
+func requestedAccount(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	acn := md.Get(AccountHeader)
+	if len(acn) == 0 {
+		return ""
+	}
+
+	return acn[0]
+}
+
+//MembershipFromContext provides the account the request acts on and the role of
+//the caller in it. Users act on their personal account unless another account is
+//selected. API client tokens always act on the account they were issued for
+func MembershipFromContext(ctx context.Context) (string, usersAPI.OrgRole, error) {
+	claims, err := TokenClaimsFromContext(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	sub, _ := claims["sub"].(string)
+	acn, _ := claims["acn"].(string)
+	if sub == "" || acn == "" {
+		return "", 0, status.Error(codes.Unauthenticated, "missing subject or account")
+	}
+
+	_, client := claims["cid"]
+
+	account := acn
+	if req := requestedAccount(ctx); req != "" && !client {
+		account = req
+	}
+
+	if account == acn && !client {
+		return account, usersAPI.OrgRole_OWNER, nil
+	}
+
+	role, err := cachedMembership(ctx, sub, account)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return "", 0, status.Error(codes.PermissionDenied, "not a member of the account")
+		}
+		return "", 0, fmt.Errorf("membership: %w", err)
+	}
+
+	return account, role, nil
+}
+
+//RequireRole ensures the caller has at least the given role in the account the
+//request acts on, returning the account
+func RequireRole(ctx context.Context, min usersAPI.OrgRole) (string, error) {
+	account, role, err := MembershipFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if role < min {
+		return "", status.Errorf(codes.PermissionDenied, "requires %s role", min)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,131 @@
+package utils
+
+const (
+	ScopeStrategiesRead  = "strategies:read"
+	ScopeStrategiesWrite = "strategies:write"
+	ScopeBacktest        = "backtest"
+	ScopeBlocksRead      = "blocks:read"
+	ScopeBlocksWrite     = "blocks:write"
+	ScopeBlocksTrade     = "blocks:trade"
+	ScopeOrdersRead      = "orders:read"
+	ScopeOrdersTrade     = "orders:trade"
+	ScopeExCredsRead     = "excreds:read"
+	ScopeExCredsWrite    = "excreds:write"
+	ScopeTicksRead       = "ticks:read"
+	ScopeProfileRead     = "profile:read"
+)
+
+var (
+	//Scopes all scopes which can be granted to API clients
+	Scopes = []string{
+		ScopeStrategiesRead,
+		ScopeStrategiesWrite,
+		ScopeBacktest,
+		ScopeBlocksRead,
+		ScopeBlocksWrite,
+		ScopeBlocksTrade,
+		ScopeOrdersRead,
+		ScopeOrdersTrade,
+		ScopeExCredsRead,
+		ScopeExCredsWrite,
+		ScopeTicksRead,
+		ScopeProfileRead,
+	}
+
+	//methodScopes the scopes which allow a scoped token to call each method. Methods
+	//with no scopes may be called by any scoped token, methods not listed can only
+	//be called by unscoped (user) tokens. Calls between services aren't scope limited
+	methodScopes = map[string][]string{
+		"/ataas.strategy.StrategyService/List":     {ScopeStrategiesRead},
+		"/ataas.strategy.StrategyService/History":  {ScopeStrategiesRead},
+		"/ataas.strategy.StrategyService/Get":      {ScopeStrategiesRead, ScopeBacktest},
+		"/ataas.strategy.StrategyService/Create":   {ScopeStrategiesWrite},
+		"/ataas.strategy.StrategyService/Update":   {ScopeStrategiesWrite},
+		"/ataas.strategy.StrategyService/Delete":   {ScopeStrategiesWrite},
+		"/ataas.strategy.StrategyService/BackTest": {ScopeBacktest},
+
+		"/ataas.blocks.BlocksService/List":               {ScopeBlocksRead},
+		"/ataas.blocks.BlocksService/Get":                {ScopeBlocksRead},
+		"/ataas.blocks.BlocksService/PnL":                {ScopeBlocksRead},
+		"/ataas.blocks.BlocksService/AccountPnL":         {ScopeBlocksRead},
+		"/ataas.blocks.BlocksService/PnLSeries":          {ScopeBlocksRead},
+		"/ataas.blocks.BlocksService/New":                {ScopeBlocksWrite},
+		"/ataas.blocks.BlocksService/Update":             {ScopeBlocksWrite},
+		"/ataas.blocks.BlocksService/Delete":             {ScopeBlocksWrite},
+		"/ataas.blocks.BlocksService/ManualAction":       {ScopeBlocksTrade},
+		"/ataas.blocks.BlocksService/ListManualActions":  {ScopeBlocksRead},
+		"/ataas.blocks.BlocksService/DecideManualAction": {ScopeBlocksTrade},
+
+		"/ataas.orders.OrdersService/Get":           {ScopeOrdersRead},
+		"/ataas.orders.OrdersService/ListOpen":      {ScopeOrdersRead},
+		"/ataas.orders.OrdersService/PaperBalances": {ScopeOrdersRead},
+		"/ataas.orders.OrdersService/Create":        {ScopeOrdersTrade},
+		"/ataas.orders.OrdersService/Cancel":        {ScopeOrdersTrade},
+
+		"/ataas.excreds.ExCredsService/List":   {ScopeExCredsRead},
+		"/ataas.excreds.ExCredsService/New":    {ScopeExCredsWrite},
+		"/ataas.excreds.ExCredsService/Delete": {ScopeExCredsWrite},
+		"/ataas.excreds.ExCredsService/Test":   {ScopeExCredsRead},
+
+		"/ataas.ticks.HistoryService/Trades":            {ScopeTicksRead},
+		"/ataas.ticks.HistoryService/TradesRange":       {ScopeTicksRead},
+		"/ataas.ticks.HistoryService/TradesRangeStream": {ScopeTicksRead},
+		"/ataas.ticks.HistoryService/Candles":           {ScopeTicksRead},
+		"/ataas.ticks.HistoryService/Depth":             {ScopeTicksRead},
+		"/ataas.ticks.HistoryService/Markets":           {ScopeTicksRead},
+		"/ataas.ticks.HistoryService/Collected":         {ScopeTicksRead},
+
+		"/ataas.users.UserService/Me": {ScopeProfileRead},
+
+		"/ataas.passport.PassportSevice/RevokeToken": {},
+	}
+)
+
+//IsScope checks if the scope can be granted
+func IsScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+//ScopesFromClaims provides the scopes of a token. Tokens without a scope claim
+//aren't scope limited and return nil
+func ScopesFromClaims(claims map[string]interface{}) []string {
+	scp, ok := claims["scp"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	scopes := make([]string, 0, len(scp))
+	for _, s := range scp {
+		if str, ok := s.(string); ok {
+			scopes = append(scopes, str)
+		}
+	}
+
+	return scopes
+}
+
+//ScopeAllowed checks if a token with the given scopes may call the method
+func ScopeAllowed(method string, scopes []string) bool {
+	allowed, ok := methodScopes[method]
+	if !ok {
+		return false
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, a := range allowed {
+		for _, s := range scopes {
+			if a == s {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeAllowed(t *testing.T) {
+	assert.True(t, ScopeAllowed("/ataas.strategy.StrategyService/List", []string{ScopeStrategiesRead}))
+	assert.False(t, ScopeAllowed("/ataas.strategy.StrategyService/Create", []string{ScopeStrategiesRead}))
+	assert.False(t, ScopeAllowed("/ataas.orders.OrdersService/Create", []string{ScopeBlocksTrade}))
+	assert.True(t, ScopeAllowed("/ataas.passport.PassportSevice/RevokeToken", nil))
+	assert.False(t, ScopeAllowed("/ataas.passport.PassportSevice/CreateAPIClient", Scopes))
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
+)
+
+const (
+	//sessionTTL how long an active session is trusted before passport is asked again
+	sessionTTL = 30 * time.Second
+	//sessionCacheSize entries before expired entries are swept
+	sessionCacheSize = 10000
+)
+
+type sessionEntry struct {
+	active  bool
+	expires time.Time
+}
+
+var (
+	_passportSvc   passportAPI.PassportSeviceClient
+	_passportSvcMu sync.Mutex
+
+	sessionCacheMu sync.Mutex
+	sessionCache   = map[string]sessionEntry{}
+
+	//sessionLookup checks with passport if the session of a token is still active
+	sessionLookup = lookupSession
+)
+
+func passportSvc() (passportAPI.PassportSeviceClient, error) {
+	_passportSvcMu.Lock()
+	defer _passportSvcMu.Unlock()
+
+	if _passportSvc == nil {
+		passportEndpoint, envExists := os.LookupEnv("PASSPORT_HOST")
+		if !envExists {
+			passportEndpoint = viper.GetString("grpc.addr")
+		}
+
+		conn, err := grpc.Dial(passportEndpoint, grpc.WithInsecure(), grpc.WithPerRPCCredentials(&ServiceCredentials{}))
+		if err != nil {
+			return nil, err
+		}
+
+		_passportSvc = passportAPI.NewPassportSeviceClient(conn)
+	}
+
+	return _passportSvc, nil
+}
+
+//lookupSession asks passport if the token is valid and its session hasn't been revoked
+func lookupSession(ctx context.Context, token string) (bool, error) {
+	svc, err := passportSvc()
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := svc.VerifyToken(ctx, &passportAPI.VerifyTokenRequest{Token: token})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Valid && !resp.Revoked, nil
+}
+
+//cachedSessionActive checks the session of the token is still active, caching
+//results briefly to save a lookup on every call. Revoked sessions are remembered
+//until the token expires
+func cachedSessionActive(ctx context.Context, token, jti string, exp time.Time) (bool, error) {
+	sessionCacheMu.Lock()
+	entry, ok := sessionCache[jti]
+	sessionCacheMu.Unlock()
+
+	if ok && time.Now().Before(entry.expires) {
+		return entry.active, nil
+	}
+
+	active, err := sessionLookup(ctx, token)
+	if err != nil {
+		return false, err
+	}
+
+	expires := exp
+	if active && time.Now().Add(sessionTTL).Before(exp) {
+		expires = time.Now().Add(sessionTTL)
+	}
+
+	sessionCacheMu.Lock()
+	if len(sessionCache) >= sessionCacheSize {
+		for k, e := range sessionCache {
+			if time.Now().After(e.expires) {
+				delete(sessionCache, k)
+			}
+		}
+	}
+	sessionCache[jti] = sessionEntry{active: active, expires: expires}
+	sessionCacheMu.Unlock()
+
+	return active, nil
+}
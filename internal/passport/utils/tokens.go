@@ -30,7 +30,7 @@ func ValidateAuthToken(ctx context.Context, token string) (bool, error) {
 		passportEndpoint = viper.GetString("grpc.addr")
 	}
 
-	conn, err := grpc.DialContext(ctx, passportEndpoint, grpc.WithInsecure())
+	conn, err := grpc.DialContext(ctx, passportEndpoint, grpc.WithInsecure(), grpc.WithPerRPCCredentials(&ServiceCredentials{}))
 	if err != nil {
 		return false, err
 	}
@@ -131,12 +131,9 @@ func UserIDFromContext(ctx context.Context) (string, error) {
 	return claims["sub"].(string), nil
 }
 
-//AccountFromContext provides the current account from the auth found in the given context
+//AccountFromContext provides the account the request acts on from the auth found
+//in the given context, checking the caller is a member of it
 func AccountFromContext(ctx context.Context) (string, error) {
-	claims, err := TokenClaimsFromContext(ctx)
-	if err != nil {
-		return "", err
-	}
-
-	return claims["acn"].(string), nil
+	account, _, err := MembershipFromContext(ctx)
+	return account, err
 }
@@ -1,12 +1,26 @@
 package passport
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/duo-labs/webauthn/protocol"
 	"github.com/duo-labs/webauthn/webauthn"
+	"github.com/go-redis/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
 	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
 )
 
+const (
+	webAuthnSessionTTL = 5 * time.Minute
+)
+
 func webAuthn() (*webauthn.WebAuthn, error) {
 	config := &webauthn.Config{
 		RPDisplayName: "Atass",
@@ -43,18 +57,252 @@ func (wau *webauthnUser) WebAuthnIcon() string {
 
 // Credentials owned by the user
 func (wau *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
-	fido := wau.u.Mfa.GetFIDO()
-
-	cred := webauthn.Credential{
-		ID:              fido.Id,
-		PublicKey:       fido.Pk,
-		AttestationType: fido.AttestationType,
-		Authenticator: webauthn.Authenticator{
-			AAGUID:       fido.Authenticator.AAGUID,
-			SignCount:    fido.Authenticator.SignCount,
-			CloneWarning: fido.Authenticator.CloneWarning,
+	keys := fidoKeys(wau.u.Mfa)
+	creds := make([]webauthn.Credential, 0, len(keys))
+
+	for _, fido := range keys {
+		cred := webauthn.Credential{
+			ID:              fido.Id,
+			PublicKey:       fido.Pk,
+			AttestationType: fido.AttestationType,
+		}
+		if fido.Authenticator != nil {
+			cred.Authenticator = webauthn.Authenticator{
+				AAGUID:       fido.Authenticator.AAGUID,
+				SignCount:    fido.Authenticator.SignCount,
+				CloneWarning: fido.Authenticator.CloneWarning,
+			}
+		}
+		creds = append(creds, cred)
+	}
+
+	return creds
+}
+
+//fidoKeys all FIDO authenticators registered in the MFA
+func fidoKeys(mfa *usersAPI.MFA) []*usersAPI.MFAFIDO {
+	if keys := mfa.GetFIDOKeys(); keys != nil {
+		return keys.Keys
+	}
+	if key := mfa.GetFIDO(); key != nil {
+		return []*usersAPI.MFAFIDO{key}
+	}
+	return nil
+}
+
+func webAuthnSessionKey(purpose string, uid string) string {
+	return fmt.Sprintf("webAuthn.%s.%s", purpose, uid)
+}
+
+//storeWebAuthnSession keeps the ceremony session data until the response is received
+func (s *Server) storeWebAuthnSession(ctx context.Context, key string, sessData *webauthn.SessionData) error {
+	data, err := json.Marshal(sessData)
+	if err != nil {
+		return err
+	}
+
+	cache, err := s.limiter.cache(ctx)
+	if err != nil {
+		return err
+	}
+
+	return cache.Set(key, data, webAuthnSessionTTL).Err()
+}
+
+//takeWebAuthnSession retrieves and removes ceremony session data so each
+//challenge can only be answered once
+func (s *Server) takeWebAuthnSession(ctx context.Context, key string) (*webauthn.SessionData, error) {
+	cache, err := s.limiter.cache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := cache.Get(key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cache.Del(key)
+
+	sessData := &webauthn.SessionData{}
+	if err := json.Unmarshal(data, sessData); err != nil {
+		return nil, err
+	}
+
+	return sessData, nil
+}
+
+//validateFIDO finishes the login ceremony started by mfaChallenge, updating
+//the sign count of the authenticator used
+func (s *Server) validateFIDO(ctx context.Context, user *usersAPI.User, response string) (bool, error) {
+	sessData, err := s.takeWebAuthnSession(ctx, webAuthnSessionKey("session", user.Id))
+	if err != nil || sessData == nil {
+		return false, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(response))
+	if err != nil {
+		return false, nil
+	}
+
+	watn, err := webAuthn()
+	if err != nil {
+		return false, err
+	}
+
+	cred, err := watn.ValidateLogin(&webauthnUser{user}, *sessData, parsed)
+	if err != nil {
+		s.log.WithError(err).WithField("user", user.Id).Warn("FIDO assertion failed")
+		return false, nil
+	}
+
+	var key *usersAPI.MFAFIDO
+	for _, k := range fidoKeys(user.Mfa) {
+		if bytes.Equal(k.Id, cred.ID) {
+			key = k
+		}
+	}
+	if key == nil {
+		return false, nil
+	}
+
+	if key.Authenticator == nil {
+		key.Authenticator = &usersAPI.FIDOAuthenticator{AAGUID: cred.Authenticator.AAGUID}
+	}
+	key.Authenticator.SignCount = cred.Authenticator.SignCount
+	key.Authenticator.CloneWarning = key.Authenticator.CloneWarning || cred.Authenticator.CloneWarning
+
+	if err := amendUser(ctx, user); err != nil {
+		return false, err
+	}
+
+	//A sign count which didn't increase indicates the key may have been cloned,
+	//refuse the key until it has been re-registered
+	if key.Authenticator.CloneWarning {
+		s.log.WithField("user", user.Id).WithField("key", key.Name).Warn("FIDO authenticator may be cloned")
+		return false, nil
+	}
+
+	return true, nil
+}
+
+//FinishFIDOLogin completes a login using the assertion response to the FIDO
+//challenge returned by Authenticate
+func (s *Server) FinishFIDOLogin(ctx context.Context, req *passportAPI.FIDOLoginRequest) (*passportAPI.AuthResponse, error) {
+	if req.Username == "" || req.Response == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	return s.Authenticate(ctx, &passportAPI.AuthRequest{Creds: &passportAPI.AuthRequest_UserCreds{UserCreds: &passportAPI.UserCreds{
+		Username: req.Username,
+		MFA:      req.Response,
+	}}})
+}
+
+//BeginFIDORegistration starts the registration ceremony of a new FIDO authenticator
+//for the user
+func (s *Server) BeginFIDORegistration(ctx context.Context, _ *passportAPI.Empty) (*passportAPI.FIDOChallenge, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Mfa != nil && fidoKeys(user.Mfa) == nil {
+		return nil, status.Error(codes.FailedPrecondition, "another MFA type is already enrolled")
+	}
+
+	watn, err := webAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	webAuthnUser := &webauthnUser{user}
+
+	//Prevent registering the same authenticator twice
+	exclude := []protocol.CredentialDescriptor{}
+	for _, cred := range webAuthnUser.WebAuthnCredentials() {
+		exclude = append(exclude, protocol.CredentialDescriptor{Type: protocol.PublicKeyCredentialType, CredentialID: cred.ID})
+	}
+
+	creation, sessData, err := watn.BeginRegistration(webAuthnUser, webauthn.WithExclusions(exclude))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storeWebAuthnSession(ctx, webAuthnSessionKey("register", user.Id), sessData); err != nil {
+		return nil, err
+	}
+
+	creationData, err := json.Marshal(creation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passportAPI.FIDOChallenge{
+		Challenge: string(creationData),
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+//FinishFIDORegistration validates the attestation response and adds the
+//authenticator to the users FIDO keys
+func (s *Server) FinishFIDORegistration(ctx context.Context, req *passportAPI.FIDORegistration) (*passportAPI.Empty, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessData, err := s.takeWebAuthnSession(ctx, webAuthnSessionKey("register", user.Id))
+	if err != nil {
+		return nil, err
+	}
+	if sessData == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no pending FIDO registration")
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(req.Response))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid registration response")
+	}
+
+	watn, err := webAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := watn.CreateCredential(&webauthnUser{user}, *sessData, parsed)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "registration failed: %s", err)
+	}
+
+	if user.Mfa != nil && fidoKeys(user.Mfa) == nil {
+		return nil, status.Error(codes.FailedPrecondition, "another MFA type is already enrolled")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = fmt.Sprintf("Security key %d", len(fidoKeys(user.Mfa))+1)
+	}
+
+	keys := append(fidoKeys(user.Mfa), &usersAPI.MFAFIDO{
+		Id:              cred.ID,
+		Pk:              cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		Name:            name,
+		Authenticator: &usersAPI.FIDOAuthenticator{
+			AAGUID:    cred.Authenticator.AAGUID,
+			SignCount: cred.Authenticator.SignCount,
 		},
+	})
+
+	user.Mfa = &usersAPI.MFA{MFA: &usersAPI.MFA_FIDOKeys{FIDOKeys: &usersAPI.MFAFIDOKeys{Keys: keys}}}
+
+	if err := amendUser(ctx, user); err != nil {
+		return nil, err
 	}
 
-	return []webauthn.Credential{cred}
+	auditMFAChanged(ctx, user, "fido key registered")
+
+	return &passportAPI.Empty{}, nil
 }
@@ -0,0 +1,29 @@
+package passport
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+)
+
+func TestFIDOKeys(t *testing.T) {
+	assert.Nil(t, fidoKeys(nil))
+	assert.Nil(t, fidoKeys(&usersAPI.MFA{MFA: &usersAPI.MFA_TOTP{TOTP: &usersAPI.MFATOTP{}}}))
+
+	legacy := &usersAPI.MFAFIDO{Id: []byte("a")}
+	assert.Equal(t, []*usersAPI.MFAFIDO{legacy}, fidoKeys(&usersAPI.MFA{MFA: &usersAPI.MFA_FIDO{FIDO: legacy}}))
+
+	keys := []*usersAPI.MFAFIDO{
+		{Id: []byte("a"), Pk: []byte("pk-a"), Authenticator: &usersAPI.FIDOAuthenticator{SignCount: 3}},
+		{Id: []byte("b"), Pk: []byte("pk-b")},
+	}
+	user := &usersAPI.User{Id: "user", Mfa: &usersAPI.MFA{MFA: &usersAPI.MFA_FIDOKeys{FIDOKeys: &usersAPI.MFAFIDOKeys{Keys: keys}}}}
+
+	creds := (&webauthnUser{user}).WebAuthnCredentials()
+	if assert.Len(t, creds, 2) {
+		assert.Equal(t, []byte("a"), creds[0].ID)
+		assert.Equal(t, uint32(3), creds[0].Authenticator.SignCount)
+		assert.Equal(t, []byte("pk-b"), creds[1].PublicKey)
+	}
+}
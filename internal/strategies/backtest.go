@@ -16,6 +16,11 @@ import (
 	"pm.tcfw.com.au/source/ataas/internal/strategies/runtimes/js"
 )
 
+const (
+	//backtestFeeRate simulated taker commission charged on each backtest order
+	backtestFeeRate = 0.001
+)
+
 func (s *Server) BackTest(ctx context.Context, req *strategy.BacktestRequest) (*strategy.BacktestResponse, error) {
 	t, err := ticksSvc()
 	if err != nil {
@@ -154,12 +159,7 @@ func (s *Server) BackTest(ctx context.Context, req *strategy.BacktestRequest) (*
 	}
 
 	if len(orders) > 0 && orders[len(orders)-1].Action == 0 {
-		orders = append(orders, &ordersAPI.Order{
-			Action:    ordersAPI.Action_SELL,
-			Price:     marketPrice,
-			Units:     block.BaseUnits,
-			Timestamp: nextLook.Format(time.RFC3339),
-		})
+		orders = append(orders, backtestOrder(ordersAPI.Action_SELL, marketPrice, block.BaseUnits, nextLook))
 	}
 
 	var purOrder *ordersAPI.Order
@@ -167,11 +167,11 @@ func (s *Server) BackTest(ctx context.Context, req *strategy.BacktestRequest) (*
 	var fees float32
 
 	for _, order := range orders {
-		fees += order.Price * float32(order.Units) * 0.001
+		fees += float32(order.Fees)
 		if order.Action == ordersAPI.Action_BUY {
 			purOrder = order
 		} else {
-			pnl += (purOrder.Price - order.Price) * float32(order.Units)
+			pnl += (purOrder.AvgPrice - order.AvgPrice) * float32(order.FilledUnits)
 		}
 	}
 
@@ -196,25 +196,29 @@ func (s *Server) backtestChange(ctx context.Context, block *blocksAPI.Block, ns
 		block.State = ns
 		switch ns {
 		case blocksAPI.BlockState_PURCHASED:
-			return &ordersAPI.Order{
-				Action:    ordersAPI.Action_BUY,
-				Price:     marketPrice,
-				Units:     block.BaseUnits,
-				Timestamp: ts.Format(time.RFC3339),
-			}, nil
+			return backtestOrder(ordersAPI.Action_BUY, marketPrice, block.BaseUnits, ts), nil
 		case blocksAPI.BlockState_SOLD:
-			return &ordersAPI.Order{
-				Action:    ordersAPI.Action_SELL,
-				Price:     marketPrice,
-				Units:     block.BaseUnits,
-				Timestamp: ts.Format(time.RFC3339),
-			}, nil
+			return backtestOrder(ordersAPI.Action_SELL, marketPrice, block.BaseUnits, ts), nil
 		}
 	}
 
 	return nil, nil
 }
 
+//backtestOrder creates a simulated filled order with fees charged in the quote asset
+func backtestOrder(action ordersAPI.Action, price float32, units float64, ts time.Time) *ordersAPI.Order {
+	return &ordersAPI.Order{
+		Action:      action,
+		Price:       price,
+		Units:       units,
+		Timestamp:   ts.Format(time.RFC3339),
+		Status:      ordersAPI.OrderStatus_FILLED,
+		FilledUnits: units,
+		AvgPrice:    price,
+		Fees:        float64(price) * units * backtestFeeRate,
+	}
+}
+
 func limitedJSRuntime(t []*ticks.Trade, p map[string]string) (strategy.Action, error) {
 	ts := t[len(t)-1].Timestamp
 	if ts > 9999999999 {
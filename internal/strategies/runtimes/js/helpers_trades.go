@@ -40,6 +40,31 @@ func (lgt *LimitedGetTrades) GetTrades(exchange, symbol, duration string) []*tic
 	return trades.Data
 }
 
+//GetDepth provides the order book snapshot at the end of the limited period
+func (lgt *LimitedGetTrades) GetDepth(exchange, symbol string, levels int) *ticksAPI.Depth {
+	svc, err := tradesClient()
+	if err != nil {
+		panic(err)
+	}
+
+	depth, err := svc.Depth(context.Background(), &ticksAPI.DepthRequest{
+		Market:     exchange,
+		Instrument: symbol,
+		Since:      "1m",
+		Until:      lgt.Until.Format(time.RFC3339),
+		Levels:     int32(levels),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if len(depth.Data) == 0 {
+		return nil
+	}
+
+	return depth.Data[len(depth.Data)-1]
+}
+
 func GetTrades(exchange, symbol, duration string) []*ticksAPI.Trade {
 	svc, err := tradesClient()
 	if err != nil {
@@ -78,6 +103,26 @@ func GetCandles(exchange, symbol, duration string, depth int) []*ticksAPI.OHLCV
 	return trades.Data
 }
 
+//GetDepth provides the latest order book snapshot including the spread, mid price
+//and bid/ask imbalance of the top levels
+func GetDepth(exchange, symbol string, levels int) *ticksAPI.Depth {
+	svc, err := tradesClient()
+	if err != nil {
+		panic(err)
+	}
+
+	depth, err := svc.Depth(context.TODO(), &ticksAPI.DepthRequest{
+		Market:     exchange,
+		Instrument: symbol,
+		Levels:     int32(levels),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return depth.Data[0]
+}
+
 func tradesClient() (ticksAPI.HistoryServiceClient, error) {
 	ticksEndpoint, envExists := os.LookupEnv("TICKS_HOST")
 	if !envExists {
@@ -130,3 +175,16 @@ func GetTestCandles(call goja.FunctionCall) goja.Value {
 
 	return v
 }
+
+func GetTestDepth(call goja.FunctionCall) goja.Value {
+	v := goja.New().ToValue(&ticksAPI.Depth{
+		Bids:      []*ticksAPI.PriceLevel{{Price: 448.9, Quantity: 3}, {Price: 448.8, Quantity: 5}},
+		Asks:      []*ticksAPI.PriceLevel{{Price: 449.1, Quantity: 1}, {Price: 449.3, Quantity: 1}},
+		Timestamp: 1,
+		Spread:    0.2,
+		Mid:       449,
+		Imbalance: 0.6,
+	})
+
+	return v
+}
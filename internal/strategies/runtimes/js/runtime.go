@@ -88,6 +88,10 @@ func (jsr *JSRuntime) initParams() error {
 		if err != nil {
 			return err
 		}
+		err = jsr.vm.Set("GetDepth", GetTestDepth)
+		if err != nil {
+			return err
+		}
 	} else {
 		err := jsr.vm.Set("GetTrades", GetTrades)
 		if err != nil {
@@ -98,6 +102,11 @@ func (jsr *JSRuntime) initParams() error {
 		if err != nil {
 			return err
 		}
+
+		err = jsr.vm.Set("GetDepth", GetDepth)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := jsr.initMath(); err != nil {
@@ -112,6 +121,10 @@ func (jsr *JSRuntime) initParams() error {
 }
 
 func (jsr *JSRuntime) SetLimitedTrades(lgt *LimitedGetTrades) error {
+	if err := jsr.vm.Set("GetDepth", lgt.GetDepth); err != nil {
+		return err
+	}
+
 	return jsr.vm.Set("GetTrades", lgt.GetTrades)
 }
 
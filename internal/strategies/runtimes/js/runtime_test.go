@@ -123,3 +123,34 @@ func TestCandles(t *testing.T) {
 	assert.Equal(t, v, strategy.Action_BUY)
 	assert.NotEmpty(t, jsr.logs)
 }
+
+func TestDepth(t *testing.T) {
+	jsr := &JSRuntime{
+		enableTestSuite: true,
+	}
+	err := jsr.Init(
+		[]byte(`
+			let book = GetDepth('binance.com', 'ADAAUD', 10);
+
+			console.log(book.Spread, book.Imbalance)
+
+			if (book.Imbalance > 0.5 && book.Spread/book.Mid < 0.001) {
+				return BUY;
+			}
+
+			return STAY;
+		`),
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := jsr.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, v, strategy.Action_BUY)
+	assert.NotEmpty(t, jsr.logs)
+}
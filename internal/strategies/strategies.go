@@ -14,6 +14,7 @@ import (
 	"pm.tcfw.com.au/source/ataas/db"
 	passportUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
 	migrate "pm.tcfw.com.au/source/ataas/internal/strategies/db"
+	ticksClient "pm.tcfw.com.au/source/ataas/internal/ticks/client"
 )
 
 const (
@@ -161,6 +162,15 @@ func (s *Server) Create(ctx context.Context, req *strategy.CreateRequest) (*stra
 		return nil, status.Error(codes.FailedPrecondition, "bad request: strategy required")
 	}
 
+	ticks, err := ticksSvc()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ticksClient.RequireCollected(ctx, ticks, req.Strategy.Market, req.Strategy.Instrument); err != nil {
+		return nil, err
+	}
+
 	if req.Strategy.Duration < 1000000000 {
 		req.Strategy.Duration *= 1000000000
 	}
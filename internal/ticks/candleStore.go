@@ -0,0 +1,313 @@
+package ticks
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/sirupsen/logrus"
+
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/db"
+)
+
+const (
+	candlesTblName = "candles"
+
+	//candleBatchSize max candles upserted per statement
+	candleBatchSize = 500
+)
+
+var (
+	//candleResolutions resolutions built as trades are collected
+	candleResolutions = []time.Duration{time.Minute, 5 * time.Minute, time.Hour, 24 * time.Hour}
+)
+
+type candleKey struct {
+	market     string
+	instrument string
+	resolution time.Duration
+}
+
+type pendingCandle struct {
+	key    candleKey
+	candle ticks.OHLCV
+}
+
+//CandleStore builds candles of each resolution incrementally from trades. Candles are
+//held in memory until flushed to the database, including the currently open candles
+type CandleStore struct {
+	open    map[candleKey]*ticks.OHLCV
+	dirty   map[candleKey]bool
+	pending []*pendingCandle
+
+	log *logrus.Logger
+	mu  sync.Mutex
+}
+
+func NewCandleStore(log *logrus.Logger) *CandleStore {
+	return &CandleStore{
+		open:  map[candleKey]*ticks.OHLCV{},
+		dirty: map[candleKey]bool{},
+		log:   log,
+	}
+}
+
+//Add applies a trade to the open candle of each resolution, closing candles when the
+//trade falls into a later period. Trades older than the open candle are ignored
+func (cs *CandleStore) Add(trade *ticks.Trade) {
+	tts := trade.Timestamp
+	if tts > 9999999999 {
+		tts = tts / 1000
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	for _, res := range candleResolutions {
+		key := candleKey{trade.Market, trade.Instrument, res}
+		ts := time.Unix(tts, 0).Truncate(res).Unix()
+
+		current, ok := cs.open[key]
+		if ok && ts < current.Timestamp {
+			continue
+		}
+
+		if !ok || ts > current.Timestamp {
+			if ok {
+				cs.pending = append(cs.pending, &pendingCandle{key, *current})
+			}
+
+			current = &ticks.OHLCV{
+				Open:      trade.Amount,
+				High:      trade.Amount,
+				Low:       trade.Amount,
+				Timestamp: ts,
+			}
+			cs.open[key] = current
+		}
+
+		if trade.Amount > current.High {
+			current.High = trade.Amount
+		}
+		if trade.Amount < current.Low {
+			current.Low = trade.Amount
+		}
+		current.Close = trade.Amount
+		current.Volume += trade.Units
+
+		cs.dirty[key] = true
+	}
+}
+
+//unflushed provides copies of the closed and changed open candles not yet flushed
+func (cs *CandleStore) unflushed() []*pendingCandle {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	candles := make([]*pendingCandle, 0, len(cs.pending)+len(cs.dirty))
+	candles = append(candles, cs.pending...)
+
+	for key := range cs.dirty {
+		candles = append(candles, &pendingCandle{key, *cs.open[key]})
+	}
+
+	return candles
+}
+
+//Flush persists the closed candles and the changed open candles
+func (cs *CandleStore) Flush(ctx context.Context) error {
+	cs.mu.Lock()
+	candles := cs.pending
+	for key := range cs.dirty {
+		candles = append(candles, &pendingCandle{key, *cs.open[key]})
+	}
+	cs.pending = nil
+	cs.dirty = map[candleKey]bool{}
+	cs.mu.Unlock()
+
+	candles = dedupeCandles(candles)
+
+	for len(candles) > 0 {
+		n := len(candles)
+		if n > candleBatchSize {
+			n = candleBatchSize
+		}
+
+		q := db.Build().Insert(candlesTblName).
+			Columns("market", "instrument", "resolution", "ts", "open", "high", "low", "close", "volume").
+			Suffix("ON CONFLICT (market, instrument, resolution, ts) DO UPDATE SET " +
+				"open = excluded.open, high = excluded.high, low = excluded.low, close = excluded.close, volume = excluded.volume")
+
+		for _, c := range candles[:n] {
+			q = q.Values(
+				c.key.market,
+				c.key.instrument,
+				int64(c.key.resolution/time.Second),
+				time.Unix(c.candle.Timestamp, 0),
+				c.candle.Open,
+				c.candle.High,
+				c.candle.Low,
+				c.candle.Close,
+				c.candle.Volume,
+			)
+		}
+
+		if err := db.SimpleExec(ctx, q); err != nil {
+			//requeue to retry on the next flush
+			cs.mu.Lock()
+			cs.pending = append(candles, cs.pending...)
+			cs.mu.Unlock()
+			return err
+		}
+
+		candles = candles[n:]
+	}
+
+	return nil
+}
+
+//dedupeCandles keeps the last of any candles of the same period as a row can only be
+//upserted once per statement
+func dedupeCandles(candles []*pendingCandle) []*pendingCandle {
+	type period struct {
+		key candleKey
+		ts  int64
+	}
+
+	idx := make(map[period]int, len(candles))
+	deduped := make([]*pendingCandle, 0, len(candles))
+
+	for _, c := range candles {
+		p := period{c.key, c.candle.Timestamp}
+		if i, ok := idx[p]; ok {
+			deduped[i] = c
+			continue
+		}
+
+		idx[p] = len(deduped)
+		deduped = append(deduped, c)
+	}
+
+	return deduped
+}
+
+//run flushes candles periodically until the context is cancelled
+func (cs *CandleStore) run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := cs.Flush(context.Background()); err != nil {
+				cs.log.Errorf("failed to flush candles: %s", err)
+			}
+			return
+		case <-t.C:
+			if err := cs.Flush(ctx); err != nil {
+				cs.log.Errorf("failed to flush candles: %s", err)
+			}
+		}
+	}
+}
+
+//Get provides the candles of a resolution between since and until, including
+//candles not yet flushed
+func (cs *CandleStore) Get(ctx context.Context, market, instrument string, resolution time.Duration, since, until time.Time) ([]*ticks.OHLCV, error) {
+	q := db.Build().Select("ts", "open", "high", "low", "close", "volume").
+		From(candlesTblName).
+		Where(sq.Eq{"market": market, "instrument": instrument, "resolution": int64(resolution / time.Second)}).
+		Where(sq.GtOrEq{"ts": since}).
+		Where(sq.LtOrEq{"ts": until}).
+		OrderBy("ts ASC")
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	byTs := map[int64]*ticks.OHLCV{}
+
+	for res.Next() {
+		c := &ticks.OHLCV{}
+		var ts time.Time
+
+		if err := res.Scan(&ts, &c.Open, &c.High, &c.Low, &c.Close, &c.Volume); err != nil {
+			return nil, err
+		}
+
+		c.Timestamp = ts.Unix()
+		byTs[c.Timestamp] = c
+	}
+
+	for _, p := range cs.unflushed() {
+		if p.key.market != market || p.key.instrument != instrument || p.key.resolution != resolution {
+			continue
+		}
+		if p.candle.Timestamp < since.Unix() || p.candle.Timestamp > until.Unix() {
+			continue
+		}
+
+		c := p.candle
+		byTs[c.Timestamp] = &c
+	}
+
+	candles := make([]*ticks.OHLCV, 0, len(byTs))
+	for _, c := range byTs {
+		candles = append(candles, c)
+	}
+
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Timestamp < candles[j].Timestamp })
+
+	return candles, nil
+}
+
+//storedResolution the largest stored resolution the interval is a multiple of, 0 if
+//the interval cannot be built from stored candles
+func storedResolution(interval time.Duration) time.Duration {
+	for i := len(candleResolutions) - 1; i >= 0; i-- {
+		res := candleResolutions[i]
+		if interval >= res && interval%res == 0 {
+			return res
+		}
+	}
+
+	return 0
+}
+
+//aggregateCandles combines ordered candles into candles of a larger interval
+func aggregateCandles(candles []*ticks.OHLCV, interval time.Duration) []*ticks.OHLCV {
+	data := []*ticks.OHLCV{}
+
+	var current *ticks.OHLCV
+
+	for _, c := range candles {
+		ts := time.Unix(c.Timestamp, 0).Truncate(interval).Unix()
+
+		if current == nil || ts != current.Timestamp {
+			current = &ticks.OHLCV{
+				Open:      c.Open,
+				High:      0,
+				Low:       math.MaxFloat32,
+				Timestamp: ts,
+			}
+			data = append(data, current)
+		}
+
+		if c.High > current.High {
+			current.High = c.High
+		}
+		if c.Low < current.Low {
+			current.Low = c.Low
+		}
+		current.Close = c.Close
+		current.Volume += c.Volume
+	}
+
+	return data
+}
@@ -0,0 +1,105 @@
+package ticks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+func TestCandleStoreAdd(t *testing.T) {
+	cs := NewCandleStore(logrus.New())
+
+	start := time.Date(2021, 7, 13, 10, 0, 0, 0, time.UTC)
+
+	add := func(offset time.Duration, price, units float32) {
+		cs.Add(&ticks.Trade{
+			Market:     "binance.com",
+			Instrument: "BTCAUD",
+			Amount:     price,
+			Units:      units,
+			Timestamp:  start.Add(offset).UnixNano() / int64(time.Millisecond),
+		})
+	}
+
+	add(0, 10, 1)
+	add(10*time.Second, 12, 1)
+	add(20*time.Second, 9, 1)
+	add(70*time.Second, 11, 2)
+
+	//older than the open minute candle but still within the open hour candle
+	add(30*time.Second, 100, 1)
+
+	minutes := map[int64]ticks.OHLCV{}
+	for _, p := range cs.unflushed() {
+		if p.key.resolution == time.Minute {
+			minutes[p.candle.Timestamp] = p.candle
+		}
+	}
+
+	require.Len(t, minutes, 2)
+
+	first := minutes[start.Unix()]
+	assert.Equal(t, float32(10), first.Open)
+	assert.Equal(t, float32(12), first.High)
+	assert.Equal(t, float32(9), first.Low)
+	assert.Equal(t, float32(9), first.Close)
+	assert.Equal(t, float32(3), first.Volume)
+
+	second := minutes[start.Add(time.Minute).Unix()]
+	assert.Equal(t, float32(11), second.Open)
+	assert.Equal(t, float32(2), second.Volume)
+
+	hour := cs.open[candleKey{"binance.com", "BTCAUD", time.Hour}]
+	require.NotNil(t, hour)
+	assert.Equal(t, float32(100), hour.High)
+	assert.Equal(t, float32(6), hour.Volume)
+}
+
+func TestAggregateCandles(t *testing.T) {
+	start := time.Date(2021, 7, 13, 10, 0, 0, 0, time.UTC)
+
+	candles := []*ticks.OHLCV{}
+	for i := 0; i < 6; i++ {
+		candles = append(candles, &ticks.OHLCV{
+			Open:      float32(i),
+			High:      float32(i + 2),
+			Low:       float32(i),
+			Close:     float32(i + 1),
+			Volume:    1,
+			Timestamp: start.Add(time.Duration(i) * 5 * time.Minute).Unix(),
+		})
+	}
+
+	agg := aggregateCandles(candles, 15*time.Minute)
+	require.Len(t, agg, 2)
+	assert.Equal(t, float32(0), agg[0].Open)
+	assert.Equal(t, float32(4), agg[0].High)
+	assert.Equal(t, float32(3), agg[0].Close)
+	assert.Equal(t, float32(3), agg[0].Volume)
+	assert.Equal(t, float32(3), agg[1].Low)
+}
+
+func TestStoredResolution(t *testing.T) {
+	assert.Equal(t, time.Minute, storedResolution(time.Minute))
+	assert.Equal(t, 5*time.Minute, storedResolution(15*time.Minute))
+	assert.Equal(t, time.Hour, storedResolution(4*time.Hour))
+	assert.Equal(t, 24*time.Hour, storedResolution(7*24*time.Hour))
+	assert.Equal(t, time.Duration(0), storedResolution(30*time.Second))
+}
+
+func TestDedupeCandles(t *testing.T) {
+	key := candleKey{"binance.com", "BTCAUD", time.Minute}
+
+	deduped := dedupeCandles([]*pendingCandle{
+		{key, ticks.OHLCV{Timestamp: 60, Close: 1}},
+		{key, ticks.OHLCV{Timestamp: 120, Close: 2}},
+		{key, ticks.OHLCV{Timestamp: 60, Close: 3}},
+	})
+
+	require.Len(t, deduped, 2)
+	assert.Equal(t, float32(3), deduped[0].candle.Close)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ticksAPI "pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+//RequireCollected ensures trades for the instrument are being collected. Instruments
+//are matched regardless of case
+func RequireCollected(ctx context.Context, svc ticksAPI.HistoryServiceClient, market, instrument string) error {
+	instrument = strings.ToUpper(instrument)
+
+	resp, err := svc.Collected(ctx, &ticksAPI.CollectedRequest{Market: market, Instrument: instrument})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Instruments) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "instrument %s is not collected on %s", instrument, market)
+	}
+
+	return nil
+}
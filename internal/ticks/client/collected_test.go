@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ticksAPI "pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+type fakeHistory struct {
+	ticksAPI.HistoryServiceClient
+
+	collected map[string]bool
+	requested string
+}
+
+func (f *fakeHistory) Collected(ctx context.Context, req *ticksAPI.CollectedRequest, opts ...grpc.CallOption) (*ticksAPI.CollectedResponse, error) {
+	f.requested = req.Instrument
+
+	resp := &ticksAPI.CollectedResponse{}
+	if f.collected[req.Instrument] {
+		resp.Instruments = append(resp.Instruments, &ticksAPI.CollectedInstrument{Market: req.Market, Instrument: req.Instrument})
+	}
+
+	return resp, nil
+}
+
+func TestRequireCollected(t *testing.T) {
+	svc := &fakeHistory{collected: map[string]bool{"BTCAUD": true}}
+
+	assert.NoError(t, RequireCollected(context.Background(), svc, "binance.com", "btcaud"))
+	assert.Equal(t, "BTCAUD", svc.requested)
+
+	err := RequireCollected(context.Background(), svc, "binance.com", "ethaud")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,233 @@
+package ticks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/broadcast"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+const (
+	collectedTblName = "collected_instruments"
+
+	//collectedChangedTopic notifies collectors to reload the instruments of a market
+	collectedChangedTopic = "COLLECTOR.changed"
+)
+
+//Collected lists the instruments being collected. When an instrument is given, markets
+//collecting all instruments are also matched
+func (s *Server) Collected(ctx context.Context, req *ticks.CollectedRequest) (*ticks.CollectedResponse, error) {
+	q := db.Build().Select("market", "instrument", "created_at", "raw_retention", "compacted_retention").
+		From(collectedTblName).
+		OrderBy("market", "instrument")
+
+	if req.Market != "" {
+		q = q.Where(sq.Eq{"market": exchanges.PriceMarket(req.Market)})
+	}
+
+	if req.Instrument != "" {
+		q = q.Where(sq.Eq{"instrument": []string{strings.ToUpper(req.Instrument), exchanges.AllInstruments}})
+	}
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	resp := &ticks.CollectedResponse{Instruments: []*ticks.CollectedInstrument{}}
+
+	for res.Next() {
+		ci := &ticks.CollectedInstrument{}
+		var createdAt time.Time
+		var rawRetention, compactedRetention *int64
+
+		if err := res.Scan(&ci.Market, &ci.Instrument, &createdAt, &rawRetention, &compactedRetention); err != nil {
+			return nil, err
+		}
+
+		ci.CreatedAt = createdAt.Format(time.RFC3339)
+		ci.RawRetention = formatRetention(rawRetention)
+		ci.CompactedRetention = formatRetention(compactedRetention)
+		resp.Instruments = append(resp.Instruments, ci)
+	}
+
+	return resp, nil
+}
+
+//AddCollected starts collecting an instrument, or updates the retention of an
+//instrument already being collected
+func (s *Server) AddCollected(ctx context.Context, req *ticks.CollectedInstrument) (*ticks.CollectedInstrument, error) {
+	if req.Market == "" || req.Instrument == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	ex, ok := s.markets[req.Market]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown market")
+	}
+
+	if req.Instrument != exchanges.AllInstruments {
+		if err := validateInstrument(ex, req.Instrument); err != nil {
+			return nil, err
+		}
+	}
+
+	rawRetention, err := parseRetention(req.RawRetention)
+	if err != nil {
+		return nil, err
+	}
+
+	compactedRetention, err := parseRetention(req.CompactedRetention)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now()
+
+	q := db.Build().Insert(collectedTblName).
+		Columns("market", "instrument", "created_at", "raw_retention", "compacted_retention").
+		Values(req.Market, req.Instrument, createdAt, rawRetention, compactedRetention).
+		Suffix("ON CONFLICT (market, instrument) DO UPDATE SET " +
+			"raw_retention = excluded.raw_retention, compacted_retention = excluded.compacted_retention")
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyCollectedChanged(req.Market); err != nil {
+		return nil, err
+	}
+
+	req.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return req, nil
+}
+
+//RemoveCollected stops collecting an instrument
+func (s *Server) RemoveCollected(ctx context.Context, req *ticks.CollectedRequest) (*ticks.RemoveCollectedResponse, error) {
+	if req.Market == "" || req.Instrument == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	q := db.Build().Delete(collectedTblName).Where(sq.Eq{"market": req.Market, "instrument": req.Instrument})
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyCollectedChanged(req.Market); err != nil {
+		return nil, err
+	}
+
+	return &ticks.RemoveCollectedResponse{}, nil
+}
+
+//parseRetention parses a retention duration to seconds, nil for the default
+func parseRetention(retention string) (*int64, error) {
+	if retention == "" {
+		return nil, nil
+	}
+
+	d, err := time.ParseDuration(retention)
+	if err != nil || d <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid retention %q", retention)
+	}
+
+	secs := int64(d / time.Second)
+	return &secs, nil
+}
+
+func formatRetention(secs *int64) string {
+	if secs == nil {
+		return ""
+	}
+
+	return (time.Duration(*secs) * time.Second).String()
+}
+
+//validateInstrument ensures the instrument is a trading symbol of the exchange
+func validateInstrument(ex exchanges.Adapter, instrument string) error {
+	symbols, err := ex.Symbols()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to fetch symbols: %s", err)
+	}
+
+	for _, sym := range symbols {
+		if sym.Instrument == instrument {
+			if !sym.Trading() {
+				return status.Errorf(codes.FailedPrecondition, "instrument is not trading (%s)", sym.Status)
+			}
+			return nil
+		}
+	}
+
+	return status.Error(codes.NotFound, "unknown instrument")
+}
+
+func (s *Server) notifyCollectedChanged(market string) error {
+	br, err := broadcast.Driver()
+	if err != nil {
+		return err
+	}
+
+	return br.Publish(collectedChangedTopic, &ticks.CollectedRequest{Market: market})
+}
+
+//collectedInstruments the instruments to collect for a market
+func (s *Server) collectedInstruments(ctx context.Context, market string) ([]string, error) {
+	resp, err := s.Collected(ctx, &ticks.CollectedRequest{Market: market})
+	if err != nil {
+		return nil, err
+	}
+
+	instruments := make([]string, 0, len(resp.Instruments))
+	for _, ci := range resp.Instruments {
+		instruments = append(instruments, ci.Instrument)
+	}
+
+	return instruments, nil
+}
+
+//applyCollected updates the market's subscriptions to the collected instruments
+func (s *Server) applyCollected(ctx context.Context, market string) error {
+	ex, ok := s.markets[market]
+	if !ok {
+		return nil
+	}
+
+	instruments, err := s.collectedInstruments(ctx, market)
+	if err != nil {
+		return err
+	}
+
+	s.log.Infof("Collecting %d instruments from %s: %s", len(instruments), market, strings.Join(instruments, ","))
+
+	return ex.SetTradeInstruments(instruments)
+}
+
+//watchCollected applies changes to the collected instruments as they are made
+func (s *Server) watchCollected(ctx context.Context) (func() error, error) {
+	br, err := broadcast.Driver()
+	if err != nil {
+		return nil, err
+	}
+
+	return br.Subscribe(collectedChangedTopic, func(req *ticks.CollectedRequest) {
+		if err := s.applyCollected(ctx, req.Market); err != nil {
+			s.log.Errorf("failed to apply collected instruments for %s: %s", req.Market, err)
+		}
+
+		if err := s.applyRetention(ctx); err != nil {
+			s.log.Errorf("failed to apply retention policies: %s", err)
+		}
+	})
+}
@@ -10,23 +10,47 @@ import (
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
 	"pm.tcfw.com.au/source/ataas/db"
 	"pm.tcfw.com.au/source/ataas/internal/broadcast"
-	binance_client "pm.tcfw.com.au/source/ataas/internal/exchanges/binance-client"
-	crypto_com_client "pm.tcfw.com.au/source/ataas/internal/exchanges/crypto-com-client"
 )
 
+func init() {
+	viper.SetDefault("collector.candles.flush", "10s")
+	viper.SetDefault("collector.library_sync", "interval")
+	viper.SetDefault("collector.library_sync_interval", "1s")
+}
+
 func (s *Server) Collect(ctx context.Context) {
 	ch := make(chan *ticks.Trade, 100)
 
 	go s.collectFromCh(ctx, ch)
+	go s.candles.run(ctx, viper.GetDuration("collector.candles.flush"))
+
+	for market := range s.markets {
+		if err := s.applyCollected(ctx, market); err != nil {
+			s.log.Fatalf("Failed to load collected instruments for %s: %s", market, err)
+		}
+	}
+
+	if err := s.applyRetention(ctx); err != nil {
+		s.log.Fatalf("Failed to load retention policies: %s", err)
+	}
+
+	go s.library.maintain(viper.GetDuration("collector.retention.interval"))
+	go s.library.syncActive(viper.GetDuration("collector.library_sync_interval"))
+
+	unsub, err := s.watchCollected(ctx)
+	if err != nil {
+		s.log.Fatalf("Failed to watch collected instruments: %s", err)
+	}
+	defer unsub()
 
 	//crypto.com
-	// go func() {
-	// 	err := s.collectCryptoDotCom(ctx, ch)
-	// 	if err != nil {
-	// 		s.log.Fatalf("Disconnected from crypto.com: %s", err)
-	// 		os.Exit(1)
-	// 	}
-	// }()
+	go func() {
+		err := s.collectCryptoDotCom(ctx, ch)
+		if err != nil {
+			s.log.Fatalf("Disconnected from crypto.com: %s", err)
+			os.Exit(1)
+		}
+	}()
 
 	//binance.com
 	go func() {
@@ -37,6 +61,9 @@ func (s *Server) Collect(ctx context.Context) {
 		}
 	}()
 
+	s.collectDepth(ctx)
+	go s.depth.gc(viper.GetDuration("collector.depth.retention"))
+
 	s.gcTrades()
 }
 
@@ -52,6 +79,8 @@ func (s *Server) collectFromCh(ctx context.Context, ch <-chan *ticks.Trade) {
 		if err := s.library.Add(trade); err != nil {
 			s.log.Errorf("failed to record in library: %s", err)
 		}
+
+		s.candles.Add(trade)
 	}
 }
 
@@ -74,12 +103,7 @@ func (s *Server) gcTrades() {
 }
 
 func (s *Server) collectCryptoDotCom(ctx context.Context, ch chan *ticks.Trade) error {
-	key := viper.GetString("collector.crypto_com.key")
-	secret := viper.GetString("collector.crypto_com.secret")
-
-	c := crypto_com_client.NewClient(key, secret)
-
-	tch, err := c.SubscribeTradesAll()
+	tch, err := s.markets["crypto.com"].SubscribeTradesAll()
 	if err != nil {
 		return err
 	}
@@ -94,12 +118,7 @@ func (s *Server) collectCryptoDotCom(ctx context.Context, ch chan *ticks.Trade)
 }
 
 func (s *Server) collectBinanceDotCom(ctx context.Context, ch chan *ticks.Trade) error {
-	key := viper.GetString("collector.binance.key")
-	secret := viper.GetString("collector.binance.secret")
-
-	c := binance_client.NewClient(key, secret)
-
-	tch, err := c.SubscribeTradesAll()
+	tch, err := s.markets["binance.com"].SubscribeTradesAll()
 	if err != nil {
 		return err
 	}
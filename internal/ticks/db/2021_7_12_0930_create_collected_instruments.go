@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_collected_instruments",
+		time.Date(2021, 7, 12, 9, 30, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS collected_instruments (
+					market STRING NOT NULL,
+					instrument STRING NOT NULL,
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					PRIMARY KEY (market, instrument)
+				);
+
+				INSERT INTO collected_instruments (market, instrument) VALUES
+					('binance.com', 'ADAAUD'),
+					('binance.com', 'BNBAUD'),
+					('binance.com', 'BTCAUD'),
+					('binance.com', 'DOGEAUD'),
+					('binance.com', 'ETHAUD'),
+					('binance.com', 'LINKAUD'),
+					('binance.com', 'SXPAUD'),
+					('binance.com', 'TRXAUD'),
+					('binance.com', 'XRPAUD'),
+					('crypto.com', '*')
+				ON CONFLICT DO NOTHING;
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
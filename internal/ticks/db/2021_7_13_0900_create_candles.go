@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_candles",
+		time.Date(2021, 7, 13, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS candles (
+					market STRING NOT NULL,
+					instrument STRING NOT NULL,
+					resolution INT NOT NULL,
+					ts TIMESTAMPTZ NOT NULL,
+					open FLOAT8 NOT NULL,
+					high FLOAT8 NOT NULL,
+					low FLOAT8 NOT NULL,
+					close FLOAT8 NOT NULL,
+					volume FLOAT8 NOT NULL,
+					PRIMARY KEY (market, instrument, resolution, ts DESC)
+				)
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -0,0 +1,144 @@
+package ticks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/broadcast"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+func init() {
+	viper.SetDefault("collector.depth.levels", 10)
+	viper.SetDefault("collector.depth.interval", "10s")
+	viper.SetDefault("collector.depth.retention", "168h")
+}
+
+//Depth provides order book snapshots of an instrument. Without a since time, the
+//latest snapshot is provided
+func (s *Server) Depth(ctx context.Context, req *ticks.DepthRequest) (*ticks.DepthResponse, error) {
+	if req.Instrument == "" || req.Market == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	market := exchanges.PriceMarket(req.Market)
+
+	if req.Since == "" {
+		latest := s.depth.Latest(market, req.Instrument)
+		if latest == nil {
+			return nil, status.Error(codes.NotFound, "no depth available")
+		}
+
+		return &ticks.DepthResponse{Data: []*ticks.Depth{withDepthStats(latest, int(req.Levels))}}, nil
+	}
+
+	tsFrom, tsUntil, err := parseRange(req.Since, req.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	depths, err := s.depth.GetSince(market, req.Instrument, tsFrom, tsUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, d := range depths {
+		depths[i] = withDepthStats(d, int(req.Levels))
+	}
+
+	return &ticks.DepthResponse{Data: depths}, nil
+}
+
+//withDepthStats copies the snapshot truncated to the top levels with the spread,
+//mid price and imbalance of those levels
+func withDepthStats(d *ticks.Depth, levels int) *ticks.Depth {
+	out := &ticks.Depth{
+		Market:     d.Market,
+		Instrument: d.Instrument,
+		Bids:       d.Bids,
+		Asks:       d.Asks,
+		Timestamp:  d.Timestamp,
+	}
+
+	if levels > 0 && len(out.Bids) > levels {
+		out.Bids = out.Bids[:levels]
+	}
+	if levels > 0 && len(out.Asks) > levels {
+		out.Asks = out.Asks[:levels]
+	}
+
+	snap := &exchanges.BookSnapshot{Bids: toBookLevels(out.Bids), Asks: toBookLevels(out.Asks)}
+	out.Spread = snap.Spread()
+	out.Mid = snap.Mid()
+	out.Imbalance = snap.Imbalance()
+
+	return out
+}
+
+func toBookLevels(levels []*ticks.PriceLevel) []exchanges.PriceLevel {
+	pl := make([]exchanges.PriceLevel, 0, len(levels))
+	for _, l := range levels {
+		pl = append(pl, exchanges.PriceLevel{Price: l.Price, Quantity: l.Quantity})
+	}
+	return pl
+}
+
+func fromBookLevels(levels []exchanges.PriceLevel) []*ticks.PriceLevel {
+	pl := make([]*ticks.PriceLevel, 0, len(levels))
+	for _, l := range levels {
+		pl = append(pl, &ticks.PriceLevel{Price: l.Price, Quantity: l.Quantity})
+	}
+	return pl
+}
+
+//collectDepth records order book snapshots from markets supporting depth data
+func (s *Server) collectDepth(ctx context.Context) {
+	levels := viper.GetInt("collector.depth.levels")
+
+	br, err := broadcast.Driver()
+	if err != nil {
+		panic(err)
+	}
+
+	for name, market := range s.markets {
+		dd, ok := market.(exchanges.DepthData)
+		if !ok {
+			continue
+		}
+
+		ch, err := dd.SubscribeDepthAll(levels)
+		if err != nil {
+			s.log.Errorf("failed to subscribe to %s depth: %s", name, err)
+			continue
+		}
+
+		s.log.Infof("Collecting depth from %s", name)
+
+		go func(ch <-chan *exchanges.BookSnapshot) {
+			for snap := range ch {
+				d := &ticks.Depth{
+					Market:     snap.Market,
+					Instrument: snap.Instrument,
+					Bids:       fromBookLevels(snap.Bids),
+					Asks:       fromBookLevels(snap.Asks),
+					Timestamp:  snap.Timestamp,
+				}
+
+				stored, err := s.depth.Add(d)
+				if err != nil {
+					s.log.Errorf("failed to record depth: %s", err)
+					continue
+				}
+
+				if stored {
+					br.Publish(fmt.Sprintf("DEPTH.%s.%s", d.Market, d.Instrument), withDepthStats(d, 0))
+				}
+			}
+		}(ch)
+	}
+}
@@ -0,0 +1,281 @@
+package ticks
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/vmihailenco/msgpack/v5"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+const (
+	depthFilePrefix = "depth_"
+
+	//depthFileSpan period covered by each depth file
+	depthFileSpan = time.Hour
+
+	//maxDepthRecordSize sanity limit of an encoded snapshot
+	maxDepthRecordSize = 1 << 20
+)
+
+//DepthLibrary stores order book snapshots in hourly files. The latest snapshot of
+//each instrument is kept in memory and persisted at most once per interval
+type DepthLibrary struct {
+	dir      string
+	interval time.Duration
+
+	latest     map[string]*ticks.Depth
+	lastStored map[string]time.Time
+
+	active      *os.File
+	activeStart time.Time
+
+	log *logrus.Logger
+	mu  sync.Mutex
+}
+
+func NewDepthLibrary(dir string, interval time.Duration, log *logrus.Logger) (*DepthLibrary, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &DepthLibrary{
+		dir:        dir,
+		interval:   interval,
+		latest:     map[string]*ticks.Depth{},
+		lastStored: map[string]time.Time{},
+		log:        log,
+	}, nil
+}
+
+func depthKey(market, instrument string) string {
+	return market + "/" + instrument
+}
+
+//Add updates the latest snapshot of the instrument, indicating if the snapshot
+//was also persisted
+func (dl *DepthLibrary) Add(d *ticks.Depth) (bool, error) {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	key := depthKey(d.Market, d.Instrument)
+	dl.latest[key] = d
+
+	ts := time.Unix(0, d.Timestamp*int64(time.Millisecond))
+	if ts.Sub(dl.lastStored[key]) < dl.interval {
+		return false, nil
+	}
+
+	if err := dl.write(ts, d); err != nil {
+		return false, err
+	}
+
+	dl.lastStored[key] = ts
+
+	return true, nil
+}
+
+func (dl *DepthLibrary) write(ts time.Time, d *ticks.Depth) error {
+	start := ts.Truncate(depthFileSpan)
+
+	if dl.active == nil || !start.Equal(dl.activeStart) {
+		if dl.active != nil {
+			dl.active.Close()
+		}
+
+		f, err := os.OpenFile(dl.fileName(start), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return err
+		}
+
+		dl.active = f
+		dl.activeStart = start
+	}
+
+	val, err := msgpack.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	buff := make([]byte, 12+len(val))
+	binary.LittleEndian.PutUint32(buff[:4], uint32(len(val)))
+	binary.LittleEndian.PutUint64(buff[4:12], uint64(d.Timestamp))
+	copy(buff[12:], val)
+
+	_, err = dl.active.Write(buff)
+	return err
+}
+
+func (dl *DepthLibrary) fileName(start time.Time) string {
+	return filepath.Join(dl.dir, fmt.Sprintf("%s%d", depthFilePrefix, start.Unix()))
+}
+
+//Latest provides the most recent snapshot of an instrument, nil if none has been received
+func (dl *DepthLibrary) Latest(market, instrument string) *ticks.Depth {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	return dl.latest[depthKey(market, instrument)]
+}
+
+//files provides the start times of the depth files in order
+func (dl *DepthLibrary) files() ([]time.Time, error) {
+	files, err := ioutil.ReadDir(dl.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	starts := []time.Time{}
+	for _, file := range files {
+		if !strings.HasPrefix(file.Name(), depthFilePrefix) {
+			continue
+		}
+
+		ts, err := strconv.ParseInt(strings.TrimPrefix(file.Name(), depthFilePrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		starts = append(starts, time.Unix(ts, 0))
+	}
+
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	return starts, nil
+}
+
+//GetSince provides the persisted snapshots of an instrument between since and until
+func (dl *DepthLibrary) GetSince(market, instrument string, since, until time.Time) ([]*ticks.Depth, error) {
+	if since.IsZero() {
+		return nil, fmt.Errorf("since must not be zero")
+	}
+
+	starts, err := dl.files()
+	if err != nil {
+		return nil, err
+	}
+
+	from := since.UnixNano() / int64(time.Millisecond)
+	to := until.UnixNano() / int64(time.Millisecond)
+
+	depths := []*ticks.Depth{}
+
+	for _, start := range starts {
+		if start.Add(depthFileSpan).Before(since) || start.After(until) {
+			continue
+		}
+
+		err := dl.readFile(dl.fileName(start), func(ts int64, b []byte) error {
+			if ts < from || ts > to {
+				return nil
+			}
+
+			d := &ticks.Depth{}
+			if err := msgpack.Unmarshal(b, d); err != nil {
+				return err
+			}
+
+			if d.Market == market && d.Instrument == instrument {
+				depths = append(depths, d)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return depths, nil
+}
+
+//readFile calls fn for each record in the file. A partially written record at the
+//end of the file is ignored
+func (dl *DepthLibrary) readFile(name string, fn func(ts int64, b []byte) error) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	head := make([]byte, 12)
+
+	for {
+		if _, err := io.ReadFull(r, head); err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		rl := binary.LittleEndian.Uint32(head[:4])
+		ts := int64(binary.LittleEndian.Uint64(head[4:12]))
+
+		if rl > maxDepthRecordSize {
+			return fmt.Errorf("failed to read depth record: outside of standard bounds")
+		}
+
+		b := make([]byte, rl)
+		if _, err := io.ReadFull(r, b); err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := fn(ts, b); err != nil {
+			return err
+		}
+	}
+}
+
+//gc periodically removes depth files older than the retention period
+func (dl *DepthLibrary) gc(retention time.Duration) {
+	t := time.NewTicker(10 * time.Minute)
+	defer t.Stop()
+
+	for range t.C {
+		starts, err := dl.files()
+		if err != nil {
+			dl.log.Errorf("failed to list depth files: %s", err)
+			continue
+		}
+
+		cutoff := time.Now().Add(-retention)
+
+		for _, start := range starts {
+			if start.Add(depthFileSpan).After(cutoff) {
+				continue
+			}
+
+			if err := os.Remove(dl.fileName(start)); err != nil {
+				dl.log.Errorf("failed to delete depth file: %s", err)
+			}
+		}
+	}
+}
+
+func (dl *DepthLibrary) Close() error {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	if dl.active == nil {
+		return nil
+	}
+
+	dl.active.Sync()
+	err := dl.active.Close()
+	dl.active = nil
+
+	return err
+}
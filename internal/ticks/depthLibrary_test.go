@@ -0,0 +1,55 @@
+package ticks
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+func TestDepthLibrary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "depth")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dl, err := NewDepthLibrary(dir, 10*time.Second, logrus.New())
+	require.NoError(t, err)
+	defer dl.Close()
+
+	start := time.Now().Add(-time.Minute)
+
+	for i := 0; i < 60; i++ {
+		ts := start.Add(time.Duration(i) * time.Second)
+
+		stored, err := dl.Add(&ticks.Depth{
+			Market:     "binance.com",
+			Instrument: "BTCAUD",
+			Bids:       []*ticks.PriceLevel{{Price: float64(100 + i), Quantity: 1}},
+			Asks:       []*ticks.PriceLevel{{Price: float64(101 + i), Quantity: 2}},
+			Timestamp:  ts.UnixNano() / int64(time.Millisecond),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, i%10 == 0, stored)
+	}
+
+	latest := dl.Latest("binance.com", "BTCAUD")
+	require.NotNil(t, latest)
+	assert.Equal(t, 159.0, latest.Bids[0].Price)
+
+	assert.Nil(t, dl.Latest("binance.com", "ETHAUD"))
+
+	depths, err := dl.GetSince("binance.com", "BTCAUD", start.Add(-time.Second), time.Now())
+	require.NoError(t, err)
+	require.Len(t, depths, 6)
+	assert.Equal(t, 100.0, depths[0].Bids[0].Price)
+	assert.Equal(t, 110.0, depths[1].Bids[0].Price)
+
+	depths, err = dl.GetSince("binance.com", "ETHAUD", start.Add(-time.Second), time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, depths)
+}
@@ -0,0 +1,334 @@
+package ticks
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+)
+
+const (
+	fileIndexSuffix  = ".idx"
+	fileIndexMagic   = "ATIX"
+	fileIndexVersion = 1
+
+	//indexInterval records between sparse index entries
+	indexInterval = 64
+)
+
+var (
+	ErrIndexStale   = errors.New("index stale")
+	ErrIndexCorrupt = errors.New("index corrupt")
+)
+
+//indexEntry the offset of a record and its timestamp
+type indexEntry struct {
+	ts     int64
+	offset int64
+}
+
+//instrumentKey identifies an instrument of a market
+type instrumentKey struct {
+	market     string
+	instrument string
+}
+
+//instrumentSpan the range of a file containing all records of an instrument
+type instrumentSpan struct {
+	first int64
+	end   int64
+	count uint32
+}
+
+//fileIndex the persisted sparse time index of a file store, allowing the file store
+//to be opened without reading every record. The index covers the first size bytes of
+//the file; records appended after it was written are scanned when loaded
+type fileIndex struct {
+	size       int64
+	lastOffset int64
+	records    uint32
+	startTime  int64
+	lastTime   int64
+
+	entries     []indexEntry
+	instruments map[instrumentKey]*instrumentSpan
+}
+
+//encodeFileIndex encodes the index as magic+version+reserved, the file summary, the
+//sparse entries and instrument spans, followed by a CRC32C of the preceding bytes
+func encodeFileIndex(idx *fileIndex) []byte {
+	buf := bytes.NewBuffer(nil)
+
+	header := make([]byte, 8)
+	copy(header, fileIndexMagic)
+	binary.LittleEndian.PutUint16(header[4:6], fileIndexVersion)
+	buf.Write(header)
+
+	binary.Write(buf, binary.LittleEndian, idx.size)
+	binary.Write(buf, binary.LittleEndian, idx.lastOffset)
+	binary.Write(buf, binary.LittleEndian, idx.records)
+	binary.Write(buf, binary.LittleEndian, idx.startTime)
+	binary.Write(buf, binary.LittleEndian, idx.lastTime)
+
+	binary.Write(buf, binary.LittleEndian, uint32(len(idx.entries)))
+	for _, e := range idx.entries {
+		binary.Write(buf, binary.LittleEndian, e.ts)
+		binary.Write(buf, binary.LittleEndian, e.offset)
+	}
+
+	//sorted to keep the encoding deterministic
+	keys := make([]instrumentKey, 0, len(idx.instruments))
+	for key := range idx.instruments {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].market != keys[j].market {
+			return keys[i].market < keys[j].market
+		}
+		return keys[i].instrument < keys[j].instrument
+	})
+
+	binary.Write(buf, binary.LittleEndian, uint32(len(keys)))
+	for _, key := range keys {
+		span := idx.instruments[key]
+		writeIndexString(buf, key.market)
+		writeIndexString(buf, key.instrument)
+		binary.Write(buf, binary.LittleEndian, span.first)
+		binary.Write(buf, binary.LittleEndian, span.end)
+		binary.Write(buf, binary.LittleEndian, span.count)
+	}
+
+	binary.Write(buf, binary.LittleEndian, crc32.Checksum(buf.Bytes(), crcTable))
+
+	return buf.Bytes()
+}
+
+func writeIndexString(buf *bytes.Buffer, s string) {
+	binary.Write(buf, binary.LittleEndian, uint16(len(s)))
+	buf.WriteString(s)
+}
+
+func decodeFileIndex(b []byte) (*fileIndex, error) {
+	if len(b) < 12 || string(b[:len(fileIndexMagic)]) != fileIndexMagic {
+		return nil, ErrIndexCorrupt
+	}
+
+	if v := binary.LittleEndian.Uint16(b[4:6]); v != fileIndexVersion {
+		return nil, fmt.Errorf("unsupported index version %d: %w", v, ErrIndexCorrupt)
+	}
+
+	body, sum := b[:len(b)-4], binary.LittleEndian.Uint32(b[len(b)-4:])
+	if crc32.Checksum(body, crcTable) != sum {
+		return nil, fmt.Errorf("checksum mismatch: %w", ErrIndexCorrupt)
+	}
+
+	r := bytes.NewReader(body[8:])
+	idx := &fileIndex{instruments: map[instrumentKey]*instrumentSpan{}}
+
+	for _, v := range []interface{}{&idx.size, &idx.lastOffset, &idx.records, &idx.startTime, &idx.lastTime} {
+		if err := binary.Read(r, binary.LittleEndian, v); err != nil {
+			return nil, ErrIndexCorrupt
+		}
+	}
+
+	var n uint32
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil || int64(n)*16 > int64(r.Len()) {
+		return nil, ErrIndexCorrupt
+	}
+	idx.entries = make([]indexEntry, n)
+	for i := range idx.entries {
+		e := &idx.entries[i]
+		if err := binary.Read(r, binary.LittleEndian, &e.ts); err != nil {
+			return nil, ErrIndexCorrupt
+		}
+		if err := binary.Read(r, binary.LittleEndian, &e.offset); err != nil {
+			return nil, ErrIndexCorrupt
+		}
+	}
+
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return nil, ErrIndexCorrupt
+	}
+	for i := uint32(0); i < n; i++ {
+		market, err := readIndexString(r)
+		if err != nil {
+			return nil, err
+		}
+		instrument, err := readIndexString(r)
+		if err != nil {
+			return nil, err
+		}
+
+		span := &instrumentSpan{}
+		for _, v := range []interface{}{&span.first, &span.end, &span.count} {
+			if err := binary.Read(r, binary.LittleEndian, v); err != nil {
+				return nil, ErrIndexCorrupt
+			}
+		}
+		idx.instruments[instrumentKey{market, instrument}] = span
+	}
+
+	return idx, nil
+}
+
+func readIndexString(r *bytes.Reader) (string, error) {
+	var l uint16
+	if err := binary.Read(r, binary.LittleEndian, &l); err != nil || int(l) > r.Len() {
+		return "", ErrIndexCorrupt
+	}
+
+	s := make([]byte, l)
+	io.ReadFull(r, s)
+
+	return string(s), nil
+}
+
+//indexFile the path of the index of a file store
+func indexFile(file string) string {
+	return file + fileIndexSuffix
+}
+
+//loadIndex restores the skip list, time range and instrument spans from the persisted
+//index. The last indexed record is verified against the file to detect an index which
+//no longer matches its file
+func (fs *FileStore) loadIndex() error {
+	b, err := ioutil.ReadFile(indexFile(fs.name))
+	if err != nil {
+		return err
+	}
+
+	idx, err := decodeFileIndex(b)
+	if err != nil {
+		return err
+	}
+
+	if idx.size < fs.dataOff || idx.size > fs.size {
+		return ErrIndexStale
+	}
+
+	if idx.records > 0 {
+		r := fs.newReaderAt(fs.f, idx.lastOffset, idx.size)
+		if _, _, n, err := r.read(); err != nil || idx.lastOffset+int64(n) != idx.size {
+			return ErrIndexStale
+		}
+	}
+
+	for _, e := range idx.entries {
+		fs.sk.insert(time.Unix(e.ts, 0), uint64(e.offset))
+	}
+
+	fs.index = idx.entries
+	fs.instruments = idx.instruments
+	fs.records = idx.records
+	fs.lastOffset = idx.lastOffset
+	fs.indexedSize = idx.size
+
+	if idx.records > 0 {
+		fs.startTime = time.Unix(idx.startTime, 0)
+		fs.lastTime = time.Unix(idx.lastTime, 0)
+	}
+
+	return nil
+}
+
+//resetIndex discards any partially loaded index state before rebuilding
+func (fs *FileStore) resetIndex() {
+	fs.sk = &skipList{}
+	fs.index = nil
+	fs.instruments = map[instrumentKey]*instrumentSpan{}
+	fs.records = 0
+	fs.lastOffset = 0
+	fs.indexedSize = 0
+	fs.startTime = time.Time{}
+	fs.lastTime = time.Time{}
+}
+
+//WriteIndex atomically persists the sparse index of the file store alongside the file
+//if records have been added since it was last written
+func (fs *FileStore) WriteIndex() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.writeIndex()
+}
+
+func (fs *FileStore) writeIndex() error {
+	if fs.f == nil || fs.size == fs.indexedSize {
+		return nil
+	}
+
+	//the index must never cover data which isn't on disk
+	if err := fs.f.Sync(); err != nil {
+		return err
+	}
+
+	idx := &fileIndex{
+		size:        fs.size,
+		lastOffset:  fs.lastOffset,
+		records:     fs.records,
+		startTime:   fs.startTime.Unix(),
+		lastTime:    fs.lastTime.Unix(),
+		entries:     fs.index,
+		instruments: fs.instruments,
+	}
+
+	p := indexFile(fs.name)
+	tmp := p + ".tmp"
+	if err := ioutil.WriteFile(tmp, encodeFileIndex(idx), 0644); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		return err
+	}
+
+	fs.indexedSize = fs.size
+
+	return nil
+}
+
+//track adds a record at the offset to the sparse index, time range and instrument spans
+func (fs *FileStore) track(ts time.Time, off int64, n int, market, instrument string) {
+	if fs.records%indexInterval == 0 {
+		fs.sk.insert(ts, uint64(off))
+		fs.index = append(fs.index, indexEntry{ts.Unix(), off})
+	}
+
+	fs.records++
+	fs.lastOffset = off
+
+	if fs.startTime.IsZero() || ts.Before(fs.startTime) {
+		fs.startTime = ts
+	}
+	if fs.lastTime.IsZero() || ts.After(fs.lastTime) {
+		fs.lastTime = ts
+	}
+
+	key := instrumentKey{market, instrument}
+	span, ok := fs.instruments[key]
+	if !ok {
+		span = &instrumentSpan{first: off}
+		fs.instruments[key] = span
+	}
+	span.end = off + int64(n)
+	span.count++
+}
+
+//span provides the range of the file containing the instrument
+func (fs *FileStore) span(market, instrument string) (instrumentSpan, bool) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	span, ok := fs.instruments[instrumentKey{market, instrument}]
+	if !ok {
+		return instrumentSpan{}, false
+	}
+
+	return *span, true
+}
@@ -0,0 +1,228 @@
+package ticks
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+func addIndexTrades(t *testing.T, fs *FileStore, start int64, from, n int) {
+	for i := from; i < from+n; i++ {
+		instrument := "TCFWAUD"
+		if i%2 == 1 {
+			instrument = "TCFWUSD"
+		}
+
+		err := fs.Add(&ticks.Trade{
+			Market:     "ataas.io",
+			Instrument: instrument,
+			TradeID:    strconv.Itoa(i),
+			Amount:     float32(i),
+			Units:      1,
+			Timestamp:  start + int64(i),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIndexReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Now().Unix()
+
+	fs, err := NewFileStore(dir, time.Unix(start, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fName := fs.f.Name()
+
+	addIndexTrades(t, fs, start, 0, 1000)
+
+	size, records, entries := fs.size, fs.records, len(fs.index)
+	assert.Equal(t, 1000/indexInterval+1, entries)
+
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = os.Stat(indexFile(fName))
+	assert.NoError(t, err)
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	assert.Equal(t, size, fs.indexedSize)
+	assert.Equal(t, records, fs.records)
+	assert.Len(t, fs.index, entries)
+	assert.Equal(t, start, fs.startTime.Unix())
+	assert.Equal(t, start+999, fs.lastTime.Unix())
+
+	if assert.Contains(t, fs.instruments, instrumentKey{"ataas.io", "TCFWUSD"}) {
+		assert.Equal(t, uint32(500), fs.instruments[instrumentKey{"ataas.io", "TCFWUSD"}].count)
+	}
+
+	trades, err := fs.GetAll("ataas.io", "TCFWAUD", uint64(start+500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, trades, 250) {
+		assert.Equal(t, "500", trades[0].TradeID)
+	}
+
+	trades, err = fs.GetAll("ataas.io", "TCFWEUR", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, trades)
+}
+
+func TestIndexScansTail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Now().Unix()
+
+	fs, err := NewFileStore(dir, time.Unix(start, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fName := fs.f.Name()
+
+	addIndexTrades(t, fs, start, 0, 100)
+	if err := fs.WriteIndex(); err != nil {
+		t.Fatal(err)
+	}
+	indexed := fs.size
+
+	//records after the index was written, closed without updating the index
+	addIndexTrades(t, fs, start, 100, 100)
+	fs.f.Close()
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	assert.Nil(t, fs.Recovery())
+	assert.True(t, fs.indexedSize > indexed)
+	assert.Equal(t, uint32(200), fs.records)
+	assert.Equal(t, start+199, fs.lastTime.Unix())
+
+	trades, err := fs.GetAll("ataas.io", "TCFWUSD", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, trades, 100)
+}
+
+func TestIndexRebuild(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Now().Unix()
+
+	fs, err := NewFileStore(dir, time.Unix(start, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fName := fs.f.Name()
+
+	addIndexTrades(t, fs, start, 0, 100)
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	//corrupt index
+	b, err := ioutil.ReadFile(indexFile(fName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[20] ^= 0x01
+	if err := ioutil.WriteFile(indexFile(fName), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, uint32(100), fs.records)
+	trades, err := fs.GetAll("ataas.io", "TCFWAUD", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, trades, 50)
+
+	//index covering more than the file
+	half := fs.index[1].offset
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(fName, half); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	assert.Equal(t, uint32(indexInterval), fs.records)
+	assert.Equal(t, half, fs.indexedSize)
+	assert.Equal(t, start+indexInterval-1, fs.lastTime.Unix())
+}
+
+func BenchmarkOpenIndexed(b *testing.B) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs, err := NewFileStore(dir, time.Now())
+	if err != nil {
+		b.Fatal(err)
+	}
+	fName := fs.f.Name()
+
+	trade := &ticks.Trade{Market: "ataas.io", Instrument: "TCFWAUD", TradeID: "0", Amount: 1, Units: 1, Timestamp: time.Now().Unix()}
+	for i := 0; i < 100000; i++ {
+		trade.Timestamp++
+		if err := fs.Add(trade); err != nil {
+			b.Fatal(err)
+		}
+	}
+	fs.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fs, err := NewFileStoreFromFile(fName)
+		if err != nil {
+			b.Fatal(err)
+		}
+		fs.Close()
+	}
+}
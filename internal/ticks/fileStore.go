@@ -4,8 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"io/ioutil"
 	"os"
 	"sync"
 	"time"
@@ -16,8 +19,65 @@ import (
 
 const (
 	fileStorePrefix = "trades_"
+
+	fileStoreMagic = "ATTR"
+
+	//fileStoreVersion current format. Version 1 files have no header or checksums
+	fileStoreVersion    = 2
+	fileStoreHeaderSize = 8 //magic+version+reserved
+
+	recordHeaderSizeV1 = 10 //len+ts
+	recordHeaderSize   = 14 //len+ts+crc
+
+	maxRecordSize = 256
+)
+
+var (
+	ErrRecordCorrupt = errors.New("record corrupt")
+	ErrStoreSealed   = errors.New("file store sealed")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+//SyncPolicy when writes are flushed to disk
+type SyncPolicy int
+
+const (
+	//SyncNever leaves flushing writes to the OS
+	SyncNever SyncPolicy = iota
+	//SyncInterval syncs the active file periodically
+	SyncInterval
+	//SyncAlways syncs after every record
+	SyncAlways
 )
 
+//ParseSyncPolicy parses never, interval or always
+func ParseSyncPolicy(p string) (SyncPolicy, error) {
+	switch p {
+	case "never":
+		return SyncNever, nil
+	case "", "interval":
+		return SyncInterval, nil
+	case "always":
+		return SyncAlways, nil
+	default:
+		return SyncNever, fmt.Errorf("unknown sync policy %q", p)
+	}
+}
+
+//RecoveryReport describes trailing corruption removed when opening a file store
+type RecoveryReport struct {
+	File           string
+	Records        int
+	TruncatedAt    int64
+	TruncatedBytes int64
+	Err            error
+}
+
+func (r *RecoveryReport) String() string {
+	return fmt.Sprintf("%s: truncated %d bytes at offset %d after %d records (%s)", r.File, r.TruncatedBytes, r.TruncatedAt, r.Records, r.Err)
+}
+
 func NewFileStore(dir string, ts time.Time) (*FileStore, error) {
 	//Round down to closest hour
 	ts = ts.Truncate(time.Minute)
@@ -27,9 +87,13 @@ func NewFileStore(dir string, ts time.Time) (*FileStore, error) {
 	return NewFileStoreFromFile(abs)
 }
 
+//NewFileStoreFromFile opens or creates a file store. The skip list is loaded from the
+//persisted index, scanning only records written after the index. Files without a valid
+//index are fully scanned and the index rebuilt. Any corrupt or partially written records
+//at the end of the file are moved to a .corrupt file and truncated
 func NewFileStoreFromFile(file string) (*FileStore, error) {
 	// f, err := mmap.OpenFile(file, 0644, maxFileStoreSize)
-	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE, 0644)
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
 		return nil, err
 	}
@@ -40,53 +104,54 @@ func NewFileStoreFromFile(file string) (*FileStore, error) {
 	}
 
 	fStore := &FileStore{
-		f:         f,
-		startTime: time.Time{},
-		lastTime:  time.Time{},
-		size:      fstat.Size(),
-		sk:        &skipList{},
-		encBuff:   make([]byte, encTradeSize),
+		f:           f,
+		name:        file,
+		startTime:   time.Time{},
+		lastTime:    time.Time{},
+		size:        fstat.Size(),
+		sk:          &skipList{},
+		instruments: map[instrumentKey]*instrumentSpan{},
+		version:     fileStoreVersion,
+		dataOff:     fileStoreHeaderSize,
 	}
 
-	if fStore.size != 0 {
-		fStore.buildSK()
+	if fStore.size == 0 {
+		//any index left from a previous file of the same name no longer applies
+		if err := os.Remove(indexFile(file)); err != nil && !os.IsNotExist(err) {
+			f.Close()
+			return nil, err
+		}
 
-		s, l, err := findSLTimestamps(fStore)
-		if err != nil {
+		if err := fStore.writeHeader(); err != nil {
+			f.Close()
 			return nil, err
 		}
-		fStore.startTime = s
-		fStore.lastTime = l
+		return fStore, nil
 	}
 
-	return fStore, nil
-}
-
-func findSLTimestamps(fs *FileStore) (time.Time, time.Time, error) {
-
-	var startTime, lastTime time.Time
+	if err := fStore.readHeader(); err != nil {
+		f.Close()
+		return nil, err
+	}
 
-	r := fs.newReader(time.Time{})
-	for {
-		ts, err := r.nextTs(0)
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return startTime, lastTime, err
-		}
+	from := fStore.dataOff
+	if err := fStore.loadIndex(); err == nil {
+		from = fStore.indexedSize
+	} else {
+		fStore.resetIndex()
+	}
 
-		pTs := time.Unix(int64(ts), 0)
+	if err := fStore.scan(from); err != nil {
+		f.Close()
+		return nil, err
+	}
 
-		if startTime.IsZero() || pTs.Before(startTime) {
-			startTime = pTs
-		}
-		if lastTime.IsZero() || pTs.After(lastTime) {
-			lastTime = pTs
-		}
+	if err := fStore.writeIndex(); err != nil {
+		f.Close()
+		return nil, err
 	}
 
-	return startTime, lastTime, nil
+	return fStore, nil
 }
 
 type fileStoreFile interface {
@@ -96,95 +161,254 @@ type fileStoreFile interface {
 
 	Sync() error
 	Name() string
+	Truncate(int64) error
 }
 
 type FileStore struct {
 	f         fileStoreFile
+	name      string
 	startTime time.Time
 	lastTime  time.Time
 	size      int64
 
+	version int
+	dataOff int64
+	sync    SyncPolicy
+
+	//recovery set if corruption was removed when opened
+	recovery *RecoveryReport
+
+	//sparse index of every indexInterval records, persisted by writeIndex
+	index       []indexEntry
+	instruments map[instrumentKey]*instrumentSpan
+	records     uint32
+	lastOffset  int64
+	indexedSize int64
+
 	mu sync.RWMutex
 	sk *skipList
+}
+
+func (fs *FileStore) writeHeader() error {
+	header := make([]byte, fileStoreHeaderSize)
+	copy(header, fileStoreMagic)
+	binary.LittleEndian.PutUint16(header[4:6], fileStoreVersion)
+
+	if _, err := fs.f.Write(header); err != nil {
+		return err
+	}
 
-	encBuff []byte
+	fs.size = fileStoreHeaderSize
+
+	return fs.f.Sync()
 }
 
-func (fs *FileStore) buildSK() {
-	r := fs.newReader(time.Time{})
+func (fs *FileStore) readHeader() error {
+	header := make([]byte, fileStoreHeaderSize)
+	n, err := fs.f.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	if n < len(fileStoreMagic) || string(header[:len(fileStoreMagic)]) != fileStoreMagic {
+		//files written before the header was introduced
+		fs.version = 1
+		fs.dataOff = 0
+		return nil
+	}
+
+	if n < fileStoreHeaderSize {
+		return fmt.Errorf("%s: truncated header", fs.name)
+	}
+
+	fs.version = int(binary.LittleEndian.Uint16(header[4:6]))
+	if fs.version > fileStoreVersion {
+		return fmt.Errorf("%s: unsupported file store version %d", fs.name, fs.version)
+	}
+
+	return nil
+}
 
-	buf := make([]byte, 1024)
-	var off uint64
+//scan reads each record from the offset to add it to the index, truncating the file
+//at the first invalid record
+func (fs *FileStore) scan(off int64) error {
+	r := fs.newReaderAt(fs.f, off, fs.size)
 
 	for {
-		rowN := 0
-		n, err := io.ReadFull(r.r, buf[:10])
+		ts, val, n, err := r.read()
 		if err == io.EOF {
-			return
+			return nil
 		} else if err != nil {
-			return
+			return fs.truncate(off, int(fs.records), err)
 		}
-		rowN += n
 
-		rl := binary.LittleEndian.Uint16(buf[:2])
-		ts := binary.LittleEndian.Uint64(buf[2:10])
-
-		no, err := r.r.Discard(int(rl))
+		trade, err := r.decode(val)
 		if err != nil {
-			return
+			return fs.truncate(off, int(fs.records), fmt.Errorf("failed to decode record: %s: %w", err, ErrRecordCorrupt))
 		}
-		rowN += int(no)
 
-		if fs.sk.coinFlip() {
-			fs.sk.insert(time.Unix(int64(ts), 0), off)
-		}
+		fs.track(time.Unix(int64(ts), 0), off, n, trade.Market, trade.Instrument)
 
-		off += uint64(rowN)
+		off += int64(n)
 	}
 }
 
+//truncate removes all data from the offset, keeping a copy of the removed bytes
+func (fs *FileStore) truncate(off int64, records int, cause error) error {
+	report := &RecoveryReport{
+		File:           fs.name,
+		Records:        records,
+		TruncatedAt:    off,
+		TruncatedBytes: fs.size - off,
+		Err:            cause,
+	}
+
+	tail := make([]byte, report.TruncatedBytes)
+	if _, err := fs.f.ReadAt(tail, off); err != nil && err != io.EOF {
+		return err
+	}
+
+	if err := ioutil.WriteFile(fs.name+".corrupt", tail, 0644); err != nil {
+		return err
+	}
+
+	if err := fs.f.Truncate(off); err != nil {
+		return err
+	}
+
+	if err := fs.f.Sync(); err != nil {
+		return err
+	}
+
+	fs.size = off
+	fs.recovery = report
+
+	return nil
+}
+
+//Recovery provides the details of any corruption removed when the file was opened
+func (fs *FileStore) Recovery() *RecoveryReport {
+	return fs.recovery
+}
+
+//SetSyncPolicy sets when records are flushed to disk
+func (fs *FileStore) SetSyncPolicy(p SyncPolicy) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.sync = p
+}
+
+//Sync flushes written records to disk
+func (fs *FileStore) Sync() error {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if fs.f == nil {
+		return nil
+	}
+
+	return fs.f.Sync()
+}
+
 func (fs *FileStore) Add(trade *ticks.Trade) error {
 	fs.mu.Lock()
 	defer fs.mu.Unlock()
 
+	if fs.f == nil {
+		return ErrStoreSealed
+	}
+
 	valBuff := bytes.NewBuffer(nil)
 	err := fs.encode(valBuff, trade)
 	if err != nil {
 		return err
 	}
 
+	if valBuff.Len() > maxRecordSize {
+		return fmt.Errorf("trade record too large: %d bytes", valBuff.Len())
+	}
+
 	tradeTs := trade.Timestamp
 	if tradeTs > 9999999999 {
 		tradeTs = tradeTs / 1000
 	}
 
-	fs.sk.insert(time.Unix(tradeTs, 0), uint64(fs.size))
-
-	buff := make([]byte, 10+valBuff.Len())
-	binary.LittleEndian.PutUint16(buff[:2], uint16(valBuff.Len()))
-	binary.LittleEndian.PutUint64(buff[2:10], uint64(tradeTs))
-
-	copy(buff[10:], valBuff.Bytes())
+	buff := encodeRecord(fs.version, uint64(tradeTs), valBuff.Bytes())
 
 	n, err := fs.f.Write(buff)
 	if err != nil {
 		return err
 	}
 
+	fs.track(time.Unix(tradeTs, 0), fs.size, n, trade.Market, trade.Instrument)
 	fs.size += int64(n)
 
-	ts := time.Unix(tradeTs, 0)
-	if fs.startTime.IsZero() {
-		fs.startTime = ts
+	if fs.sync == SyncAlways {
+		if err := fs.f.Sync(); err != nil {
+			return err
+		}
 	}
-	fs.lastTime = ts
+
+	return nil
+}
+
+//encodeRecord frames a record as len+ts+crc+value. The checksum covers the
+//timestamp and value. Version 1 records have no checksum
+func encodeRecord(version int, ts uint64, val []byte) []byte {
+	if version == 1 {
+		buff := make([]byte, recordHeaderSizeV1+len(val))
+		binary.LittleEndian.PutUint16(buff[:2], uint16(len(val)))
+		binary.LittleEndian.PutUint64(buff[2:10], ts)
+		copy(buff[recordHeaderSizeV1:], val)
+		return buff
+	}
+
+	buff := make([]byte, recordHeaderSize+len(val))
+	binary.LittleEndian.PutUint16(buff[:2], uint16(len(val)))
+	binary.LittleEndian.PutUint64(buff[2:10], ts)
+	copy(buff[recordHeaderSize:], val)
+
+	crc := crc32.Update(crc32.Checksum(buff[2:10], crcTable), crcTable, val)
+	binary.LittleEndian.PutUint32(buff[10:14], crc)
+
+	return buff
+}
+
+//Seal persists the index and closes the file for writing. Sealed file stores remain
+//readable but no longer hold an open file
+func (fs *FileStore) Seal() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if fs.f == nil {
+		return nil
+	}
+
+	if err := fs.writeIndex(); err != nil {
+		return err
+	}
+
+	if err := fs.f.Close(); err != nil {
+		return err
+	}
+
+	fs.f = nil
 
 	return nil
 }
 
 func (fs *FileStore) Close() error {
 	fs.mu.Lock()
-	// defer fs.mu.Unlock()
+	defer fs.mu.Unlock()
+
+	if fs.f == nil {
+		return nil
+	}
+
+	if err := fs.writeIndex(); err != nil {
+		return err
+	}
 
 	fs.f.Sync()
 
@@ -202,7 +426,7 @@ func (fs *FileStore) Open() error {
 	fs.mu.Lock()
 	defer fs.mu.Unlock()
 
-	f, err := os.OpenFile(fs.f.Name(), os.O_RDWR|os.O_CREATE|os.O_SYNC, 0644)
+	f, err := os.OpenFile(fs.name, os.O_RDWR|os.O_CREATE|os.O_APPEND|os.O_SYNC, 0644)
 	if err != nil {
 		return err
 	}
@@ -213,7 +437,17 @@ func (fs *FileStore) Open() error {
 
 func (fs *FileStore) GetAll(market, instrument string, after uint64) ([]*ticks.Trade, error) {
 	trades := make([]*ticks.Trade, 0, 200)
-	r := fs.newReader(time.Unix(int64(after), 0))
+
+	span, ok := fs.span(market, instrument)
+	if !ok {
+		return trades, nil
+	}
+
+	r, err := fs.newReader(time.Unix(int64(after), 0), span)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
 
 	for {
 		trade, err := r.next(after)
@@ -234,7 +468,16 @@ func (fs *FileStore) GetAll(market, instrument string, after uint64) ([]*ticks.T
 func (fs *FileStore) GetN(market, instrument string, after uint64, n int) ([]*ticks.Trade, error) {
 	trades := make([]*ticks.Trade, 0, n)
 
-	r := fs.newReader(time.Unix(int64(after), 0))
+	span, ok := fs.span(market, instrument)
+	if !ok {
+		return trades, nil
+	}
+
+	r, err := fs.newReader(time.Unix(int64(after), 0), span)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
 
 	for {
 		trade, err := r.next(after)
@@ -254,12 +497,36 @@ func (fs *FileStore) GetN(market, instrument string, after uint64, n int) ([]*ti
 	return trades, nil
 }
 
+//GetStream streams trades of an instrument, only reading the range of the file
+//containing the instrument
 func (fs *FileStore) GetStream(market, instrument string, from, until uint64) (<-chan *ticks.Trade, error) {
+	span, ok := fs.span(market, instrument)
+	if !ok {
+		trades := make(chan *ticks.Trade)
+		close(trades)
+		return trades, nil
+	}
+
+	return fs.stream(from, until, span, func(trade *ticks.Trade) bool {
+		return trade.Market == market && trade.Instrument == instrument
+	})
+}
+
+//GetAllStream streams trades of all instruments
+func (fs *FileStore) GetAllStream(from, until uint64) (<-chan *ticks.Trade, error) {
+	return fs.stream(from, until, instrumentSpan{}, func(trade *ticks.Trade) bool { return true })
+}
+
+func (fs *FileStore) stream(from, until uint64, span instrumentSpan, match func(*ticks.Trade) bool) (<-chan *ticks.Trade, error) {
 	trades := make(chan *ticks.Trade)
-	r := fs.newReader(time.Unix(int64(from), 0))
+	r, err := fs.newReader(time.Unix(int64(from), 0), span)
+	if err != nil {
+		return nil, err
+	}
 
 	go func() {
 		defer close(trades)
+		defer r.Close()
 
 		for {
 			trade, err := r.next(from)
@@ -279,7 +546,7 @@ func (fs *FileStore) GetStream(market, instrument string, from, until uint64) (<
 				return
 			}
 
-			if trade.Market == market && trade.Instrument == instrument {
+			if match(trade) {
 				trades <- trade
 			}
 		}
@@ -313,31 +580,70 @@ func (fs *FileStore) encode(w io.Writer, t *ticks.Trade) error {
 	return msgpack.NewEncoder(w).Encode(t)
 }
 
-func (fs *FileStore) newReader(ts time.Time) *fsReader {
+//newReader reads from the closest indexed record at or before the timestamp. A non
+//empty span limits reading to the range of the file containing an instrument. Readers
+//open their own file so sealing the store doesn't interrupt reads
+func (fs *FileStore) newReader(ts time.Time, span instrumentSpan) (*fsReader, error) {
 	fs.mu.RLock()
 	defer fs.mu.RUnlock()
 
-	var offset int64
+	f, err := os.Open(fs.name)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, end := fs.dataOff, fs.size
 	n := fs.sk.search(ts)
 	if n != nil {
 		offset = int64(n.offset)
 	}
 
-	roff := io.NewSectionReader(fs.f, offset, fs.size)
+	if span.end != 0 {
+		if span.first > offset {
+			offset = span.first
+		}
+		end = span.end
+	}
+
+	if offset > end {
+		offset = end
+	}
+
+	r := fs.newReaderAt(f, offset, end)
+	r.f = f
+
+	return r, nil
+}
+
+//newReaderAt reads the records of the file between two offsets
+func (fs *FileStore) newReaderAt(f io.ReaderAt, offset, end int64) *fsReader {
+	roff := io.NewSectionReader(f, offset, end-offset)
 
 	r := bufio.NewReaderSize(roff, 4096)
 
 	reader := &fsReader{
-		r:   r,
-		buf: make([]byte, 256),
+		r:       r,
+		buf:     make([]byte, recordHeaderSize+maxRecordSize),
+		version: fs.version,
 	}
 
 	return reader
 }
 
 type fsReader struct {
-	r   *bufio.Reader
-	buf []byte
+	r       *bufio.Reader
+	f       io.Closer
+	buf     []byte
+	version int
+}
+
+//Close closes the file opened by the reader
+func (fs *fsReader) Close() error {
+	if fs.f == nil {
+		return nil
+	}
+
+	return fs.f.Close()
 }
 
 func (fs *fsReader) decode(b []byte) (*ticks.Trade, error) {
@@ -360,60 +666,65 @@ func (fs *fsReader) decode(b []byte) (*ticks.Trade, error) {
 	return t, nil
 }
 
-func (fs *fsReader) next(after uint64) (*ticks.Trade, error) {
-	_, err := io.ReadFull(fs.r, fs.buf[:10])
+//read reads the next record, verifying its checksum. io.EOF is only returned at a
+//record boundary; a partial record returns io.ErrUnexpectedEOF
+func (fs *fsReader) read() (ts uint64, val []byte, n int, err error) {
+	hl := recordHeaderSize
+	if fs.version == 1 {
+		hl = recordHeaderSizeV1
+	}
+
+	hn, err := io.ReadFull(fs.r, fs.buf[:hl])
 	if err == io.EOF {
-		return nil, err
+		return 0, nil, 0, err
+	} else if err == io.ErrUnexpectedEOF {
+		return 0, nil, hn, err
 	} else if err != nil {
-		return nil, fmt.Errorf("failed to read record len+ts: %s", err)
+		return 0, nil, hn, fmt.Errorf("failed to read record len+ts: %s", err)
 	}
 
-	rl := binary.LittleEndian.Uint16(fs.buf[:2])
-	ts := binary.LittleEndian.Uint64(fs.buf[2:10])
+	rl := int(binary.LittleEndian.Uint16(fs.buf[:2]))
+	ts = binary.LittleEndian.Uint64(fs.buf[2:10])
 
-	if ts < after {
-		fs.r.Discard(int(rl))
-		return fs.next(after)
+	if rl > maxRecordSize {
+		return 0, nil, hn, fmt.Errorf("failed to read record: outside of standard bounds: %w", ErrRecordCorrupt)
 	}
 
-	if rl > 150 {
-		return nil, fmt.Errorf("failed to read record: outside of standard bounds")
-	}
-
-	_, err = io.ReadFull(fs.r, fs.buf[:rl])
-	if err != nil {
-		return nil, fmt.Errorf("failed to read record: %s", err)
+	val = fs.buf[hl : hl+rl]
+	if _, err := io.ReadFull(fs.r, val); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return 0, nil, hn, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return 0, nil, hn, fmt.Errorf("failed to read record: %s", err)
 	}
 
-	trade, err := fs.decode(fs.buf[:rl])
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode record: %s", err)
+	if fs.version != 1 {
+		crc := crc32.Update(crc32.Checksum(fs.buf[2:10], crcTable), crcTable, val)
+		if crc != binary.LittleEndian.Uint32(fs.buf[10:14]) {
+			return 0, nil, hn + rl, fmt.Errorf("failed to read record: checksum mismatch: %w", ErrRecordCorrupt)
+		}
 	}
 
-	return trade, nil
+	return ts, val, hl + rl, nil
 }
 
-func (fs *fsReader) nextTs(after uint64) (uint64, error) {
-	_, err := io.ReadFull(fs.r, fs.buf[:10])
-	if err == io.EOF {
-		return 0, err
-	} else if err != nil {
-		return 0, fmt.Errorf("failed to read record len+ts: %s", err)
-	}
+func (fs *fsReader) next(after uint64) (*ticks.Trade, error) {
+	for {
+		ts, val, _, err := fs.read()
+		if err != nil {
+			return nil, err
+		}
 
-	rl := binary.LittleEndian.Uint16(fs.buf[:2])
-	ts := binary.LittleEndian.Uint64(fs.buf[2:10])
+		if ts < after {
+			continue
+		}
 
-	_, err = io.ReadFull(fs.r, fs.buf[:rl])
-	if err != nil {
-		return 0, fmt.Errorf("failed to read record: %s", err)
-	}
+		trade, err := fs.decode(val)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode record: %s", err)
+		}
 
-	if ts < after {
-		return fs.nextTs(after)
+		return trade, nil
 	}
-
-	return ts, nil
 }
 
 func fileName(ts time.Time) string {
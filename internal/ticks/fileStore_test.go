@@ -1,12 +1,16 @@
 package ticks
 
 import (
+	"errors"
+	"io/ioutil"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/valyala/fastrand"
+	"github.com/vmihailenco/msgpack/v5"
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
 )
 
@@ -22,6 +26,7 @@ func TestAdd(t *testing.T) {
 	fName := fs.f.Name()
 
 	defer os.Remove(fName)
+	defer os.Remove(indexFile(fName))
 
 	trade := &ticks.Trade{
 		Market:     "atass.io",
@@ -59,6 +64,7 @@ func TestGet(t *testing.T) {
 	fName := fs.f.Name()
 
 	defer os.Remove(fName)
+	defer os.Remove(indexFile(fName))
 
 	market := "staging.ataas.io"
 	instrument := "TCFWAUD"
@@ -112,6 +118,7 @@ func TestFindSL(t *testing.T) {
 	fName := fs.f.Name()
 
 	defer os.Remove(fName)
+	defer os.Remove(indexFile(fName))
 
 	market := "ataas.io"
 	instrument := "TCFW/AUD"
@@ -161,6 +168,172 @@ func TestFindSL(t *testing.T) {
 	assert.Equal(t, trade2.Timestamp, fs.lastTime.Unix())
 }
 
+func TestRecoverTornWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs, err := NewFileStore(dir, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fName := fs.f.Name()
+
+	for i := 0; i < 10; i++ {
+		trade := &ticks.Trade{
+			Market:     "ataas.io",
+			Instrument: "TCFWAUD",
+			TradeID:    strconv.Itoa(i),
+			Amount:     float32(i),
+			Units:      1,
+			Timestamp:  time.Now().Unix() + int64(i),
+		}
+		if err := fs.Add(trade); err != nil {
+			t.Fatal(err)
+		}
+	}
+	validSize := fs.size
+	fs.Close()
+
+	//partial record
+	f, err := os.OpenFile(fName, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{20, 0, 1, 2, 3})
+	f.Close()
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := fs.Recovery()
+	if assert.NotNil(t, report) {
+		assert.Equal(t, 10, report.Records)
+		assert.Equal(t, validSize, report.TruncatedAt)
+		assert.Equal(t, int64(5), report.TruncatedBytes)
+	}
+	assert.Equal(t, validSize, fs.size)
+
+	trades, err := fs.GetAll("ataas.io", "TCFWAUD", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, trades, 10)
+
+	//appends continue after the recovered records
+	err = fs.Add(&ticks.Trade{Market: "ataas.io", Instrument: "TCFWAUD", TradeID: "10", Timestamp: time.Now().Unix() + 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.Close()
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	assert.Nil(t, fs.Recovery())
+
+	trades, err = fs.GetAll("ataas.io", "TCFWAUD", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, trades, 11)
+}
+
+func TestRecoverChecksumMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs, err := NewFileStore(dir, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fName := fs.f.Name()
+
+	var lastOffset int64
+	for i := 0; i < 3; i++ {
+		lastOffset = fs.size
+		err := fs.Add(&ticks.Trade{Market: "ataas.io", Instrument: "TCFWAUD", TradeID: strconv.Itoa(i), Timestamp: time.Now().Unix()})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	fs.Close()
+
+	//flip a bit in the value of the last record
+	b, err := ioutil.ReadFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(b)-1] ^= 0x01
+	if err := ioutil.WriteFile(fName, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err = NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	report := fs.Recovery()
+	if assert.NotNil(t, report) {
+		assert.Equal(t, 2, report.Records)
+		assert.Equal(t, lastOffset, report.TruncatedAt)
+		assert.True(t, errors.Is(report.Err, ErrRecordCorrupt))
+	}
+
+	corrupt, err := ioutil.ReadFile(fName + ".corrupt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, b[lastOffset:], corrupt)
+}
+
+func TestReadVersion1(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	trade := &ticks.Trade{Market: "ataas.io", Instrument: "TCFWAUD", TradeID: "1", Amount: 1.5, Units: 2, Timestamp: time.Now().Unix()}
+
+	val, err := msgpack.Marshal(trade)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fName := dir + "/" + fileName(time.Now())
+	if err := ioutil.WriteFile(fName, encodeRecord(1, uint64(trade.Timestamp), val), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFileStoreFromFile(fName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	assert.Nil(t, fs.Recovery())
+	assert.Equal(t, 1, fs.version)
+
+	trades, err := fs.GetAll("ataas.io", "TCFWAUD", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []*ticks.Trade{trade}, trades)
+}
+
 func BenchmarkAdd(b *testing.B) {
 	b.StopTimer()
 
@@ -175,6 +348,7 @@ func BenchmarkAdd(b *testing.B) {
 	fName := fs.f.Name()
 
 	defer os.Remove(fName)
+	defer os.Remove(indexFile(fName))
 
 	market := "ataas.io"
 	instrument := "TCFW/AUD"
@@ -213,6 +387,7 @@ func BenchmarkGet(b *testing.B) {
 	fName := fs.f.Name()
 
 	defer os.Remove(fName)
+	defer os.Remove(indexFile(fName))
 
 	market := "ataas.io"
 	instrument := "TCFW/AUD"
@@ -264,6 +439,7 @@ func benchmarkRandGet(t int64, b *testing.B) {
 	fName := fs.f.Name()
 
 	defer os.Remove(fName)
+	defer os.Remove(indexFile(fName))
 
 	market := "ataas.io"
 	instrument := "TCFW/AUD"
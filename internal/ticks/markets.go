@@ -0,0 +1,78 @@
+package ticks
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+	binance_client "pm.tcfw.com.au/source/ataas/internal/exchanges/binance-client"
+	crypto_com_client "pm.tcfw.com.au/source/ataas/internal/exchanges/crypto-com-client"
+)
+
+func init() {
+	viper.SetDefault("collector.binance.quotes", []string{"AUD"})
+}
+
+//newMarkets creates the exchange clients used for collection and market metadata
+func newMarkets() map[string]exchanges.Adapter {
+	bc := binance_client.NewClient(viper.GetString("collector.binance.key"), viper.GetString("collector.binance.secret"))
+	bc.SetTradeQuotes(viper.GetStringSlice("collector.binance.quotes")...)
+
+	cc := crypto_com_client.NewClient(viper.GetString("collector.crypto_com.key"), viper.GetString("collector.crypto_com.secret"))
+
+	return map[string]exchanges.Adapter{
+		"binance.com": bc,
+		"crypto.com":  cc,
+	}
+}
+
+//Markets lists the symbols and trading rules of a market
+func (s *Server) Markets(ctx context.Context, req *ticks.MarketsRequest) (*ticks.MarketsResponse, error) {
+	if req.Market == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	ex, ok := s.markets[exchanges.PriceMarket(req.Market)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown market")
+	}
+
+	symbols, err := ex.Symbols()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch symbols: %s", err)
+	}
+
+	resp := &ticks.MarketsResponse{}
+
+	for _, sym := range symbols {
+		if req.Quote != "" && !strings.EqualFold(sym.Quote, req.Quote) {
+			continue
+		}
+
+		resp.Symbols = append(resp.Symbols, &ticks.Symbol{
+			Market:           req.Market,
+			Instrument:       sym.Instrument,
+			Base:             sym.Base,
+			Quote:            sym.Quote,
+			Status:           sym.Status,
+			PriceDecimals:    int32(sym.PriceDecimals),
+			QuantityDecimals: int32(sym.QuantityDecimals),
+			TickSize:         sym.TickSize,
+			StepSize:         sym.StepSize,
+			MinQuantity:      sym.MinQuantity,
+			MinNotional:      sym.MinNotional,
+		})
+	}
+
+	sort.Slice(resp.Symbols, func(i, j int) bool {
+		return resp.Symbols[i].Instrument < resp.Symbols[j].Instrument
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+package ticks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
+)
+
+func init() {
+	viper.SetDefault("collector.retention.raw", "168h")
+	viper.SetDefault("collector.retention.compacted", "0")
+	viper.SetDefault("collector.retention.interval", "1h")
+}
+
+//RetentionPolicy how long trades of an instrument are kept. A zero compacted
+//retention keeps compacted trades indefinitely
+type RetentionPolicy struct {
+	Raw       time.Duration
+	Compacted time.Duration
+}
+
+//retentionPolicies per instrument retention with fallbacks to the market wide
+//policy of markets collecting all instruments, then the default policy
+type retentionPolicies struct {
+	def         RetentionPolicy
+	markets     map[string]RetentionPolicy
+	instruments map[string]RetentionPolicy
+	mu          sync.RWMutex
+}
+
+func newRetentionPolicies(def RetentionPolicy) *retentionPolicies {
+	return &retentionPolicies{
+		def:         def,
+		markets:     map[string]RetentionPolicy{},
+		instruments: map[string]RetentionPolicy{},
+	}
+}
+
+//set replaces the policies from the collected instruments
+func (rp *retentionPolicies) set(collected []*ticks.CollectedInstrument) {
+	markets := map[string]RetentionPolicy{}
+	instruments := map[string]RetentionPolicy{}
+
+	for _, ci := range collected {
+		policy := rp.def
+
+		if d, err := time.ParseDuration(ci.RawRetention); err == nil {
+			policy.Raw = d
+		}
+		if d, err := time.ParseDuration(ci.CompactedRetention); err == nil {
+			policy.Compacted = d
+		}
+
+		if ci.Instrument == exchanges.AllInstruments {
+			markets[ci.Market] = policy
+		} else {
+			instruments[ci.Market+"/"+ci.Instrument] = policy
+		}
+	}
+
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	rp.markets = markets
+	rp.instruments = instruments
+}
+
+func (rp *retentionPolicies) policy(market, instrument string) RetentionPolicy {
+	rp.mu.RLock()
+	defer rp.mu.RUnlock()
+
+	if p, ok := rp.instruments[market+"/"+instrument]; ok {
+		return p
+	}
+
+	if p, ok := rp.markets[market]; ok {
+		return p
+	}
+
+	return rp.def
+}
+
+//maxRaw the longest raw retention of any policy. Shared raw files hold trades of all
+//instruments so can only be removed once past every instruments raw retention
+func (rp *retentionPolicies) maxRaw() time.Duration {
+	rp.mu.RLock()
+	defer rp.mu.RUnlock()
+
+	max := rp.def.Raw
+	for _, p := range rp.markets {
+		if p.Raw > max {
+			max = p.Raw
+		}
+	}
+	for _, p := range rp.instruments {
+		if p.Raw > max {
+			max = p.Raw
+		}
+	}
+
+	return max
+}
+
+//applyRetention loads the retention policies of the collected instruments into the library
+func (s *Server) applyRetention(ctx context.Context) error {
+	resp, err := s.Collected(ctx, &ticks.CollectedRequest{})
+	if err != nil {
+		return err
+	}
+
+	s.library.retention.set(resp.Instruments)
+
+	return nil
+}
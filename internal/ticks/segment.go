@@ -0,0 +1,307 @@
+package ticks
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+const (
+	segmentMagic   = "ATSG"
+	segmentVersion = 1
+
+	segmentDayLayout = "20060102"
+	segmentExt       = ".seg"
+)
+
+var (
+	ErrSegmentCorrupt = errors.New("segment corrupt")
+)
+
+//encodeSegment encodes the trades of a single instrument in columns, ordered by time,
+//and compresses the result. Timestamps are delta encoded and prices and units are
+//XOR'd with the previous value so repeated values compress well. Trade IDs are not kept
+func encodeSegment(trades []*ticks.Trade) ([]byte, error) {
+	sorted := make([]*ticks.Trade, len(trades))
+	copy(sorted, trades)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp < sorted[j].Timestamp })
+
+	cols := bytes.NewBuffer(nil)
+	vbuf := make([]byte, binary.MaxVarintLen64)
+
+	putVarint := func(v int64) {
+		n := binary.PutVarint(vbuf, v)
+		cols.Write(vbuf[:n])
+	}
+	putUvarint := func(v uint64) {
+		n := binary.PutUvarint(vbuf, v)
+		cols.Write(vbuf[:n])
+	}
+
+	var prevTs int64
+	for _, t := range sorted {
+		putVarint(t.Timestamp - prevTs)
+		prevTs = t.Timestamp
+	}
+
+	var prev uint32
+	for _, t := range sorted {
+		bits := math.Float32bits(t.Amount)
+		putUvarint(uint64(bits ^ prev))
+		prev = bits
+	}
+
+	prev = 0
+	for _, t := range sorted {
+		bits := math.Float32bits(t.Units)
+		putUvarint(uint64(bits ^ prev))
+		prev = bits
+	}
+
+	dirs := make([]byte, (len(sorted)+7)/8)
+	for i, t := range sorted {
+		if t.Direction == ticks.TradeDirection_SELL {
+			dirs[i/8] |= 1 << (i % 8)
+		}
+	}
+	cols.Write(dirs)
+
+	out := bytes.NewBuffer(nil)
+	out.WriteString(segmentMagic)
+	out.WriteByte(segmentVersion)
+
+	count := make([]byte, 4)
+	binary.LittleEndian.PutUint32(count, uint32(len(sorted)))
+	out.Write(count)
+
+	zw := gzip.NewWriter(out)
+	if _, err := zw.Write(cols.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+//decodeSegment decodes the trades of a segment. Market and instrument are not stored
+//in the segment so are set by the caller
+func decodeSegment(b []byte) ([]*ticks.Trade, error) {
+	if len(b) < 9 || string(b[:4]) != segmentMagic {
+		return nil, ErrSegmentCorrupt
+	}
+
+	if b[4] != segmentVersion {
+		return nil, fmt.Errorf("unsupported segment version %d", b[4])
+	}
+
+	count := int(binary.LittleEndian.Uint32(b[5:9]))
+
+	zr, err := gzip.NewReader(bytes.NewReader(b[9:]))
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	trades := make([]*ticks.Trade, count)
+	for i := range trades {
+		trades[i] = &ticks.Trade{}
+	}
+
+	off := 0
+	varint := func() (int64, error) {
+		v, n := binary.Varint(cols[off:])
+		if n <= 0 {
+			return 0, ErrSegmentCorrupt
+		}
+		off += n
+		return v, nil
+	}
+	uvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(cols[off:])
+		if n <= 0 {
+			return 0, ErrSegmentCorrupt
+		}
+		off += n
+		return v, nil
+	}
+
+	var ts int64
+	for _, t := range trades {
+		d, err := varint()
+		if err != nil {
+			return nil, err
+		}
+		ts += d
+		t.Timestamp = ts
+	}
+
+	var prev uint32
+	for _, t := range trades {
+		x, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		prev ^= uint32(x)
+		t.Amount = math.Float32frombits(prev)
+	}
+
+	prev = 0
+	for _, t := range trades {
+		x, err := uvarint()
+		if err != nil {
+			return nil, err
+		}
+		prev ^= uint32(x)
+		t.Units = math.Float32frombits(prev)
+	}
+
+	if len(cols[off:]) < (count+7)/8 {
+		return nil, ErrSegmentCorrupt
+	}
+
+	for i, t := range trades {
+		if cols[off+i/8]&(1<<(i%8)) != 0 {
+			t.Direction = ticks.TradeDirection_SELL
+		}
+	}
+
+	return trades, nil
+}
+
+//segmentStore holds compacted per instrument, per day segments of trades
+type segmentStore struct {
+	dir string
+}
+
+func newSegmentStore(dir string) (*segmentStore, error) {
+	if err := os.MkdirAll(filepath.Join(dir, "compacted"), 0755); err != nil {
+		return nil, err
+	}
+
+	return &segmentStore{dir: dir}, nil
+}
+
+func (ss *segmentStore) path(market, instrument string, day time.Time) string {
+	return filepath.Join(ss.dir, market, instrument, day.UTC().Format(segmentDayLayout)+segmentExt)
+}
+
+//write atomically writes the segment of an instrument for a day
+func (ss *segmentStore) write(market, instrument string, day time.Time, trades []*ticks.Trade) error {
+	b, err := encodeSegment(trades)
+	if err != nil {
+		return err
+	}
+
+	p := ss.path(market, instrument, day)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+
+	tmp := p + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, p)
+}
+
+//read reads the segment of an instrument for a day, nil if there is no segment
+func (ss *segmentStore) read(market, instrument string, day time.Time) ([]*ticks.Trade, error) {
+	b, err := ioutil.ReadFile(ss.path(market, instrument, day))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	trades, err := decodeSegment(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read segment %s/%s/%s: %s", market, instrument, day.Format(segmentDayLayout), err)
+	}
+
+	for _, t := range trades {
+		t.Market = market
+		t.Instrument = instrument
+	}
+
+	return trades, nil
+}
+
+func (ss *segmentStore) markerPath(day time.Time) string {
+	return filepath.Join(ss.dir, "compacted", day.UTC().Format(segmentDayLayout))
+}
+
+//compacted checks if all instruments of the day have been compacted
+func (ss *segmentStore) compacted(day time.Time) bool {
+	_, err := os.Stat(ss.markerPath(day))
+	return err == nil
+}
+
+func (ss *segmentStore) markCompacted(day time.Time) error {
+	return ioutil.WriteFile(ss.markerPath(day), nil, 0644)
+}
+
+//gc removes segments older than the compacted retention of each instrument
+func (ss *segmentStore) gc(now time.Time, policies *retentionPolicies) error {
+	markets, err := ioutil.ReadDir(ss.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, market := range markets {
+		if !market.IsDir() || market.Name() == "compacted" {
+			continue
+		}
+
+		instruments, err := ioutil.ReadDir(filepath.Join(ss.dir, market.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, instrument := range instruments {
+			policy := policies.policy(market.Name(), instrument.Name())
+			if policy.Compacted <= 0 {
+				continue
+			}
+
+			cutoff := now.Add(-policy.Compacted)
+
+			segments, err := ioutil.ReadDir(filepath.Join(ss.dir, market.Name(), instrument.Name()))
+			if err != nil {
+				return err
+			}
+
+			for _, seg := range segments {
+				day, err := time.Parse(segmentDayLayout, strings.TrimSuffix(seg.Name(), segmentExt))
+				if err != nil {
+					continue
+				}
+
+				if day.Add(24 * time.Hour).Before(cutoff) {
+					if err := os.Remove(filepath.Join(ss.dir, market.Name(), instrument.Name(), seg.Name())); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
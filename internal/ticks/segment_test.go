@@ -0,0 +1,108 @@
+package ticks
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+func TestSegmentEncoding(t *testing.T) {
+	start := time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC).UnixNano() / int64(time.Millisecond)
+
+	trades := []*ticks.Trade{}
+	for i := 0; i < 1000; i++ {
+		dir := ticks.TradeDirection_BUY
+		if i%3 == 0 {
+			dir = ticks.TradeDirection_SELL
+		}
+
+		trades = append(trades, &ticks.Trade{
+			Amount:    1234.5 + float32(i%7),
+			Units:     0.001 * float32(i%11+1),
+			Timestamp: start + int64(i*250),
+			Direction: dir,
+		})
+	}
+
+	//out of order trades are sorted
+	trades[10], trades[20] = trades[20], trades[10]
+
+	b, err := encodeSegment(trades)
+	require.NoError(t, err)
+	assert.Less(t, len(b), len(trades)*8)
+
+	decoded, err := decodeSegment(b)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(trades))
+
+	trades[10], trades[20] = trades[20], trades[10]
+	for i, trade := range decoded {
+		assert.Equal(t, trades[i].Amount, trade.Amount)
+		assert.Equal(t, trades[i].Units, trade.Units)
+		assert.Equal(t, trades[i].Timestamp, trade.Timestamp)
+		assert.Equal(t, trades[i].Direction, trade.Direction)
+	}
+
+	_, err = decodeSegment(b[:len(b)/2])
+	assert.Error(t, err)
+}
+
+func TestLibraryCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "library")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	lib, err := NewLibrary(dir, LibraryOptions{Retention: RetentionPolicy{Raw: 24 * time.Hour}}, logrus.New())
+	require.NoError(t, err)
+
+	now := time.Now()
+	old := now.Add(-3 * 24 * time.Hour).UTC().Truncate(24 * time.Hour).Add(time.Hour)
+
+	for i := 0; i < 100; i++ {
+		instrument := "BTCAUD"
+		if i%2 == 0 {
+			instrument = "ETHAUD"
+		}
+
+		require.NoError(t, lib.Add(&ticks.Trade{
+			Market:     "binance.com",
+			Instrument: instrument,
+			TradeID:    "1",
+			Amount:     float32(i),
+			Units:      1,
+			Timestamp:  old.Add(time.Duration(i)*time.Minute).UnixNano() / int64(time.Millisecond),
+		}))
+	}
+
+	//rotates to a new raw file for the new day
+	require.NoError(t, lib.Add(&ticks.Trade{
+		Market:     "binance.com",
+		Instrument: "BTCAUD",
+		Amount:     100,
+		Units:      1,
+		Timestamp:  now.UnixNano() / int64(time.Millisecond),
+	}))
+	require.Len(t, lib.stores(), 3)
+
+	require.NoError(t, lib.compact(now))
+	assert.True(t, lib.segments.compacted(old.Truncate(24*time.Hour)))
+
+	require.NoError(t, lib.gc(now))
+	require.Len(t, lib.stores(), 1)
+
+	trades, err := lib.GetSince("binance.com", "BTCAUD", old.Add(-time.Minute), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, trades, 51)
+	assert.Equal(t, float32(1), trades[0].Amount)
+	assert.Equal(t, float32(100), trades[50].Amount)
+
+	trades, err = lib.GetSince("binance.com", "ETHAUD", old.Add(-time.Minute), old.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, trades, 30)
+}
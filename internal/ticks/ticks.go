@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
 	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/exchanges"
 	migrate "pm.tcfw.com.au/source/ataas/internal/ticks/db"
 )
 
@@ -32,7 +34,23 @@ func NewServer(ctx context.Context) (*Server, error) {
 	}
 	log.Infof("Loading trades library: %s", libDir)
 
-	lib, err := NewLibrary(libDir, log)
+	syncPolicy, err := ParseSyncPolicy(viper.GetString("collector.library_sync"))
+	if err != nil {
+		return nil, err
+	}
+
+	lib, err := NewLibrary(libDir, LibraryOptions{
+		Retention: RetentionPolicy{
+			Raw:       viper.GetDuration("collector.retention.raw"),
+			Compacted: viper.GetDuration("collector.retention.compacted"),
+		},
+		Sync: syncPolicy,
+	}, log)
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := NewDepthLibrary(filepath.Join(libDir, "depth"), viper.GetDuration("collector.depth.interval"), log)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +58,9 @@ func NewServer(ctx context.Context) (*Server, error) {
 	s := &Server{
 		log:     log,
 		library: lib,
+		depth:   depth,
+		candles: NewCandleStore(log),
+		markets: newMarkets(),
 	}
 
 	err = s.Migrate(ctx)
@@ -56,6 +77,10 @@ type Server struct {
 	log *logrus.Logger
 
 	library *TradeLibrary
+	depth   *DepthLibrary
+	candles *CandleStore
+
+	markets map[string]exchanges.Adapter
 }
 
 func (s *Server) Migrate(ctx context.Context) error {
@@ -69,6 +94,10 @@ func (s *Server) Migrate(ctx context.Context) error {
 }
 
 func (s *Server) Close() error {
+	if err := s.depth.Close(); err != nil {
+		return err
+	}
+
 	return s.library.Close()
 }
 
@@ -78,29 +107,11 @@ func (s *Server) TradesRange(ctx context.Context, req *ticks.RangeRequest) (*tic
 		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
 	}
 
-	tsUntil := time.Now()
-
-	tsFrom, wasDuration, err := parseTime(req.Since)
+	tsFrom, tsUntil, err := parseRange(req.Since, req.Until)
 	if err != nil {
 		return nil, err
 	}
 
-	if req.Until != "" {
-		t, _, err := parseTime(req.Until)
-		if err != nil {
-			return nil, err
-		}
-		tsUntil = t
-	}
-
-	if wasDuration && req.Until != "" {
-		ts, err := time.ParseDuration(req.Since)
-		if err != nil {
-			return nil, err
-		}
-		tsFrom = tsUntil.Add(-ts)
-	}
-
 	trades, err := s.library.GetSince(req.Market, req.Instrument, tsFrom, tsUntil)
 	if err != nil {
 		return nil, err
@@ -149,29 +160,11 @@ func (s *Server) TradesRangeStream(req *ticks.RangeRequest, stream ticks.History
 		return status.Error(codes.InvalidArgument, "missing required arguments")
 	}
 
-	tsUntil := time.Now()
-
-	tsFrom, wasDuration, err := parseTime(req.Since)
+	tsFrom, tsUntil, err := parseRange(req.Since, req.Until)
 	if err != nil {
 		return err
 	}
 
-	if req.Until != "" {
-		t, _, err := parseTime(req.Until)
-		if err != nil {
-			return err
-		}
-		tsUntil = t
-	}
-
-	if wasDuration && req.Until != "" {
-		ts, err := time.ParseDuration(req.Since)
-		if err != nil {
-			return err
-		}
-		tsFrom = tsUntil.Add(-ts)
-	}
-
 	tradesCh, err := s.library.GetSinceStream(req.Market, req.Instrument, tsFrom, tsUntil)
 	if err != nil {
 		return err
@@ -187,7 +180,10 @@ func (s *Server) TradesRangeStream(req *ticks.RangeRequest, stream ticks.History
 	return nil
 }
 
-//Candles provides a means of sumarising trades into OHLCV formats for a particular instrument
+//Candles provides a means of sumarising trades into OHLCV formats for a particular instrument.
+//Intervals which are a multiple of a stored resolution are served from the candle store,
+//backfilling any leading range the store doesn't cover from the trades, otherwise candles
+//are aggregated from the raw trades
 func (s *Server) Candles(ctx context.Context, req *ticks.CandlesRequest) (*ticks.CandlesResponse, error) {
 	if req.Instrument == "" || req.Market == "" || req.Interval == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
@@ -198,9 +194,52 @@ func (s *Server) Candles(ctx context.Context, req *ticks.CandlesRequest) (*ticks
 		return nil, err
 	}
 
-	startingPoint := time.Now().Add(-time.Duration(req.Depth) * interval).Round(interval)
+	now := time.Now()
+	startingPoint := now.Add(-time.Duration(req.Depth) * interval).Round(interval)
 
-	trades, err := s.library.GetSinceStream(req.Market, req.Instrument, startingPoint, time.Now())
+	res := storedResolution(interval)
+	if res == 0 {
+		data, err := s.tradeCandles(req.Market, req.Instrument, interval, startingPoint, now)
+		if err != nil {
+			return nil, err
+		}
+		return &ticks.CandlesResponse{Data: data}, nil
+	}
+
+	candles, err := s.candles.Get(ctx, req.Market, req.Instrument, res, startingPoint, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candles) == 0 || candles[0].Timestamp > startingPoint.Unix() {
+		//the store doesn't cover the start of the period, so backfill it from the trades
+		until := now
+		if len(candles) > 0 {
+			until = time.Unix(candles[0].Timestamp, 0)
+		}
+
+		backfill, err := s.tradeCandles(req.Market, req.Instrument, res, startingPoint, until)
+		if err != nil {
+			return nil, err
+		}
+
+		for len(backfill) > 0 && len(candles) > 0 && backfill[len(backfill)-1].Timestamp >= candles[0].Timestamp {
+			backfill = backfill[:len(backfill)-1]
+		}
+
+		candles = append(backfill, candles...)
+	}
+
+	if res != interval {
+		candles = aggregateCandles(candles, interval)
+	}
+
+	return &ticks.CandlesResponse{Data: candles}, nil
+}
+
+//tradeCandles aggregates the raw trades between the starting point and until into candles
+func (s *Server) tradeCandles(market, instrument string, interval time.Duration, startingPoint, until time.Time) ([]*ticks.OHLCV, error) {
+	trades, err := s.library.GetSinceStream(market, instrument, startingPoint, until)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +277,36 @@ func (s *Server) Candles(ctx context.Context, req *ticks.CandlesRequest) (*ticks
 		current.Volume += trade.Units
 	}
 
-	return &ticks.CandlesResponse{Data: data}, nil
+	return data, nil
+}
+
+//parseRange parses a since and optional until time. A since duration is relative
+//to until when until is given, otherwise to now
+func parseRange(since, until string) (time.Time, time.Time, error) {
+	tsUntil := time.Now()
+
+	tsFrom, wasDuration, err := parseTime(since)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if until != "" {
+		t, _, err := parseTime(until)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		tsUntil = t
+	}
+
+	if wasDuration && until != "" {
+		ts, err := time.ParseDuration(since)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		tsFrom = tsUntil.Add(-ts)
+	}
+
+	return tsFrom, tsUntil, nil
 }
 
 func parseTime(ts string) (time.Time, bool, error) {
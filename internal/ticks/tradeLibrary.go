@@ -3,7 +3,9 @@ package ticks
 import (
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -15,98 +17,408 @@ import (
 
 const (
 	maxFileStoreSize = 50 << 20 //50MB
+
+	//partitionDir directory of the per instrument file stores
+	partitionDir = "raw"
+
+	oneDay = 24 * time.Hour
+
+	//compactionDelay time after the end of a day before it is compacted to allow for
+	//late trades
+	compactionDelay = 1 * time.Hour
 )
 
+//TradeLibrary stores raw trades in file stores partitioned by market and instrument.
+//Complete days are compacted into per instrument segments, which are read once the raw
+//trades have been removed. File stores written before partitioning hold trades of all
+//instruments and are read alongside the partitions until removed
 type TradeLibrary struct {
-	libDir string
-	active *FileStore
-	refs   map[string]*FileStore
+	libDir     string
+	partitions map[instrumentKey]*partition
+	shared     map[string]*FileStore
+
+	segments  *segmentStore
+	retention *retentionPolicies
+	sync      SyncPolicy
 
 	log *logrus.Logger
 	mu  sync.Mutex
 }
 
-func NewLibrary(dir string, log *logrus.Logger) (*TradeLibrary, error) {
+//partition the file stores of a single instrument
+type partition struct {
+	dir    string
+	active *FileStore
+	stores map[string]*FileStore
+}
+
+//LibraryOptions configures the retention and durability of a trade library
+type LibraryOptions struct {
+	Retention RetentionPolicy
+	Sync      SyncPolicy
+}
+
+func NewLibrary(dir string, opts LibraryOptions, log *logrus.Logger) (*TradeLibrary, error) {
 	if !strings.HasSuffix(dir, "/") {
 		dir += "/"
 	}
 
+	segments, err := newSegmentStore(dir + "segments")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir+partitionDir, 0755); err != nil {
+		return nil, err
+	}
+
 	l := &TradeLibrary{
-		libDir: dir,
-		refs:   map[string]*FileStore{},
-		log:    log,
+		libDir:     dir,
+		partitions: map[instrumentKey]*partition{},
+		shared:     map[string]*FileStore{},
+		segments:   segments,
+		retention:  newRetentionPolicies(opts.Retention),
+		sync:       opts.Sync,
+		log:        log,
 	}
 
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	err := l.findFileStores()
+	err = l.findFileStores()
 	if err != nil {
 		return nil, err
 	}
 
-	// go l.gc()
-
 	return l, nil
 }
 
-func (tl *TradeLibrary) gc() {
-	t := time.NewTicker(5 * time.Minute)
+//maintain periodically compacts complete days and removes trades past retention
+func (tl *TradeLibrary) maintain(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		now := time.Now()
+
+		if err := tl.compact(now); err != nil {
+			tl.log.Errorf("failed to compact trades: %s", err)
+		}
+
+		if err := tl.gc(now); err != nil {
+			tl.log.Errorf("failed to remove expired trades: %s", err)
+		}
+
+		<-t.C
+	}
+}
+
+//syncActive periodically flushes the active file stores when using the interval sync policy
+func (tl *TradeLibrary) syncActive(interval time.Duration) {
+	if tl.sync != SyncInterval {
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
 
 	for range t.C {
 		tl.mu.Lock()
+		active := make([]*FileStore, 0, len(tl.partitions))
+		for _, p := range tl.partitions {
+			if p.active != nil {
+				active = append(active, p.active)
+			}
+		}
+		tl.mu.Unlock()
+
+		for _, fs := range active {
+			if err := fs.Sync(); err != nil {
+				tl.log.Errorf("failed to sync trades: %s", err)
+			}
+		}
+	}
+}
+
+//stores provides the file stores containing trades, ordered by start time
+func (tl *TradeLibrary) stores() FileStoreList {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	refs := []map[string]*FileStore{tl.shared}
+	for _, p := range tl.partitions {
+		refs = append(refs, p.stores)
+	}
+
+	return sortedStores(refs...)
+}
 
-		t := time.Now().Add(-24 * time.Hour)
+//instrumentStores provides the file stores which may contain trades of the instrument,
+//ordered by start time
+func (tl *TradeLibrary) instrumentStores(market, instrument string) FileStoreList {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
 
-		for f, fileStore := range tl.refs {
-			if fileStore.lastTime.Before(t) {
-				fileStore.f.Close()
-				if err := os.Remove(fileStore.f.Name()); err != nil {
-					tl.log.Printf("failed to delete file %s: %s", f, err)
-					continue
-				}
+	refs := []map[string]*FileStore{tl.shared}
+	if p, ok := tl.partitions[instrumentKey{market, instrument}]; ok {
+		refs = append(refs, p.stores)
+	}
 
-				delete(tl.refs, f)
+	return sortedStores(refs...)
+}
+
+func sortedStores(refs ...map[string]*FileStore) FileStoreList {
+	fsList := FileStoreList{}
+	for _, stores := range refs {
+		for _, ref := range stores {
+			if ref.startTime.IsZero() {
+				continue
 			}
+			fsList = append(fsList, ref)
 		}
+	}
 
-		tl.mu.Unlock()
+	sort.Sort(fsList)
+
+	return fsList
+}
+
+//storeDays the days covered by a file store
+func storeDays(fs *FileStore) []time.Time {
+	days := []time.Time{}
+	for d := fs.startTime.UTC().Truncate(oneDay); !d.After(fs.lastTime); d = d.Add(oneDay) {
+		days = append(days, d)
+	}
+	return days
+}
+
+//compact compacts each complete day of raw trades into segments
+func (tl *TradeLibrary) compact(now time.Time) error {
+	cutoff := now.UTC().Add(-compactionDelay).Truncate(oneDay)
+	stores := tl.stores()
+
+	days := map[time.Time]bool{}
+	for _, fs := range stores {
+		for _, d := range storeDays(fs) {
+			if d.Before(cutoff) {
+				days[d] = true
+			}
+		}
+	}
+
+	ordered := make([]time.Time, 0, len(days))
+	for d := range days {
+		ordered = append(ordered, d)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Before(ordered[j]) })
+
+	for _, d := range ordered {
+		if tl.segments.compacted(d) {
+			continue
+		}
+
+		if err := tl.compactDay(d, stores); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//compactDay writes a segment for each instrument traded during the day
+func (tl *TradeLibrary) compactDay(d time.Time, stores FileStoreList) error {
+	end := d.Add(oneDay)
+	byInstrument := map[instrumentKey][]*ticks.Trade{}
+
+	for _, fs := range stores {
+		if fs.lastTime.Before(d) || !fs.startTime.Before(end) {
+			continue
+		}
+
+		ch, err := fs.GetAllStream(uint64(d.Unix()), uint64(end.Unix()-1))
+		if err != nil {
+			return err
+		}
+
+		for trade := range ch {
+			key := instrumentKey{trade.Market, trade.Instrument}
+			byInstrument[key] = append(byInstrument[key], trade)
+		}
+	}
+
+	for key, trades := range byInstrument {
+		if err := tl.segments.write(key.market, key.instrument, d, trades); err != nil {
+			return err
+		}
+	}
+
+	tl.log.Infof("Compacted %d instruments for %s", len(byInstrument), d.Format("2006-01-02"))
+
+	return tl.segments.markCompacted(d)
+}
+
+//gc removes raw file stores past the raw retention of their instrument once compacted,
+//and segments past the compacted retention of each instrument
+func (tl *TradeLibrary) gc(now time.Time) error {
+	tl.mu.Lock()
+	tl.removeExpired(tl.shared, nil, now.Add(-tl.retention.maxRaw()))
+
+	for key, p := range tl.partitions {
+		//instruments without trades since the end of the day would otherwise keep
+		//their file open and never expire it
+		if p.active != nil && p.active.lastTime.UTC().Truncate(oneDay).Before(now.UTC().Truncate(oneDay)) {
+			tl.seal(p)
+		}
+
+		policy := tl.retention.policy(key.market, key.instrument)
+		tl.removeExpired(p.stores, p.active, now.Add(-policy.Raw))
+
+		if len(p.stores) == 0 {
+			delete(tl.partitions, key)
+		}
+	}
+	tl.mu.Unlock()
+
+	return tl.segments.gc(now, tl.retention)
+}
+
+//removeExpired removes the inactive file stores last written before the cutoff once
+//every day of the file store has been compacted
+func (tl *TradeLibrary) removeExpired(stores map[string]*FileStore, active *FileStore, cutoff time.Time) {
+	for name, fileStore := range stores {
+		if fileStore == active || fileStore.startTime.IsZero() || !fileStore.lastTime.Before(cutoff) {
+			continue
+		}
+
+		compacted := true
+		for _, d := range storeDays(fileStore) {
+			if !tl.segments.compacted(d) {
+				compacted = false
+				break
+			}
+		}
+		if !compacted {
+			continue
+		}
+
+		fileStore.Close()
+		if err := os.Remove(name); err != nil {
+			tl.log.Printf("failed to delete file %s: %s", name, err)
+			continue
+		}
+		if err := os.Remove(indexFile(name)); err != nil && !os.IsNotExist(err) {
+			tl.log.Printf("failed to delete index %s: %s", name, err)
+		}
+
+		delete(stores, name)
 	}
 }
 
+//findFileStores loads the shared file stores in the library directory and the file
+//stores of each partition
 func (tl *TradeLibrary) findFileStores() error {
-	files, err := ioutil.ReadDir(tl.libDir)
+	shared, err := tl.loadFileStores(tl.libDir)
 	if err != nil {
 		return err
 	}
+	tl.shared = shared
 
-	for _, file := range files {
-		if strings.HasPrefix(file.Name(), fileStorePrefix) {
-			abs := fmt.Sprintf("%s%s", tl.libDir, file.Name())
-			fs, err := NewFileStoreFromFile(abs)
+	n := len(shared)
+
+	markets, err := ioutil.ReadDir(tl.libDir + partitionDir)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range markets {
+		if !m.IsDir() {
+			continue
+		}
+
+		instruments, err := ioutil.ReadDir(filepath.Join(tl.libDir, partitionDir, m.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, i := range instruments {
+			market, err := url.PathUnescape(m.Name())
+			if err != nil || !i.IsDir() {
+				continue
+			}
+			instrument, err := url.PathUnescape(i.Name())
+			if err != nil {
+				continue
+			}
+
+			dir := filepath.Join(tl.libDir, partitionDir, m.Name(), i.Name())
+			stores, err := tl.loadFileStores(dir)
 			if err != nil {
 				return err
 			}
 
-			tl.refs[file.Name()] = fs
+			tl.partitions[instrumentKey{market, instrument}] = &partition{dir: dir, stores: stores}
+			n += len(stores)
 		}
 	}
 
-	tl.log.Infof("Loaded %d file stores", len(tl.refs))
+	tl.log.Infof("Loaded %d file stores (%d shared) in %d partitions", n, len(shared), len(tl.partitions))
 
 	return nil
 }
 
+//loadFileStores opens the file stores of a directory, sealing each as only new file
+//stores are written to
+func (tl *TradeLibrary) loadFileStores(dir string) (map[string]*FileStore, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	stores := map[string]*FileStore{}
+
+	for _, file := range files {
+		//skip indexes and .corrupt files kept from recovery
+		if file.IsDir() || !strings.HasPrefix(file.Name(), fileStorePrefix) || strings.Contains(file.Name(), ".") {
+			continue
+		}
+
+		abs := filepath.Join(dir, file.Name())
+		fs, err := NewFileStoreFromFile(abs)
+		if err != nil {
+			return nil, err
+		}
+
+		if r := fs.Recovery(); r != nil {
+			tl.log.Warnf("Recovered file store: %s", r)
+		}
+
+		if err := fs.Seal(); err != nil {
+			return nil, err
+		}
+
+		stores[abs] = fs
+	}
+
+	return stores, nil
+}
+
 func (tl *TradeLibrary) Close() error {
 	tl.mu.Lock()
 
-	for _, f := range tl.refs {
-		err := f.Close()
-		if err != nil {
+	for _, f := range tl.shared {
+		if err := f.Close(); err != nil {
 			return err
 		}
 	}
 
+	for _, p := range tl.partitions {
+		for _, f := range p.stores {
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -120,38 +432,76 @@ func (tl *TradeLibrary) AddFromCh(trades <-chan *ticks.Trade) {
 }
 
 func (tl *TradeLibrary) Add(trade *ticks.Trade) error {
-	if tl.active == nil {
-		tl.mu.Lock()
+	tradeTs := trade.Timestamp
+	if tradeTs > 9999999999 {
+		tradeTs = tradeTs / 1000
+	}
+
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	p, err := tl.partitionOf(trade.Market, trade.Instrument)
+	if err != nil {
+		return err
+	}
+
+	if p.active != nil && !p.active.lastTime.IsZero() &&
+		time.Unix(tradeTs, 0).UTC().Truncate(oneDay).After(p.active.lastTime.UTC().Truncate(oneDay)) {
+		//Start a new file each day so whole files can be removed once compacted
+		tl.seal(p)
+	}
 
-		f, err := NewFileStore(tl.libDir, time.Unix(trade.Timestamp, 0))
+	if p.active == nil {
+		f, err := NewFileStore(p.dir, time.Unix(tradeTs, 0))
 		if err != nil {
-			tl.mu.Unlock()
 			return err
 		}
 
-		fName := f.f.Name()
-		tl.refs[fName] = f
-		tl.active = f
+		f.SetSyncPolicy(tl.sync)
 
-		tl.mu.Unlock()
+		p.stores[f.name] = f
+		p.active = f
 	}
 
-	err := tl.active.Add(trade)
+	err = p.active.Add(trade)
 	if err != nil {
 		return err
 	}
 
-	if tl.active.size >= maxFileStoreSize {
-		//Mark as no longer active to create a new file on next add
-		tl.mu.Lock()
-		defer tl.mu.Unlock()
-		tl.active.f.Sync()
-		tl.active = nil
+	if p.active.size >= maxFileStoreSize {
+		//Seal to create a new file on next add
+		tl.seal(p)
 	}
 
 	return nil
 }
 
+//partitionOf gets or creates the partition of an instrument
+func (tl *TradeLibrary) partitionOf(market, instrument string) (*partition, error) {
+	key := instrumentKey{market, instrument}
+	if p, ok := tl.partitions[key]; ok {
+		return p, nil
+	}
+
+	dir := filepath.Join(tl.libDir, partitionDir, url.PathEscape(market), url.PathEscape(instrument))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	p := &partition{dir: dir, stores: map[string]*FileStore{}}
+	tl.partitions[key] = p
+
+	return p, nil
+}
+
+//seal seals the active file store of the partition so the next trade starts a new file
+func (tl *TradeLibrary) seal(p *partition) {
+	if err := p.active.Seal(); err != nil {
+		tl.log.Errorf("failed to seal file store: %s", err)
+	}
+	p.active = nil
+}
+
 type TradeList []*ticks.Trade
 
 func (tl TradeList) Len() int           { return len(tl) }
@@ -169,37 +519,25 @@ func (tl *TradeLibrary) GetSince(market, instrument string, since, until time.Ti
 		return nil, fmt.Errorf("since must not be zero")
 	}
 
-	trades := make(TradeList, 0, 1000)
-
-	fsList := FileStoreList{}
-
-	for _, ref := range tl.refs {
-		if since.After(ref.lastTime) {
-			continue
-		}
-
-		fsList = append(fsList, ref)
+	ch, err := tl.GetSinceStream(market, instrument, since, until)
+	if err != nil {
+		return nil, err
 	}
 
-	sort.Sort(fsList)
-
-	for _, fs := range fsList {
-		ch, err := fs.GetStream(market, instrument, uint64(since.Unix()), uint64(until.Unix()))
-		if err != nil {
-			return nil, fmt.Errorf("failed to read trade stream: %s", err)
-		}
+	trades := make(TradeList, 0, 1000)
 
-		for trade := range ch {
-			ts := time.Unix(trade.Timestamp/1000, 0)
-			if ts.After(since) && ts.Before(until) {
-				trades = append(trades, trade)
-			}
+	for trade := range ch {
+		ts := time.Unix(trade.Timestamp/1000, 0)
+		if ts.After(since) && ts.Before(until) {
+			trades = append(trades, trade)
 		}
 	}
 
 	return trades, nil
 }
 
+//GetSinceStream streams trades of an instrument. Periods before the oldest raw trades
+//are read from the compacted segments
 func (tl *TradeLibrary) GetSinceStream(market, instrument string, since, until time.Time) (<-chan *ticks.Trade, error) {
 	if since.IsZero() {
 		return nil, fmt.Errorf("since must not be zero")
@@ -207,9 +545,15 @@ func (tl *TradeLibrary) GetSinceStream(market, instrument string, since, until t
 
 	trades := make(chan *ticks.Trade, 1000)
 
-	fsList := FileStoreList{}
+	stores := tl.instrumentStores(market, instrument)
 
-	for _, ref := range tl.refs {
+	var rawFrom time.Time
+	if len(stores) > 0 {
+		rawFrom = stores[0].startTime
+	}
+
+	fsList := FileStoreList{}
+	for _, ref := range stores {
 		if since.After(ref.lastTime) {
 			continue
 		}
@@ -217,16 +561,26 @@ func (tl *TradeLibrary) GetSinceStream(market, instrument string, since, until t
 		fsList = append(fsList, ref)
 	}
 
-	sort.Sort(fsList)
-
 	go func() {
 		defer close(trades)
 
+		if rawFrom.IsZero() || since.Before(rawFrom) {
+			segUntil := until
+			if !rawFrom.IsZero() && rawFrom.Before(until) {
+				segUntil = rawFrom
+			}
+
+			if err := tl.streamSegments(market, instrument, since, segUntil, trades); err != nil {
+				tl.log.Errorf("failed to stream segments: %s", err)
+				return
+			}
+		}
+
 		for _, fs := range fsList {
 			s, err := fs.GetStream(market, instrument, uint64(since.Unix()), uint64(until.Unix()))
 			if err != nil {
-				close(trades)
-				fmt.Printf("failed to stream trades: %s\n", err)
+				tl.log.Errorf("failed to stream trades: %s", err)
+				return
 			}
 
 			for t := range s {
@@ -237,3 +591,28 @@ func (tl *TradeLibrary) GetSinceStream(market, instrument string, since, until t
 
 	return trades, nil
 }
+
+//streamSegments streams the compacted trades of an instrument from since until before until
+func (tl *TradeLibrary) streamSegments(market, instrument string, since, until time.Time, out chan<- *ticks.Trade) error {
+	for d := since.UTC().Truncate(oneDay); d.Before(until); d = d.Add(oneDay) {
+		trades, err := tl.segments.read(market, instrument, d)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range trades {
+			tts := t.Timestamp
+			if tts > 9999999999 {
+				tts = tts / 1000
+			}
+
+			if tts < since.Unix() || tts >= until.Unix() {
+				continue
+			}
+
+			out <- t
+		}
+	}
+
+	return nil
+}
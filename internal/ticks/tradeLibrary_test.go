@@ -0,0 +1,163 @@
+package ticks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"pm.tcfw.com.au/source/ataas/api/pb/ticks"
+)
+
+func TestLibraryPartitions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "library")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	start := time.Now().Add(-time.Hour)
+
+	//file store written before partitioning
+	legacy, err := NewFileStore(dir, start)
+	require.NoError(t, err)
+	require.NoError(t, legacy.Add(&ticks.Trade{Market: "binance.com", Instrument: "BTC/AUD", Amount: 1, Timestamp: start.UnixNano() / int64(time.Millisecond)}))
+	require.NoError(t, legacy.Add(&ticks.Trade{Market: "binance.com", Instrument: "ETHAUD", Amount: 2, Timestamp: start.UnixNano() / int64(time.Millisecond)}))
+	require.NoError(t, legacy.Close())
+
+	lib, err := NewLibrary(dir, LibraryOptions{}, logrus.New())
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		instrument := "BTC/AUD"
+		if i%2 == 0 {
+			instrument = "ETHAUD"
+		}
+
+		require.NoError(t, lib.Add(&ticks.Trade{
+			Market:     "binance.com",
+			Instrument: instrument,
+			Amount:     float32(i),
+			Timestamp:  start.Add(time.Duration(i)*time.Second).UnixNano() / int64(time.Millisecond),
+		}))
+	}
+
+	_, err = os.Stat(filepath.Join(dir, partitionDir, "binance.com", "BTC%2FAUD"))
+	assert.NoError(t, err)
+	assert.Len(t, lib.instrumentStores("binance.com", "ETHAUD"), 2)
+
+	require.NoError(t, lib.Close())
+
+	lib, err = NewLibrary(dir, LibraryOptions{}, logrus.New())
+	require.NoError(t, err)
+	defer lib.Close()
+
+	assert.Len(t, lib.shared, 1)
+	assert.Len(t, lib.partitions, 2)
+
+	trades, err := lib.GetSince("binance.com", "BTC/AUD", start.Add(-time.Minute), time.Now())
+	require.NoError(t, err)
+	if assert.Len(t, trades, 6) {
+		assert.Equal(t, float32(1), trades[0].Amount)
+		assert.Equal(t, float32(9), trades[5].Amount)
+	}
+
+	//appends after reopening start a new file in the partition
+	require.NoError(t, lib.Add(&ticks.Trade{Market: "binance.com", Instrument: "ETHAUD", Amount: 12, Timestamp: time.Now().UnixNano() / int64(time.Millisecond)}))
+
+	trades, err = lib.GetSince("binance.com", "ETHAUD", start.Add(-time.Minute), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Len(t, trades, 7)
+}
+
+type benchRangeStream struct {
+	grpc.ServerStream
+	n int
+}
+
+func (s *benchRangeStream) Send(*ticks.Trade) error {
+	s.n++
+	return nil
+}
+
+func BenchmarkTradesRangeStream(b *testing.B) {
+	const (
+		instruments = 50
+		trades      = 200000
+	)
+
+	start := time.Now().Add(-time.Hour)
+
+	fill := func(add func(*ticks.Trade) error) {
+		for i := 0; i < trades; i++ {
+			err := add(&ticks.Trade{
+				Market:     "binance.com",
+				Instrument: fmt.Sprintf("SYM%dAUD", i%instruments),
+				Amount:     float32(i),
+				Units:      1,
+				Timestamp:  start.Add(time.Duration(i) * time.Hour / trades).Unix(),
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	layouts := map[string]func(dir string){
+		"shared": func(dir string) {
+			fs, err := NewFileStore(dir, start)
+			if err != nil {
+				b.Fatal(err)
+			}
+			fill(fs.Add)
+			fs.Close()
+		},
+		"partitioned": func(dir string) {
+			lib, err := NewLibrary(dir, LibraryOptions{}, logrus.New())
+			if err != nil {
+				b.Fatal(err)
+			}
+			fill(lib.Add)
+			lib.Close()
+		},
+	}
+
+	for _, name := range []string{"shared", "partitioned"} {
+		b.Run(name, func(b *testing.B) {
+			dir, err := ioutil.TempDir("", "library")
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			layouts[name](dir)
+
+			log := logrus.New()
+			log.SetOutput(ioutil.Discard)
+
+			lib, err := NewLibrary(dir, LibraryOptions{}, log)
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer lib.Close()
+
+			s := &Server{log: log, library: lib}
+			req := &ticks.RangeRequest{Market: "binance.com", Instrument: "SYM7AUD", Since: "2h"}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				stream := &benchRangeStream{}
+				if err := s.TradesRangeStream(req, stream); err != nil {
+					b.Fatal(err)
+				}
+				if stream.n != trades/instruments {
+					b.Fatalf("unexpected trades: %d", stream.n)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_user_tokens",
+		time.Date(2021, 7, 15, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS user_tokens (
+					user_id UUID NOT NULL,
+					purpose STRING NOT NULL,
+					token_hash BYTES NOT NULL,
+					expires TIMESTAMPTZ NOT NULL,
+					created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+					PRIMARY KEY (user_id, purpose)
+				)
+			`)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `CREATE INDEX IF NOT EXISTS users_email_idx ON users (email)`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
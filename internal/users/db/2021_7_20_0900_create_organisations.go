@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	migrate "github.com/tcfw/go-migrate/pgx"
+)
+
+func init() {
+	register(migrate.NewSimpleMigration(
+		"create_organisations",
+		time.Date(2021, 7, 20, 9, 0, 0, 0, time.Local),
+
+		//Up
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS organisations (
+					id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+					name STRING NOT NULL,
+					created_by UUID NOT NULL,
+					created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+				)
+			`)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, `
+				CREATE TABLE IF NOT EXISTS org_members (
+					account UUID NOT NULL,
+					user_id UUID NOT NULL,
+					role INT NOT NULL DEFAULT 0,
+					created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+					PRIMARY KEY (account, user_id),
+					INDEX (user_id)
+				)
+			`)
+			return err
+		},
+
+		//Down
+		func(ctx context.Context, tx pgx.Tx) error {
+			return fmt.Errorf("down not supported in this migration")
+		},
+	))
+}
@@ -0,0 +1,52 @@
+package users
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	notifyAPI "pm.tcfw.com.au/source/ataas/api/pb/notify"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	rpcUtils "pm.tcfw.com.au/source/ataas/internal/utils/rpc"
+)
+
+var (
+	_notifySvc notifyAPI.NotifyServiceClient
+)
+
+func notifySvc() (notifyAPI.NotifyServiceClient, error) {
+	if _notifySvc == nil {
+		notifyEndpoint, envExists := os.LookupEnv("NOTIFY_HOST")
+		if !envExists {
+			notifyEndpoint = viper.GetString("grpc.addr")
+		}
+
+		conn, err := grpc.Dial(notifyEndpoint, rpcUtils.InternalClientOptions()...)
+		if err != nil {
+			return nil, err
+		}
+
+		_notifySvc = notifyAPI.NewNotifyServiceClient(conn)
+	}
+
+	return _notifySvc, nil
+}
+
+//notifyUser sends an account message to the user
+func notifyUser(ctx context.Context, user *usersAPI.User, title, body string) error {
+	nSvc, err := notifySvc()
+	if err != nil {
+		return err
+	}
+
+	_, err = nSvc.Send(ctx, &notifyAPI.SendRequest{
+		Uid:   user.Id,
+		Type:  notifyAPI.SendRequest_ACCOUNT,
+		Title: title,
+		Body:  body,
+	})
+
+	return err
+}
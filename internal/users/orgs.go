@@ -0,0 +1,435 @@
+package users
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/status"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/passport/utils"
+)
+
+var (
+	ErrOrgNotFound = status.Error(codes.NotFound, "organisation not found")
+	ErrLastOwner   = status.Error(codes.FailedPrecondition, "organisations must have at least one owner")
+)
+
+//CreateOrg creates a new organisation with the current user as its owner. The
+//organisation id is used as the account of everything the organisation owns
+func (s *Server) CreateOrg(ctx context.Context, req *usersAPI.CreateOrgRequest) (*usersAPI.Organisation, error) {
+	uid, err := utils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	org := &usersAPI.Organisation{
+		Id:        uuid.New().String(),
+		Name:      name,
+		Role:      usersAPI.OrgRole_OWNER,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := db.Build().Insert("organisations").
+		Columns("id", "name", "created_by").
+		Values(org.Id, org.Name, uid)
+
+	if _, err := db.Exec(ctx, tx, q); err != nil {
+		return nil, err
+	}
+
+	q = db.Build().Insert("org_members").
+		Columns("account", "user_id", "role").
+		Values(org.Id, uid, usersAPI.OrgRole_OWNER)
+
+	if _, err := db.Exec(ctx, tx, q); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+//ListOrgs lists the organisations the current user is a member of
+func (s *Server) ListOrgs(ctx context.Context, req *usersAPI.Empty) (*usersAPI.OrgList, error) {
+	uid, err := utils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	q := db.Build().Select("o.id", "o.name", "m.role", "o.created").
+		From("org_members m").
+		Join("organisations o ON o.id = m.account").
+		Where(sq.Eq{"m.user_id": uid}).
+		OrderBy("o.name")
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	list := &usersAPI.OrgList{Orgs: []*usersAPI.Organisation{}}
+
+	for res.Next() {
+		org := &usersAPI.Organisation{}
+		var created time.Time
+
+		if err := res.Scan(&org.Id, &org.Name, &org.Role, &created); err != nil {
+			return nil, err
+		}
+
+		org.CreatedAt = created.Unix()
+		list.Orgs = append(list.Orgs, org)
+	}
+
+	return list, nil
+}
+
+//ListOrgMembers lists the members of an organisation to its members, or to other services
+func (s *Server) ListOrgMembers(ctx context.Context, req *usersAPI.OrgRequest) (*usersAPI.OrgMemberList, error) {
+	service, err := utils.ServiceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if service == "" {
+		if err := requireOrgRole(ctx, req.Id, usersAPI.OrgRole_VIEWER); err != nil {
+			return nil, err
+		}
+	}
+
+	q := db.Build().Select(memberColumns...).
+		From("org_members m").
+		Join("users u ON u.id = m.user_id").
+		Where(sq.Eq{"m.account": req.Id}).
+		Where(sq.NotEq{"u.status": usersAPI.User_DELETED}).
+		OrderBy("m.created")
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	list := &usersAPI.OrgMemberList{Members: []*usersAPI.OrgMember{}}
+
+	for res.Next() {
+		member, err := scanMember(res)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Members = append(list.Members, member)
+	}
+
+	return list, nil
+}
+
+//AddOrgMember adds an existing active user to the organisation by email. Only
+//owners may manage members
+func (s *Server) AddOrgMember(ctx context.Context, req *usersAPI.OrgMemberRequest) (*usersAPI.OrgMember, error) {
+	if err := requireOrgRole(ctx, req.Id, usersAPI.OrgRole_OWNER); err != nil {
+		return nil, err
+	}
+
+	if _, ok := usersAPI.OrgRole_name[int32(req.Role)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown role")
+	}
+
+	user, err := s.Find(ctx, &usersAPI.UserRequest{
+		Query:  &usersAPI.UserRequest_Email{Email: strings.ToLower(strings.TrimSpace(req.Email))},
+		Status: usersAPI.UserRequest_ACTIVE,
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if _, member, err := memberRole(ctx, req.Id, user.Id); err != nil {
+		return nil, err
+	} else if member {
+		return nil, status.Error(codes.AlreadyExists, "user is already a member")
+	}
+
+	q := db.Build().Insert("org_members").
+		Columns("account", "user_id", "role").
+		Values(req.Id, user.Id, req.Role)
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return s.getMember(ctx, req.Id, user.Id)
+}
+
+//UpdateOrgMember changes the role of a member. Only owners may manage members
+//and the last owner cannot be demoted
+func (s *Server) UpdateOrgMember(ctx context.Context, req *usersAPI.OrgMemberRequest) (*usersAPI.OrgMember, error) {
+	if err := requireOrgRole(ctx, req.Id, usersAPI.OrgRole_OWNER); err != nil {
+		return nil, err
+	}
+
+	if _, ok := usersAPI.OrgRole_name[int32(req.Role)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown role")
+	}
+
+	q := db.Build().Update("org_members").
+		Set("role", req.Role).
+		Where(sq.Eq{"account": req.Id, "user_id": req.UserId})
+
+	if err := changeMembers(ctx, req.Id, q); err != nil {
+		return nil, err
+	}
+
+	return s.getMember(ctx, req.Id, req.UserId)
+}
+
+//RemoveOrgMember removes a member from the organisation. Owners may remove any
+//member and members may remove themselves, but the last owner cannot be removed
+func (s *Server) RemoveOrgMember(ctx context.Context, req *usersAPI.OrgMemberRequest) (*usersAPI.Empty, error) {
+	uid, err := utils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	minRole := usersAPI.OrgRole_OWNER
+	if req.UserId == uid {
+		minRole = usersAPI.OrgRole_VIEWER
+	}
+
+	if err := requireOrgRole(ctx, req.Id, minRole); err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Delete("org_members").Where(sq.Eq{"account": req.Id, "user_id": req.UserId})
+
+	if err := changeMembers(ctx, req.Id, q); err != nil {
+		return nil, err
+	}
+
+	return &usersAPI.Empty{}, nil
+}
+
+//Membership provides the role of a user in an account for other services. Users
+//are the owners of their personal account
+func (s *Server) Membership(ctx context.Context, req *usersAPI.MembershipRequest) (*usersAPI.Membership, error) {
+	if req.UserId == "" || req.Account == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing user or account")
+	}
+
+	if _, err := uuid.Parse(req.Account); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "not a member of the account")
+	}
+
+	q := db.Build().Select("count(*)").From("users").
+		Where(sq.Eq{"id": req.UserId, "account": req.Account, "status": usersAPI.User_ACTIVE})
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	var personal int
+	if res.Next() {
+		if err := res.Scan(&personal); err != nil {
+			return nil, err
+		}
+	}
+
+	if personal > 0 {
+		return &usersAPI.Membership{Account: req.Account, Role: usersAPI.OrgRole_OWNER}, nil
+	}
+
+	role, member, err := memberRole(ctx, req.Account, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !member {
+		return nil, status.Error(codes.PermissionDenied, "not a member of the account")
+	}
+
+	return &usersAPI.Membership{Account: req.Account, Role: role}, nil
+}
+
+var (
+	memberColumns = []string{
+		"u.id",
+		"u.email",
+		"u.firstName",
+		"u.lastName",
+		"m.role",
+		"m.created",
+	}
+)
+
+//getMember fetches a single member of the organisation
+func (s *Server) getMember(ctx context.Context, account, uid string) (*usersAPI.OrgMember, error) {
+	q := db.Build().Select(memberColumns...).
+		From("org_members m").
+		Join("users u ON u.id = m.user_id").
+		Where(sq.Eq{"m.account": account, "m.user_id": uid}).
+		Limit(1)
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return nil, status.Error(codes.NotFound, "member not found")
+	}
+
+	return scanMember(res)
+}
+
+//changeMembers applies an update or removal of members, refusing changes which
+//would leave the organisation without an owner
+func changeMembers(ctx context.Context, account string, q sq.Sqlizer) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := db.Exec(ctx, tx, q)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, "member not found")
+	}
+
+	owners, err := countOwners(ctx, tx, account)
+	if err != nil {
+		return err
+	}
+	if owners == 0 {
+		return ErrLastOwner
+	}
+
+	return tx.Commit(ctx)
+}
+
+func countOwners(ctx context.Context, tx pgx.Tx, account string) (int, error) {
+	q := db.Build().Select("count(*)").From("org_members").
+		Where(sq.Eq{"account": account, "role": usersAPI.OrgRole_OWNER})
+
+	res, err := db.Query(ctx, tx, q)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var n int
+	if res.Next() {
+		if err := res.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+
+	return n, res.Err()
+}
+
+//memberRole provides the role of the user in the organisation, if they are a member
+func memberRole(ctx context.Context, account, uid string) (usersAPI.OrgRole, bool, error) {
+	q := db.Build().Select("role").From("org_members").
+		Where(sq.Eq{"account": account, "user_id": uid}).
+		Limit(1)
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return 0, false, err
+	}
+	defer done()
+
+	if !res.Next() {
+		return 0, false, nil
+	}
+
+	var role usersAPI.OrgRole
+	if err := res.Scan(&role); err != nil {
+		return 0, false, err
+	}
+
+	return role, true, nil
+}
+
+//requireOrgRole ensures the current user is a member of the organisation with at
+//least the given role. Organisations are hidden from non-members
+func requireOrgRole(ctx context.Context, account string, min usersAPI.OrgRole) error {
+	uid, err := utils.UserIDFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	if _, err := uuid.Parse(account); err != nil {
+		return ErrOrgNotFound
+	}
+
+	role, member, err := memberRole(ctx, account, uid)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return ErrOrgNotFound
+	}
+	if role < min {
+		return status.Error(codes.PermissionDenied, "insufficient organisation role")
+	}
+
+	return nil
+}
+
+func scanMember(row pgx.Row) (*usersAPI.OrgMember, error) {
+	m := &usersAPI.OrgMember{}
+	var created time.Time
+
+	err := row.Scan(
+		&m.UserId,
+		&m.Email,
+		&m.FirstName,
+		&m.LastName,
+		&m.Role,
+		&created,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.CreatedAt = created.Unix()
+
+	return m, nil
+}
@@ -0,0 +1,46 @@
+package users
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	passportAPI "pm.tcfw.com.au/source/ataas/api/pb/passport"
+	rpcUtils "pm.tcfw.com.au/source/ataas/internal/utils/rpc"
+)
+
+var (
+	_passportSvc passportAPI.PassportSeviceClient
+)
+
+func passportSvc() (passportAPI.PassportSeviceClient, error) {
+	if _passportSvc == nil {
+		passportEndpoint, envExists := os.LookupEnv("PASSPORT_HOST")
+		if !envExists {
+			passportEndpoint = viper.GetString("grpc.addr")
+		}
+
+		conn, err := grpc.Dial(passportEndpoint, rpcUtils.InternalClientOptions()...)
+		if err != nil {
+			return nil, err
+		}
+
+		_passportSvc = passportAPI.NewPassportSeviceClient(conn)
+	}
+
+	return _passportSvc, nil
+}
+
+//revokeSessions signs the user out of all of their sessions
+func revokeSessions(ctx context.Context, id string, reason string) error {
+	pSvc, err := passportSvc()
+	if err != nil {
+		return err
+	}
+
+	_, err = pSvc.RevokeUserTokens(ctx, &passportAPI.Revoke{Id: id, Reason: reason})
+
+	return err
+}
@@ -0,0 +1,170 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/status"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/db"
+)
+
+const (
+	minPasswordLength = 8
+	//maxPasswordLength bcrypt ignores anything past 72 bytes
+	maxPasswordLength = 72
+)
+
+//hashPassword validates the password length and hashes it for storage
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength {
+		return "", status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+	if len(password) > maxPasswordLength {
+		return "", status.Errorf(codes.InvalidArgument, "password must be at most %d characters", maxPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+//SetPassword changes the password of a user. Users changing their own password must
+//provide their current password if they have one
+func (s *Server) SetPassword(ctx context.Context, req *usersAPI.PasswordUpdateRequest) (*usersAPI.Empty, error) {
+	id, self, err := authorizeUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Find(ctx, &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Id{Id: id}, Status: usersAPI.UserRequest_ACTIVE})
+	if err != nil {
+		return nil, err
+	}
+
+	if self && user.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "current password incorrect")
+		}
+	}
+
+	if err := setPassword(ctx, id, req.Password); err != nil {
+		return nil, err
+	}
+
+	if err := revokeSessions(ctx, id, "password changed"); err != nil {
+		return nil, err
+	}
+
+	return &usersAPI.Empty{}, nil
+}
+
+func setPassword(ctx context.Context, id string, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	q := db.Build().Update("users").
+		Set("password", hash).
+		Set("updatedAt", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id})
+
+	return db.SimpleExec(ctx, q)
+}
+
+//ForgotPassword emails a time limited reset token to an active user. Unknown emails
+//are not reported to avoid disclosing which emails are registered
+func (s *Server) ForgotPassword(ctx context.Context, req *usersAPI.ForgotPasswordRequest) (*usersAPI.Empty, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	user, err := s.Find(ctx, &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Email{Email: email}, Status: usersAPI.UserRequest_ACTIVE})
+	if err != nil {
+		return &usersAPI.Empty{}, nil
+	}
+
+	token, err := issueToken(ctx, user.Id, tokenPurposeReset, viper.GetDuration("users.reset_ttl"))
+	if err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s/resetpassword?email=%s&token=%s", viper.GetString("users.app_url"), url.QueryEscape(user.Email), token)
+
+	err = notifyUser(ctx, user, "Reset your password", fmt.Sprintf(
+		"Hi %s,<br/><br/>A password reset was requested for your account. To choose a new password follow the link below:<br/><br/><a href=\"%s\">%s</a><br/><br/>If you didn't request this, you can ignore this email.",
+		html.EscapeString(user.FirstName), html.EscapeString(link), html.EscapeString(link),
+	))
+	if err != nil {
+		s.log.WithError(err).WithField("user", user.Id).Warn("failed to send password reset")
+	}
+
+	return &usersAPI.Empty{}, nil
+}
+
+//ResetPassword sets a new password using a token sent by ForgotPassword
+func (s *Server) ResetPassword(ctx context.Context, req *usersAPI.ResetPasswordRequest) (*usersAPI.Empty, error) {
+	if req.Email == "" || req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	hash, err := hashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Find(ctx, &usersAPI.UserRequest{
+		Query:  &usersAPI.UserRequest_Email{Email: strings.ToLower(strings.TrimSpace(req.Email))},
+		Status: usersAPI.UserRequest_ACTIVE,
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := consumeToken(ctx, tx, user.Id, tokenPurposeReset, req.Token); err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Update("users").
+		Set("password", hash).
+		Set("updatedAt", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": user.Id})
+
+	if _, err := db.Exec(ctx, tx, q); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := revokeSessions(ctx, user.Id, "password reset"); err != nil {
+		return nil, err
+	}
+
+	return &usersAPI.Empty{}, nil
+}
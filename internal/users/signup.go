@@ -0,0 +1,178 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/status"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+	"pm.tcfw.com.au/source/ataas/db"
+	"pm.tcfw.com.au/source/ataas/internal/utils/recaptcha"
+	rpcUtils "pm.tcfw.com.au/source/ataas/internal/utils/rpc"
+)
+
+//Create registers a new pending user and sends a verification email. The user
+//becomes active once the email address is validated via ValidateAccount
+func (s *Server) Create(ctx context.Context, req *usersAPI.CreateRequest) (*usersAPI.User, error) {
+	if req.User == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing user")
+	}
+
+	if viper.GetBool("recaptcha.enable") {
+		valid, err := recaptcha.Validate(ctx, req.Recaptcha, rpcUtils.RemoteIPFromContext(ctx).String())
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, status.Error(codes.InvalidArgument, "invalid recaptcha")
+		}
+	}
+
+	email, err := normaliseEmail(req.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.User.FirstName) == "" {
+		return nil, status.Error(codes.InvalidArgument, "first name is required")
+	}
+
+	hash, err := hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := emailExists(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "email already registered")
+	}
+
+	user := &usersAPI.User{
+		Id:        uuid.New().String(),
+		Status:    usersAPI.User_PENDING,
+		FirstName: strings.TrimSpace(req.User.FirstName),
+		LastName:  strings.TrimSpace(req.User.LastName),
+		Email:     email,
+		Account:   uuid.New().String(),
+	}
+
+	q := db.Build().Insert("users").
+		Columns("id", "status", "email", "firstName", "lastName", "password", "account").
+		Values(user.Id, user.Status, user.Email, user.FirstName, user.LastName, hash, user.Account)
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if err := s.sendVerification(ctx, user); err != nil {
+		s.log.WithError(err).WithField("user", user.Id).Warn("failed to send verification")
+	}
+
+	return user, nil
+}
+
+//sendVerification issues a verification token and emails it to the user
+func (s *Server) sendVerification(ctx context.Context, user *usersAPI.User) error {
+	token, err := issueToken(ctx, user.Id, tokenPurposeVerify, viper.GetDuration("users.verify_ttl"))
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/validate_account?email=%s&token=%s", viper.GetString("users.app_url"), url.QueryEscape(user.Email), token)
+
+	return notifyUser(ctx, user, "Verify your email address", fmt.Sprintf(
+		"Hi %s,<br/><br/>Please verify your email address by following the link below:<br/><br/><a href=\"%s\">%s</a>",
+		html.EscapeString(user.FirstName), html.EscapeString(link), html.EscapeString(link),
+	))
+}
+
+//ValidateAccount activates a pending user using the token sent to their email address
+func (s *Server) ValidateAccount(ctx context.Context, req *usersAPI.ValidateRequest) (*usersAPI.Empty, error) {
+	if req.Email == "" || req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required arguments")
+	}
+
+	user, err := s.Find(ctx, &usersAPI.UserRequest{
+		Query:  &usersAPI.UserRequest_Email{Email: strings.ToLower(strings.TrimSpace(req.Email))},
+		Status: usersAPI.UserRequest_PENDING,
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := consumeToken(ctx, tx, user.Id, tokenPurposeVerify, req.Token); err != nil {
+		return nil, err
+	}
+
+	q := db.Build().Update("users").
+		Set("status", usersAPI.User_ACTIVE).
+		Set("updatedAt", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": user.Id, "status": usersAPI.User_PENDING})
+
+	if _, err := db.Exec(ctx, tx, q); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &usersAPI.Empty{}, nil
+}
+
+//normaliseEmail validates and lower cases an email address
+func normaliseEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", status.Error(codes.InvalidArgument, "invalid email address")
+	}
+
+	return email, nil
+}
+
+//emailExists checks if a user which hasn't been deleted has the email address
+func emailExists(ctx context.Context, email string) (bool, error) {
+	q := db.Build().Select("count(*)").From("users").
+		Where(sq.Eq{"email": email}).
+		Where(sq.NotEq{"status": usersAPI.User_DELETED})
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return false, err
+	}
+	defer done()
+
+	var n int
+	if res.Next() {
+		if err := res.Scan(&n); err != nil {
+			return false, err
+		}
+	}
+
+	return n > 0, nil
+}
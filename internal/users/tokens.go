@@ -0,0 +1,87 @@
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/status"
+	"github.com/jackc/pgx/v4"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"pm.tcfw.com.au/source/ataas/db"
+)
+
+const (
+	tokenPurposeVerify = "verify"
+	tokenPurposeReset  = "reset"
+
+	tokenSize = 32
+)
+
+var (
+	ErrInvalidToken = status.Error(codes.InvalidArgument, "invalid or expired token")
+)
+
+func init() {
+	viper.SetDefault("users.verify_ttl", "48h")
+	viper.SetDefault("users.reset_ttl", "1h")
+	viper.SetDefault("users.app_url", "https://ataas.tcfw.com.au")
+}
+
+//newToken creates a random url safe token
+func newToken() (string, error) {
+	b := make([]byte, tokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+//hashToken tokens are only stored hashed so a leaked table can't be used to take over accounts
+func hashToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+//issueToken creates a new token for the user, replacing any previous token for the same purpose
+func issueToken(ctx context.Context, userID, purpose string, ttl time.Duration) (string, error) {
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	q := db.Build().Insert("user_tokens").
+		Columns("user_id", "purpose", "token_hash", "expires").
+		Values(userID, purpose, hashToken(token), time.Now().Add(ttl)).
+		Suffix("ON CONFLICT (user_id, purpose) DO UPDATE SET token_hash = excluded.token_hash, expires = excluded.expires, created = NOW()")
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+//consumeToken removes the token of the user within the transaction, returning
+//ErrInvalidToken if it doesn't match or has expired
+func consumeToken(ctx context.Context, tx pgx.Tx, userID, purpose, token string) error {
+	q := db.Build().Delete("user_tokens").
+		Where(sq.Eq{"user_id": userID, "purpose": purpose, "token_hash": hashToken(token)}).
+		Where(sq.Gt{"expires": time.Now()})
+
+	tag, err := db.Exec(ctx, tx, q)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
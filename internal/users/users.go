@@ -1,13 +1,16 @@
 package users
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
-	"net/http"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/status"
 	"github.com/jackc/pgx/v4"
 	"github.com/sirupsen/logrus"
@@ -35,6 +38,11 @@ var (
 	}
 )
 
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
 type Server struct {
 	usersAPI.UnimplementedUserServiceServer
 
@@ -86,7 +94,7 @@ func (s *Server) Me(ctx context.Context, req *usersAPI.Empty) (*usersAPI.User, e
 		return nil, err
 	}
 
-	user.Password = ""
+	redactUser(user)
 
 	return user, nil
 }
@@ -127,7 +135,7 @@ func (s *Server) Find(ctx context.Context, req *usersAPI.UserRequest) (*usersAPI
 	defer done()
 
 	if !results.Next() {
-		return nil, status.Error(http.StatusNotFound, "User not found")
+		return nil, status.Error(codes.NotFound, "User not found")
 
 	}
 	user, err := scanUser(results)
@@ -138,12 +146,317 @@ func (s *Server) Find(ctx context.Context, req *usersAPI.UserRequest) (*usersAPI
 	return user, nil
 }
 
+//Get provides a user to admins, or to the user themselves
+func (s *Server) Get(ctx context.Context, req *usersAPI.UserRequest) (*usersAPI.User, error) {
+	if id, ok := req.Query.(*usersAPI.UserRequest_Id); ok {
+		if _, _, err := authorizeUser(ctx, id.Id); err != nil {
+			return nil, err
+		}
+	} else if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := s.Find(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	redactUser(user)
+
+	return user, nil
+}
+
+//List provides a page of users which haven't been deleted, ordered by ID. Pages
+//continue after page_start when given, otherwise from the offset
+func (s *Server) List(ctx context.Context, req *usersAPI.ListRequest) (*usersAPI.UserList, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	} else if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	notDeleted := sq.NotEq{"status": usersAPI.User_DELETED}
+
+	q := db.Build().Select(allColumn...).From("users").
+		Where(notDeleted).
+		OrderBy("id").
+		Limit(uint64(limit))
+
+	if req.PageStart != "" {
+		q = q.Where(sq.Gt{"id": req.PageStart})
+	} else if req.Offset > 0 {
+		q = q.Offset(uint64(req.Offset))
+	}
+
+	res, done, err := db.SimpleQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	list := &usersAPI.UserList{Users: []*usersAPI.User{}}
+
+	for res.Next() {
+		user, err := scanUser(res)
+		if err != nil {
+			return nil, err
+		}
+
+		redactUser(user)
+		list.Users = append(list.Users, user)
+	}
+
+	countRes, countDone, err := db.SimpleQuery(ctx, db.Build().Select("count(*)").From("users").Where(notDeleted))
+	if err != nil {
+		return nil, err
+	}
+	defer countDone()
+
+	if countRes.Next() {
+		if err := countRes.Scan(&list.Total); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+//Update updates the profile of a user. Admins may also change the status of other
+//users between pending and active by setting set_status
+func (s *Server) Update(ctx context.Context, req *usersAPI.UpdateRequest) (*usersAPI.User, error) {
+	if req.User == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing user")
+	}
+
+	id, self, err := authorizeUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.User.FirstName) == "" {
+		return nil, status.Error(codes.InvalidArgument, "first name is required")
+	}
+
+	q := db.Build().Update("users").
+		Set("firstName", strings.TrimSpace(req.User.FirstName)).
+		Set("lastName", strings.TrimSpace(req.User.LastName)).
+		Set("updatedAt", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"status": usersAPI.User_DELETED})
+
+	if req.User.Metadata != nil {
+		metadata, err := json.Marshal(req.User.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Set("metadata", string(metadata))
+	}
+
+	if !self && req.SetStatus {
+		switch req.User.Status {
+		case usersAPI.User_PENDING, usersAPI.User_ACTIVE:
+			q = q.Set("status", req.User.Status)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "users can only be deleted using Delete")
+		}
+	}
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Id{Id: id}, Status: usersAPI.UserRequest_ANY})
+}
+
+//Delete soft deletes a user, removing any outstanding tokens and sessions
+func (s *Server) Delete(ctx context.Context, req *usersAPI.UserRequest) (*usersAPI.Empty, error) {
+	id, _, err := authorizeUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := db.Build().Update("users").
+		Set("status", usersAPI.User_DELETED).
+		Set("deletedAt", sq.Expr("NOW()")).
+		Set("updatedAt", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"status": usersAPI.User_DELETED})
+
+	tag, err := db.Exec(ctx, tx, q)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if _, err := db.Exec(ctx, tx, db.Build().Delete("user_tokens").Where(sq.Eq{"user_id": id})); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := revokeSessions(ctx, id, "user deleted"); err != nil {
+		return nil, err
+	}
+
+	return &usersAPI.Empty{}, nil
+}
+
+//Amend replaces the stored fields of a user for internal services. Callers should
+//Find the user first and amend the fields they need to change
+func (s *Server) Amend(ctx context.Context, req *usersAPI.UpdateRequest) (*usersAPI.User, error) {
+	if err := requireService(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.User == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing user")
+	}
+
+	id := req.Id
+	if id == "" {
+		id = req.User.Id
+	}
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing user id")
+	}
+
+	mfa, err := marshalMFA(req.User.Mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata interface{}
+	if req.User.Metadata != nil {
+		b, err := json.Marshal(req.User.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		metadata = string(b)
+	}
+
+	q := db.Build().Update("users").
+		Set("status", req.User.Status).
+		Set("email", req.User.Email).
+		Set("firstName", req.User.FirstName).
+		Set("lastName", req.User.LastName).
+		Set("password", req.User.Password).
+		Set("mfa", mfa).
+		Set("metadata", metadata).
+		Set("account", req.User.Account).
+		Set("updatedAt", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id})
+
+	if err := db.SimpleExec(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return s.Find(ctx, &usersAPI.UserRequest{Query: &usersAPI.UserRequest_Id{Id: id}, Status: usersAPI.UserRequest_ANY})
+}
+
+//authorizeUser resolves the user being acted on, defaulting to the current user.
+//Acting on another user requires admin
+func authorizeUser(ctx context.Context, id string) (string, bool, error) {
+	uid, err := utils.UserIDFromContext(ctx)
+	if err != nil {
+		return "", false, status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	if id == "" || id == uid {
+		return uid, true, nil
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return "", false, err
+	}
+
+	return id, false, nil
+}
+
+//redactUser removes the password and MFA secrets of a user before it is returned
+//outside of the internal services. Only the type of MFA enrolled is kept
+func redactUser(user *usersAPI.User) {
+	user.Password = ""
+
+	switch mfa := user.GetMfa().GetMFA().(type) {
+	case *usersAPI.MFA_TOTP:
+		user.Mfa = &usersAPI.MFA{MFA: &usersAPI.MFA_TOTP{TOTP: &usersAPI.MFATOTP{}}}
+	case *usersAPI.MFA_FIDO:
+		user.Mfa = &usersAPI.MFA{MFA: &usersAPI.MFA_FIDO{FIDO: &usersAPI.MFAFIDO{Name: mfa.FIDO.GetName()}}}
+	case *usersAPI.MFA_FIDOKeys:
+		keys := make([]*usersAPI.MFAFIDO, 0, len(mfa.FIDOKeys.GetKeys()))
+		for _, k := range mfa.FIDOKeys.GetKeys() {
+			keys = append(keys, &usersAPI.MFAFIDO{Name: k.Name})
+		}
+		user.Mfa = &usersAPI.MFA{MFA: &usersAPI.MFA_FIDOKeys{FIDOKeys: &usersAPI.MFAFIDOKeys{Keys: keys}}}
+	}
+}
+
+func requireAdmin(ctx context.Context) error {
+	claims, err := utils.TokenClaimsFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	if _, ok := claims["admin"]; !ok {
+		return status.Error(codes.PermissionDenied, "Unauthorised")
+	}
+
+	return nil
+}
+
+//requireService ensures the call was made by another internal service
+func requireService(ctx context.Context) error {
+	service, err := utils.ServiceFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "Unauthorised")
+	}
+
+	if service == "" {
+		return status.Error(codes.PermissionDenied, "Unauthorised")
+	}
+
+	return nil
+}
+
+//marshalMFA encodes MFA settings as JSON using the protobuf mapping to retain the oneof
+func marshalMFA(mfa *usersAPI.MFA) (interface{}, error) {
+	if mfa == nil {
+		return nil, nil
+	}
+
+	m := &jsonpb.Marshaler{}
+	b, err := m.MarshalToString(mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 func scanUser(res pgx.Row) (*usersAPI.User, error) {
 	u := &usersAPI.User{}
 
 	var createdAt time.Time
 	var updatedAt time.Time
 	var deletedAt sql.NullTime
+	var mfa []byte
 
 	err := res.Scan(
 		&u.Id,
@@ -154,7 +467,7 @@ func scanUser(res pgx.Row) (*usersAPI.User, error) {
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
-		&u.Mfa,
+		&mfa,
 		&u.Password,
 		&u.Metadata,
 		&u.Account,
@@ -169,5 +482,12 @@ func scanUser(res pgx.Row) (*usersAPI.User, error) {
 		u.DeletedAt = deletedAt.Time.Unix()
 	}
 
+	if len(mfa) > 0 && string(mfa) != "null" {
+		u.Mfa = &usersAPI.MFA{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(mfa), u.Mfa); err != nil {
+			return nil, fmt.Errorf("decode mfa: %w", err)
+		}
+	}
+
 	return u, nil
 }
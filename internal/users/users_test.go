@@ -0,0 +1,98 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	usersAPI "pm.tcfw.com.au/source/ataas/api/pb/users"
+)
+
+func TestNormaliseEmail(t *testing.T) {
+	email, err := normaliseEmail("  Someone@Example.com ")
+	require.NoError(t, err)
+	assert.Equal(t, "someone@example.com", email)
+
+	for _, invalid := range []string{"", "someone", "Someone <someone@example.com>"} {
+		_, err := normaliseEmail(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	_, err := hashPassword("short")
+	assert.Error(t, err)
+
+	_, err = hashPassword(strings.Repeat("a", maxPasswordLength+1))
+	assert.Error(t, err)
+
+	hash, err := hashPassword("correct horse")
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct horse")))
+}
+
+func TestTokens(t *testing.T) {
+	a, err := newToken()
+	require.NoError(t, err)
+	b, err := newToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, a, b)
+	assert.Equal(t, hashToken(a), hashToken(a))
+	assert.NotEqual(t, hashToken(a), hashToken(b))
+}
+
+func TestMarshalMFA(t *testing.T) {
+	mfa := &usersAPI.MFA{MFA: &usersAPI.MFA_TOTP{TOTP: &usersAPI.MFATOTP{Key: "secret", BackupCodes: []string{"a", "b"}}}}
+
+	enc, err := marshalMFA(mfa)
+	require.NoError(t, err)
+
+	decoded := &usersAPI.MFA{}
+	require.NoError(t, jsonpb.Unmarshal(bytes.NewReader([]byte(enc.(string))), decoded))
+	assert.Equal(t, mfa, decoded)
+
+	enc, err = marshalMFA(nil)
+	require.NoError(t, err)
+	assert.Nil(t, enc)
+}
+
+func TestAmendRequiresService(t *testing.T) {
+	s := &Server{}
+
+	_, err := s.Amend(context.Background(), &usersAPI.UpdateRequest{Id: "user-1", User: &usersAPI.User{}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRedactUser(t *testing.T) {
+	user := &usersAPI.User{
+		Password: "hash",
+		Mfa:      &usersAPI.MFA{MFA: &usersAPI.MFA_TOTP{TOTP: &usersAPI.MFATOTP{Key: "secret", BackupCodes: []string{"a"}}}},
+	}
+
+	redactUser(user)
+	assert.Empty(t, user.Password)
+	require.NotNil(t, user.Mfa.GetTOTP(), "the enrolled MFA type is kept")
+	assert.Empty(t, user.Mfa.GetTOTP().Key)
+	assert.Empty(t, user.Mfa.GetTOTP().BackupCodes)
+
+	user.Mfa = &usersAPI.MFA{MFA: &usersAPI.MFA_FIDOKeys{FIDOKeys: &usersAPI.MFAFIDOKeys{Keys: []*usersAPI.MFAFIDO{
+		{Id: []byte("id"), Pk: []byte("pk"), Name: "laptop", Authenticator: &usersAPI.FIDOAuthenticator{SignCount: 3}},
+	}}}}
+
+	redactUser(user)
+	keys := user.Mfa.GetFIDOKeys().GetKeys()
+	require.Len(t, keys, 1)
+	assert.Equal(t, &usersAPI.MFAFIDO{Name: "laptop"}, keys[0])
+
+	user.Mfa = nil
+	redactUser(user)
+	assert.Nil(t, user.Mfa)
+}
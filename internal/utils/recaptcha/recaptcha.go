@@ -1,4 +1,4 @@
-package passport
+package recaptcha
 
 import (
 	"context"
@@ -24,7 +24,8 @@ type reCAPTCHARespones struct {
 	ErrorCodes  []string `json:"error-codes"`
 }
 
-func validateReCAPTCHA(ctx context.Context, token, remoteIP string) (bool, error) {
+//Validate verifies a reCAPTCHA response token with Google
+func Validate(ctx context.Context, token, remoteIP string) (bool, error) {
 	ctx, span := tracing.StartSpan(ctx, "recaptch_verify")
 	defer span.End()
 
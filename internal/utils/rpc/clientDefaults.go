@@ -13,10 +13,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	authUtils "pm.tcfw.com.au/source/ataas/internal/passport/utils"
 )
 
-//InternalClientOptions to be used for GRPC clients to INTERNAL services
+//InternalClientOptions to be used for GRPC clients to INTERNAL services. Calls
+//are made with the identity of the service along with the auth of the request,
+//so calls relayed for a user are still limited to what the user may do
 func InternalClientOptions() []grpc.DialOption {
+	return append(GatewayClientOptions(), grpc.WithPerRPCCredentials(&authUtils.ServiceCredentials{}))
+}
+
+//GatewayClientOptions to be used for GRPC clients forwarding external requests
+//to INTERNAL services. Calls are only made with the auth of the request
+func GatewayClientOptions() []grpc.DialOption {
 	return []grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithPerRPCCredentials(&PassthroughPerRPCCreds{}),
@@ -54,11 +63,13 @@ func DefaultServerOptions() []grpc.ServerOption {
 			grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(recoveryFunc())),
 			otelgrpc.UnaryServerInterceptor(),
 			grpc_prometheus.UnaryServerInterceptor,
+			authUtils.AuthzUnaryServerInterceptor(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_recovery.StreamServerInterceptor(),
 			otelgrpc.StreamServerInterceptor(),
 			grpc_prometheus.StreamServerInterceptor,
+			authUtils.AuthzStreamServerInterceptor(),
 		)),
 	}
 }
@@ -69,6 +80,7 @@ var (
 		"user-agent":       "user-agent",
 		"x-forwarded-host": "x-forwarded-host",
 		"x-forwarded-for":  "x-forwarded-for",
+		"x-account":        "x-account",
 	}
 )
 
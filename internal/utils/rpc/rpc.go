@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"net"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -11,12 +12,23 @@ import (
 //RemoteIPFromContext returns the IP address of the RPC client, also taking proxy calls into account
 func RemoteIPFromContext(ctx context.Context) net.IP {
 	md, _ := metadata.FromIncomingContext(ctx)
-	peer, _ := peer.FromContext(ctx)
 
-	remoteIP := net.ParseIP(peer.Addr.String())
-	if remoteIP == nil || len(md.Get("x-forwarded-for")) > 0 {
-		remoteIP = net.ParseIP(md.Get("x-forwarded-for")[0])
+	if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+		//the first address is the original client
+		if ip := net.ParseIP(strings.TrimSpace(strings.Split(fwd[0], ",")[0])); ip != nil {
+			return ip
+		}
 	}
 
-	return remoteIP
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	return net.ParseIP(host)
 }